@@ -2,15 +2,20 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
+	_ "github.com/lib/pq"
+	"github.com/nats-io/nats.go"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
@@ -23,29 +28,61 @@ import (
 
 	auth "github.com/eclipse-xfsc/microservice-core-go/pkg/auth"
 	graceful "github.com/eclipse-xfsc/microservice-core-go/pkg/graceful"
+	goadeadletter "github.com/eclipse-xfsc/task-sheduler/gen/dead_letter"
+	goaeventtask "github.com/eclipse-xfsc/task-sheduler/gen/event_task"
 	goahealth "github.com/eclipse-xfsc/task-sheduler/gen/health"
+	goadeadlettersrv "github.com/eclipse-xfsc/task-sheduler/gen/http/dead_letter/server"
+	goaeventtasksrv "github.com/eclipse-xfsc/task-sheduler/gen/http/event_task/server"
 	goahealthsrv "github.com/eclipse-xfsc/task-sheduler/gen/http/health/server"
 	goaopenapisrv "github.com/eclipse-xfsc/task-sheduler/gen/http/openapi/server"
+	goaschedulesrv "github.com/eclipse-xfsc/task-sheduler/gen/http/schedule/server"
 	goatasksrv "github.com/eclipse-xfsc/task-sheduler/gen/http/task/server"
 	goatasklistsrv "github.com/eclipse-xfsc/task-sheduler/gen/http/task_list/server"
+	goatasklisttemplatesrv "github.com/eclipse-xfsc/task-sheduler/gen/http/task_list_template/server"
+	goatasktemplatesrv "github.com/eclipse-xfsc/task-sheduler/gen/http/task_template/server"
+	goawebhooksrv "github.com/eclipse-xfsc/task-sheduler/gen/http/webhook/server"
 	"github.com/eclipse-xfsc/task-sheduler/gen/openapi"
+	goaschedule "github.com/eclipse-xfsc/task-sheduler/gen/schedule"
 	goatask "github.com/eclipse-xfsc/task-sheduler/gen/task"
 	goatasklist "github.com/eclipse-xfsc/task-sheduler/gen/task_list"
+	goatasklisttemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_list_template"
+	goatasktemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_template"
+	goawebhook "github.com/eclipse-xfsc/task-sheduler/gen/webhook"
 	"github.com/eclipse-xfsc/task-sheduler/internal/clients/cache"
 	"github.com/eclipse-xfsc/task-sheduler/internal/clients/event"
 	"github.com/eclipse-xfsc/task-sheduler/internal/clients/policy"
 	"github.com/eclipse-xfsc/task-sheduler/internal/config"
 	"github.com/eclipse-xfsc/task-sheduler/internal/executor"
+	"github.com/eclipse-xfsc/task-sheduler/internal/jetstream"
 	"github.com/eclipse-xfsc/task-sheduler/internal/listexecutor"
+	"github.com/eclipse-xfsc/task-sheduler/internal/memory"
+	"github.com/eclipse-xfsc/task-sheduler/internal/postgres"
+	"github.com/eclipse-xfsc/task-sheduler/internal/reaper"
+	"github.com/eclipse-xfsc/task-sheduler/internal/redisstream"
+	"github.com/eclipse-xfsc/task-sheduler/internal/scheduler"
 	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/deadletter"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/eventtask"
 	"github.com/eclipse-xfsc/task-sheduler/internal/service/health"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/schedule"
 	"github.com/eclipse-xfsc/task-sheduler/internal/service/task"
 	"github.com/eclipse-xfsc/task-sheduler/internal/service/tasklist"
-	"github.com/eclipse-xfsc/task-sheduler/internal/storage"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/tasklisttemplate"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/tasktemplate"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/webhook"
+	mongostorage "github.com/eclipse-xfsc/task-sheduler/internal/storage"
+	webhookdispatcher "github.com/eclipse-xfsc/task-sheduler/internal/webhook"
 )
 
 var Version = "0.0.0+development"
 
+// backend is implemented by the storages of the service, which
+// keep the data of the service and can be used as queue.
+type backend interface {
+	service.Storage
+	service.Store
+}
+
 func main() {
 	var cfg config.Config
 	if err := envconfig.Process("", &cfg); err != nil {
@@ -60,42 +97,149 @@ func main() {
 
 	logger.Info("task service started", zap.String("version", Version), zap.String("goa", goa.Version()))
 
-	// connect to mongo db
-	db, err := mongo.Connect(
-		context.Background(),
-		options.Client().ApplyURI(cfg.Mongo.Addr).SetAuth(options.Credential{
-			Username:      cfg.Mongo.User,
-			Password:      cfg.Mongo.Pass,
-			AuthMechanism: cfg.Mongo.AuthMechanism,
-		}),
-	)
-	if err != nil {
-		logger.Fatal("error connecting to mongodb", zap.Error(err))
+	// webhook requests are not signed with an empty key, so callback URLs
+	// are rejected and no webhooks are sent without a secret
+	webhooks := cfg.Webhook.Secret != ""
+	if !webhooks {
+		logger.Warn("webhooks are disabled as WEBHOOK_SECRET is not set")
 	}
-	defer db.Disconnect(context.Background()) //nolint:errcheck
 
 	// create storage
-	storage := storage.New(db)
+	var storage backend
+	switch cfg.Storage.Backend {
+	case "mongo":
+		if cfg.Mongo.Addr == "" || cfg.Mongo.User == "" || cfg.Mongo.Pass == "" {
+			logger.Fatal("MONGO_ADDR, MONGO_USER and MONGO_PASS are required by the mongo storage")
+		}
 
-	httpClient := httpClient()
+		// connect to mongo db
+		db, err := mongo.Connect(
+			context.Background(),
+			options.Client().ApplyURI(cfg.Mongo.Addr).SetAuth(options.Credential{
+				Username:      cfg.Mongo.User,
+				Password:      cfg.Mongo.Pass,
+				AuthMechanism: cfg.Mongo.AuthMechanism,
+			}),
+		)
+		if err != nil {
+			logger.Fatal("error connecting to mongodb", zap.Error(err))
+		}
+		defer db.Disconnect(context.Background()) //nolint:errcheck
+
+		mongoStorage := mongostorage.New(db, cfg.Queue.PriorityAging)
+		if err := mongoStorage.Migrate(context.Background()); err != nil {
+			logger.Fatal("error migrating mongodb schema", zap.Error(err))
+		}
+		storage = mongoStorage
+	case "postgres":
+		if cfg.Postgres.Addr == "" {
+			logger.Fatal("POSTGRES_ADDR is required by the postgres storage")
+		}
+
+		db, err := sql.Open("postgres", cfg.Postgres.Addr)
+		if err != nil {
+			logger.Fatal("error connecting to postgres", zap.Error(err))
+		}
+		defer db.Close() //nolint:errcheck
+
+		pgStorage := postgres.New(db, cfg.Queue.PriorityAging)
+		if err := pgStorage.Migrate(context.Background()); err != nil {
+			logger.Fatal("error migrating postgres schema", zap.Error(err))
+		}
+		storage = pgStorage
+	case "memory":
+		logger.Warn("data is kept in memory and is lost when the service stops")
+		storage = memory.New(cfg.Queue.PriorityAging)
+	default:
+		logger.Fatal("unknown storage backend", zap.String("backend", cfg.Storage.Backend))
+	}
+
+	// "task migrate" only migrates the schema of the storage and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		logger.Info("storage schema is migrated")
+		return
+	}
+
+	// identify the instance of the service in the leases of executed tasks and taskLists
+	leaseOwner := cfg.Lease.Owner
+	if leaseOwner == "" {
+		if leaseOwner, err = os.Hostname(); err != nil {
+			logger.Fatal("error getting hostname for lease owner", zap.Error(err))
+		}
+	}
+
+	// create queue
+	var queue service.Queue
+	switch cfg.Queue.Backend {
+	case "storage":
+		queue = storage
+	case "jetstream":
+		nc, err := nats.Connect(cfg.JetStream.Addr)
+		if err != nil {
+			logger.Fatal("error connecting to nats", zap.Error(err))
+		}
+		defer nc.Close()
+
+		queue, err = jetstream.New(
+			nc,
+			storage,
+			cfg.JetStream.Stream,
+			cfg.JetStream.Subject,
+			cfg.Lease.Duration,
+			cfg.JetStream.FetchWait,
+		)
+		if err != nil {
+			logger.Fatal("error creating jetstream queue", zap.Error(err))
+		}
+	case "redis":
+		rdb := redis.NewClient(&redis.Options{
+			Addr:     cfg.Redis.Addr,
+			Username: cfg.Redis.User,
+			Password: cfg.Redis.Pass,
+			DB:       cfg.Redis.DB,
+		})
+		defer rdb.Close() //nolint:errcheck
+
+		queue, err = redisstream.New(
+			context.Background(),
+			rdb,
+			storage,
+			cfg.Redis.Prefix,
+			cfg.Redis.FetchWait,
+		)
+		if err != nil {
+			logger.Fatal("error creating redis queue", zap.Error(err))
+		}
+	default:
+		logger.Fatal("unknown queue backend", zap.String("backend", cfg.Queue.Backend))
+	}
+
+	httpClient := newHTTPClient(20 * time.Second)
+
+	// task executions are limited by the timeouts of the tasks, so the
+	// clients used for task requests don't have a timeout of their own
+	taskClient := newHTTPClient(0)
 
 	oauthClient := httpClient
+	policyClient := taskClient
 	if cfg.Auth.Enabled {
 		// Create an HTTP Client which automatically issues and carries an OAuth2 token.
 		// The token will auto-refresh when its expiration is near.
 		oauthCtx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
 		oauthClient = newOAuth2Client(oauthCtx, cfg.OAuth.ClientID, cfg.OAuth.ClientSecret, cfg.OAuth.TokenURL)
+		policyCtx := context.WithValue(context.Background(), oauth2.HTTPClient, taskClient)
+		policyClient = newOAuth2Client(policyCtx, cfg.OAuth.ClientID, cfg.OAuth.ClientSecret, cfg.OAuth.TokenURL)
 	}
 
 	// create policy client
-	policy := policy.New(cfg.Policy.Addr, oauthClient)
+	policy := policy.New(cfg.Policy.Addr, policyClient)
 
 	// create cache client
 	cache := cache.New(cfg.Cache.Addr, cache.WithHTTPClient(oauthClient))
 
 	var events *event.Client
 	if cfg.Nats.Addr != "" {
-		events, err = event.New(storage, storage, cfg.Nats.Addr, cfg.Nats.Subject)
+		events, err = event.New(storage, queue, cfg.Nats.Addr, cfg.Nats.Subject)
 		if err != nil {
 			logger.Fatal("failed to create events client", zap.Error(err))
 		}
@@ -104,52 +248,122 @@ func main() {
 		logger.Info("task service is not able to subscribe for cache events")
 	}
 
+	// wake up the executors with change streams of the queue if enabled
+	var watcher service.QueueWatcher
+	if w, ok := queue.(service.QueueWatcher); ok && cfg.Queue.Watch {
+		watcher = w
+	}
+
 	// create task executor
 	executor := executor.New(
-		storage,
+		queue,
 		policy,
 		storage,
 		cache,
 		cfg.Executor.Workers,
 		cfg.Executor.PollInterval,
-		cfg.Executor.MaxTaskRetries,
-		httpClient,
+		service.RetryPolicy{
+			MaxAttempts: cfg.Executor.MaxTaskRetries,
+			BaseDelay:   cfg.Executor.RetryBaseDelay,
+			MaxDelay:    cfg.Executor.RetryMaxDelay,
+		},
+		cfg.Executor.TaskTimeout,
+		leaseOwner,
+		cfg.Lease.Duration,
+		watcher,
+		taskClient,
 		logger,
 	)
 
 	listExecutor := listexecutor.New(
-		storage,
+		queue,
 		policy,
 		storage,
 		cache,
 		cfg.ListExecutor.Workers,
 		cfg.ListExecutor.PollInterval,
-		httpClient,
+		cfg.ListExecutor.TaskTimeout,
+		cfg.ListExecutor.MaxRetries,
+		leaseOwner,
+		cfg.Lease.Duration,
+		watcher,
+		taskClient,
 		logger,
 	)
 
+	// create scheduler for recurring tasks and taskLists
+	scheduler := scheduler.New(storage, queue, cfg.Scheduler.PollInterval, logger)
+
+	// create reaper for tasks and taskLists of stopped instances
+	reaper := reaper.New(queue, cfg.Lease.ReapInterval, logger)
+
+	// create dispatcher for task and taskList completion webhooks
+	var dispatcher *webhookdispatcher.Dispatcher
+	if webhooks {
+		dispatcher = webhookdispatcher.New(
+			storage,
+			httpClient,
+			cfg.Webhook.Secret,
+			cfg.Webhook.MaxAttempts,
+			cfg.Webhook.MinBackoff,
+			cfg.Webhook.MaxBackoff,
+			cfg.Webhook.PollInterval,
+			logger,
+		)
+	}
+
 	// create services
 	var (
-		taskSvc     goatask.Service
-		taskListSvc goatasklist.Service
-		healthSvc   goahealth.Service
+		taskSvc             goatask.Service
+		taskListSvc         goatasklist.Service
+		taskTemplateSvc     goatasktemplate.Service
+		taskListTemplateSvc goatasklisttemplate.Service
+		eventTaskSvc        goaeventtask.Service
+		scheduleSvc         goaschedule.Service
+		webhookSvc          goawebhook.Service
+		deadLetterSvc       goadeadletter.Service
+		healthSvc           goahealth.Service
 	)
 	{
-		taskSvc = task.New(storage, storage, cache, logger)
-		taskListSvc = tasklist.New(storage, storage, cache, logger)
+		taskSvc = task.New(storage, queue, cache, cfg.Idempotency.Window, cfg.HTTP.MaxWait, webhooks, logger)
+		taskListSvc = tasklist.New(storage, queue, cache, cfg.Idempotency.Window, cfg.HTTP.MaxWait, webhooks, logger)
+		taskTemplateSvc = tasktemplate.New(storage, webhooks, logger)
+		taskListTemplateSvc = tasklisttemplate.New(storage, webhooks, logger)
+		eventTaskSvc = eventtask.New(storage, logger)
+		scheduleSvc = schedule.New(storage, logger)
+		webhookSvc = webhook.New(storage, logger)
+		deadLetterSvc = deadletter.New(storage, queue, logger)
 		healthSvc = health.New(Version)
 	}
 
+	if cfg.Storage.TemplatesDir != "" {
+		if err := loadTemplates(context.Background(), cfg.Storage.TemplatesDir, taskTemplateSvc, taskListTemplateSvc, logger); err != nil {
+			logger.Fatal("error loading templates", zap.Error(err))
+		}
+	}
+
 	// create endpoints
 	var (
-		taskEndpoints     *goatask.Endpoints
-		taskListEndpoints *goatasklist.Endpoints
-		healthEndpoints   *goahealth.Endpoints
-		openapiEndpoints  *openapi.Endpoints
+		taskEndpoints             *goatask.Endpoints
+		taskListEndpoints         *goatasklist.Endpoints
+		taskTemplateEndpoints     *goatasktemplate.Endpoints
+		taskListTemplateEndpoints *goatasklisttemplate.Endpoints
+		eventTaskEndpoints        *goaeventtask.Endpoints
+		scheduleEndpoints         *goaschedule.Endpoints
+		webhookEndpoints          *goawebhook.Endpoints
+		deadLetterEndpoints       *goadeadletter.Endpoints
+		healthEndpoints           *goahealth.Endpoints
+		openapiEndpoints          *openapi.Endpoints
 	)
 	{
 		taskEndpoints = goatask.NewEndpoints(taskSvc)
 		taskListEndpoints = goatasklist.NewEndpoints(taskListSvc)
+		taskTemplateEndpoints = goatasktemplate.NewEndpoints(taskTemplateSvc)
+		taskListTemplateEndpoints = goatasklisttemplate.NewEndpoints(taskListTemplateSvc)
+		eventTaskEndpoints = goaeventtask.NewEndpoints(eventTaskSvc)
+		scheduleEndpoints = goaschedule.NewEndpoints(scheduleSvc)
+		webhookEndpoints = goawebhook.NewEndpoints(webhookSvc)
+		deadLetterEndpoints = goadeadletter.NewEndpoints(deadLetterSvc)
 		healthEndpoints = goahealth.NewEndpoints(healthSvc)
 		openapiEndpoints = openapi.NewEndpoints(nil)
 	}
@@ -172,14 +386,26 @@ func main() {
 	// the service input and output data structures to HTTP requests and
 	// responses.
 	var (
-		taskServer     *goatasksrv.Server
-		taskListServer *goatasklistsrv.Server
-		healthServer   *goahealthsrv.Server
-		openapiServer  *goaopenapisrv.Server
+		taskServer             *goatasksrv.Server
+		taskListServer         *goatasklistsrv.Server
+		taskTemplateServer     *goatasktemplatesrv.Server
+		taskListTemplateServer *goatasklisttemplatesrv.Server
+		eventTaskServer        *goaeventtasksrv.Server
+		scheduleServer         *goaschedulesrv.Server
+		webhookServer          *goawebhooksrv.Server
+		deadLetterServer       *goadeadlettersrv.Server
+		healthServer           *goahealthsrv.Server
+		openapiServer          *goaopenapisrv.Server
 	)
 	{
 		taskServer = goatasksrv.New(taskEndpoints, mux, dec, enc, nil, errFormatter)
 		taskListServer = goatasklistsrv.New(taskListEndpoints, mux, dec, enc, nil, errFormatter)
+		taskTemplateServer = goatasktemplatesrv.New(taskTemplateEndpoints, mux, dec, enc, nil, errFormatter)
+		taskListTemplateServer = goatasklisttemplatesrv.New(taskListTemplateEndpoints, mux, dec, enc, nil, errFormatter)
+		eventTaskServer = goaeventtasksrv.New(eventTaskEndpoints, mux, dec, enc, nil, errFormatter)
+		scheduleServer = goaschedulesrv.New(scheduleEndpoints, mux, dec, enc, nil, errFormatter)
+		webhookServer = goawebhooksrv.New(webhookEndpoints, mux, dec, enc, nil, errFormatter)
+		deadLetterServer = goadeadlettersrv.New(deadLetterEndpoints, mux, dec, enc, nil, errFormatter)
 		healthServer = goahealthsrv.New(healthEndpoints, mux, dec, enc, nil, errFormatter)
 		openapiServer = goaopenapisrv.New(openapiEndpoints, mux, dec, enc, nil, errFormatter, nil, nil)
 	}
@@ -192,11 +418,23 @@ func main() {
 		}
 		taskServer.Use(m.Handler())
 		taskListServer.Use(m.Handler())
+		taskTemplateServer.Use(m.Handler())
+		taskListTemplateServer.Use(m.Handler())
+		eventTaskServer.Use(m.Handler())
+		scheduleServer.Use(m.Handler())
+		webhookServer.Use(m.Handler())
+		deadLetterServer.Use(m.Handler())
 	}
 
 	// Configure the mux.
 	goatasksrv.Mount(mux, taskServer)
 	goatasklistsrv.Mount(mux, taskListServer)
+	goatasktemplatesrv.Mount(mux, taskTemplateServer)
+	goatasklisttemplatesrv.Mount(mux, taskListTemplateServer)
+	goaeventtasksrv.Mount(mux, eventTaskServer)
+	goaschedulesrv.Mount(mux, scheduleServer)
+	goawebhooksrv.Mount(mux, webhookServer)
+	goadeadlettersrv.Mount(mux, deadLetterServer)
 	goahealthsrv.Mount(mux, healthServer)
 	goaopenapisrv.Mount(mux, openapiServer)
 
@@ -226,6 +464,17 @@ func main() {
 	g.Go(func() error {
 		return listExecutor.Start(ctx)
 	})
+	g.Go(func() error {
+		return scheduler.Start(ctx)
+	})
+	g.Go(func() error {
+		return reaper.Start(ctx)
+	})
+	if dispatcher != nil {
+		g.Go(func() error {
+			return dispatcher.Start(ctx)
+		})
+	}
 	if events != nil {
 		g.Go(func() error {
 			return events.Start(ctx)
@@ -259,7 +508,7 @@ func errFormatter(ctx context.Context, e error) goahttp.Statuser {
 	return service.NewErrorResponse(ctx, e)
 }
 
-func httpClient() *http.Client {
+func newHTTPClient(timeout time.Duration) *http.Client {
 	return &http.Client{
 		Transport: &http.Transport{
 			Proxy: http.ProxyFromEnvironment,
@@ -271,7 +520,7 @@ func httpClient() *http.Client {
 			TLSHandshakeTimeout: 10 * time.Second,
 			IdleConnTimeout:     60 * time.Second,
 		},
-		Timeout: 20 * time.Second,
+		Timeout: timeout,
 	}
 }
 
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goatasklisttemplatesrv "github.com/eclipse-xfsc/task-sheduler/gen/http/task_list_template/server"
+	goatasktemplatesrv "github.com/eclipse-xfsc/task-sheduler/gen/http/task_template/server"
+	goatasklisttemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_list_template"
+	goatasktemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_template"
+)
+
+// loadTemplates creates task templates from the JSON files in the "tasks"
+// subdirectory of dir and taskList templates from the JSON files in its
+// "taskLists" subdirectory. The files contain templates in the format of
+// the create requests of the API. They are decoded and validated like the
+// bodies of the create requests and created through the template services,
+// so they are validated in the same way as the API requests. Task templates are
+// created first, as taskList templates refer to them. Templates which
+// already exist are skipped.
+func loadTemplates(
+	ctx context.Context,
+	dir string,
+	tasks goatasktemplate.Service,
+	lists goatasklisttemplate.Service,
+	logger *zap.Logger,
+) error {
+	files, err := filepath.Glob(filepath.Join(dir, "tasks", "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		var body goatasktemplatesrv.CreateRequestBody
+		if err := readJSON(file, &body); err != nil {
+			return err
+		}
+		if err := goatasktemplatesrv.ValidateCreateRequestBody(&body); err != nil {
+			return fmt.Errorf("invalid task template in %s: %v", file, err)
+		}
+		template := goatasktemplatesrv.NewCreateTaskTemplate(&body)
+		if _, err := tasks.Create(ctx, template); err != nil {
+			if !errors.Is(errors.Exist, err) {
+				return fmt.Errorf("error creating task template from %s: %v", file, err)
+			}
+			logger.Info("task template already exists", zap.String("taskName", template.Name))
+			continue
+		}
+		logger.Info("task template is loaded", zap.String("taskName", template.Name))
+	}
+
+	files, err = filepath.Glob(filepath.Join(dir, "taskLists", "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		var body goatasklisttemplatesrv.CreateRequestBody
+		if err := readJSON(file, &body); err != nil {
+			return err
+		}
+		if err := goatasklisttemplatesrv.ValidateCreateRequestBody(&body); err != nil {
+			return fmt.Errorf("invalid taskList template in %s: %v", file, err)
+		}
+		template := goatasklisttemplatesrv.NewCreateTaskListTemplate(&body)
+		if _, err := lists.Create(ctx, template); err != nil {
+			if !errors.Is(errors.Exist, err) {
+				return fmt.Errorf("error creating taskList template from %s: %v", file, err)
+			}
+			logger.Info("taskList template already exists", zap.String("taskListName", template.Name))
+			continue
+		}
+		logger.Info("taskList template is loaded", zap.String("taskListName", template.Name))
+	}
+
+	return nil
+}
+
+func readJSON(file string, v interface{}) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error decoding %s: %v", file, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/memory"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/tasklisttemplate"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/tasktemplate"
+)
+
+func TestLoadTemplates(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string // files are the contents of the template files by their path
+
+		tasks   []string
+		lists   []string
+		errtext string
+	}{
+		{
+			name: "templates are loaded",
+			files: map[string]string{
+				"tasks/task1.json":     `{"name":"task1","url":"https://example.com","method":"GET"}`,
+				"tasks/task2.json":     `{"name":"task2","requestPolicy":"example/policy/1.0/evaluation","priority":5}`,
+				"taskLists/list1.json": `{"name":"list1","groups":[{"execution":"sequential","tasks":["task1","task2"]}]}`,
+			},
+			tasks: []string{"task1", "task2"},
+			lists: []string{"list1"},
+		},
+		{
+			name: "existing templates are skipped",
+			files: map[string]string{
+				"tasks/task1.json":      `{"name":"task1","url":"https://example.com","method":"GET"}`,
+				"tasks/task1-copy.json": `{"name":"task1","url":"https://example.com","method":"POST"}`,
+			},
+			tasks: []string{"task1"},
+		},
+		{
+			name: "invalid JSON",
+			files: map[string]string{
+				"tasks/task1.json": `{"name":`,
+			},
+			errtext: "error decoding",
+		},
+		{
+			name: "task template without name",
+			files: map[string]string{
+				"tasks/task1.json": `{"url":"https://example.com","method":"GET"}`,
+			},
+			errtext: `invalid task template in`,
+		},
+		{
+			name: "task template with invalid priority",
+			files: map[string]string{
+				"tasks/task1.json": `{"name":"task1","url":"https://example.com","method":"GET","priority":10}`,
+			},
+			errtext: "body.priority must be lesser or equal than 9",
+		},
+		{
+			name: "taskList template with invalid group execution",
+			files: map[string]string{
+				"tasks/task1.json":     `{"name":"task1","url":"https://example.com","method":"GET"}`,
+				"taskLists/list1.json": `{"name":"list1","groups":[{"execution":"sometimes","tasks":["task1"]}]}`,
+			},
+			errtext: "invalid taskList template in",
+		},
+		{
+			name: "taskList template with unknown task",
+			files: map[string]string{
+				"taskLists/list1.json": `{"name":"list1","groups":[{"execution":"parallel","tasks":["task1"]}]}`,
+			},
+			errtext: "error creating taskList template from",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range test.files {
+				file := filepath.Join(dir, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o755))
+				require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+			}
+
+			ctx := context.Background()
+			storage := memory.New(time.Minute)
+			err := loadTemplates(
+				ctx,
+				dir,
+				tasktemplate.New(storage, true, zap.NewNop()),
+				tasklisttemplate.New(storage, true, zap.NewNop()),
+				zap.NewNop(),
+			)
+			if test.errtext != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, name := range test.tasks {
+				_, err := storage.TaskTemplate(ctx, name)
+				assert.NoError(t, err)
+			}
+			for _, name := range test.lists {
+				_, err := storage.TaskListTemplate(ctx, name)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -25,12 +25,20 @@ var _ = Service("task", func() {
 		HTTP(func() {
 			POST("/v1/task/{taskName}")
 
+			Param("templateVersion:version")
+			Param("runAt")
+			Param("delay")
+			Param("priority")
+			Param("wait")
+			Param("callbackURL")
+
 			Header("cacheNamespace:x-cache-namespace", String, "Cache key namespace", func() {
 				Example("login")
 			})
 			Header("cacheScope:x-cache-scope", String, "Cache key scope", func() {
 				Example("user")
 			})
+			Header("idempotencyKey:Idempotency-Key")
 
 			Body("data")
 
@@ -47,6 +55,46 @@ var _ = Service("task", func() {
 			Response(StatusOK)
 		})
 	})
+
+	Method("TaskStatus", func() {
+		Description("TaskStatus retrieves the current state of a task from the queue or the history of executed tasks.")
+		Payload(TaskStatusRequest)
+		Result(TaskStatusResponse)
+		HTTP(func() {
+			GET("/v1/task/{taskID}/status")
+			Response(StatusOK)
+		})
+	})
+
+	Method("Search", func() {
+		Description("Search tasks in the queue, the history of executed tasks and the dead letters filtered by the given criteria.")
+		Payload(SearchTasksRequest)
+		Result(SearchTasksResponse)
+		HTTP(func() {
+			GET("/v1/tasks")
+
+			Param("name")
+			Param("state")
+			Param("cacheNamespace")
+			Param("cacheScope")
+			Param("createdAfter")
+			Param("createdBefore")
+			Param("sort")
+			Param("limit")
+			Param("cursor")
+
+			Response(StatusOK)
+		})
+	})
+
+	Method("Cancel", func() {
+		Description("Cancel a task which is waiting in the queue or is currently being executed.")
+		Payload(CancelTaskRequest)
+		HTTP(func() {
+			DELETE("/v1/task/{taskID}")
+			Response(StatusOK)
+		})
+	})
 })
 
 var _ = Service("taskList", func() {
@@ -59,12 +107,20 @@ var _ = Service("taskList", func() {
 		HTTP(func() {
 			POST("/v1/taskList/{taskListName}")
 
+			Param("templateVersion:version")
+			Param("runAt")
+			Param("delay")
+			Param("priority")
+			Param("wait")
+			Param("callbackURL")
+
 			Header("cacheNamespace:x-cache-namespace", String, "Cache key namespace", func() {
 				Example("login")
 			})
 			Header("cacheScope:x-cache-scope", String, "Cache key scope", func() {
 				Example("user")
 			})
+			Header("idempotencyKey:Idempotency-Key")
 
 			Body("data")
 
@@ -90,6 +146,267 @@ var _ = Service("taskList", func() {
 			})
 		})
 	})
+
+	Method("Search", func() {
+		Description("Search taskLists in the queue and the history of executed taskLists filtered by the given criteria.")
+		Payload(SearchTaskListsRequest)
+		Result(SearchTaskListsResponse)
+		HTTP(func() {
+			GET("/v1/taskLists")
+
+			Param("name")
+			Param("state")
+			Param("cacheNamespace")
+			Param("cacheScope")
+			Param("createdAfter")
+			Param("createdBefore")
+			Param("sort")
+			Param("limit")
+			Param("cursor")
+
+			Response(StatusOK)
+		})
+	})
+})
+
+var _ = Service("taskTemplate", func() {
+	Description("TaskTemplate service provides endpoints to manage task templates.")
+
+	Method("Create", func() {
+		Description("Create a task template.")
+		Payload(TaskTemplate)
+		Result(TaskTemplate)
+		HTTP(func() {
+			POST("/v1/taskTemplates")
+			Response(StatusCreated)
+		})
+	})
+
+	Method("Get", func() {
+		Description("Get a task template by name and optionally by version.")
+		Payload(TaskTemplateVersionRequest)
+		Result(TaskTemplate)
+		HTTP(func() {
+			GET("/v1/taskTemplates/{name}")
+			Param("version")
+			Response(StatusOK)
+		})
+	})
+
+	Method("Update", func() {
+		Description("Update an existing task template by creating a new version of it.")
+		Payload(TaskTemplate)
+		Result(TaskTemplate)
+		HTTP(func() {
+			PUT("/v1/taskTemplates/{name}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("Delete", func() {
+		Description("Delete a task template by name. Its versions are kept for the taskList templates using them.")
+		Payload(TaskTemplateRequest)
+		HTTP(func() {
+			DELETE("/v1/taskTemplates/{name}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("List", func() {
+		Description("List all task templates.")
+		Result(TaskTemplatesResponse)
+		HTTP(func() {
+			GET("/v1/taskTemplates")
+			Response(StatusOK)
+		})
+	})
+})
+
+var _ = Service("taskListTemplate", func() {
+	Description("TaskListTemplate service provides endpoints to manage taskList templates.")
+
+	Method("Create", func() {
+		Description("Create a taskList template.")
+		Payload(TaskListTemplate)
+		Result(TaskListTemplate)
+		HTTP(func() {
+			POST("/v1/taskListTemplates")
+			Response(StatusCreated)
+		})
+	})
+
+	Method("Get", func() {
+		Description("Get a taskList template by name and optionally by version.")
+		Payload(TaskListTemplateVersionRequest)
+		Result(TaskListTemplate)
+		HTTP(func() {
+			GET("/v1/taskListTemplates/{name}")
+			Param("version")
+			Response(StatusOK)
+		})
+	})
+
+	Method("Update", func() {
+		Description("Update an existing taskList template by creating a new version of it.")
+		Payload(TaskListTemplate)
+		Result(TaskListTemplate)
+		HTTP(func() {
+			PUT("/v1/taskListTemplates/{name}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("Delete", func() {
+		Description("Delete a taskList template by name. Its versions are kept for the taskLists created from them.")
+		Payload(TaskListTemplateRequest)
+		HTTP(func() {
+			DELETE("/v1/taskListTemplates/{name}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("List", func() {
+		Description("List all taskList templates.")
+		Result(TaskListTemplatesResponse)
+		HTTP(func() {
+			GET("/v1/taskListTemplates")
+			Response(StatusOK)
+		})
+	})
+})
+
+var _ = Service("eventTask", func() {
+	Description("EventTask service provides endpoints to manage bindings between cache events and tasks.")
+
+	Method("Create", func() {
+		Description("Create a binding which creates a task when a cache event is received.")
+		Payload(EventTask)
+		Result(EventTask)
+		HTTP(func() {
+			POST("/v1/eventTasks")
+			Response(StatusCreated)
+		})
+	})
+
+	Method("Delete", func() {
+		Description("Delete an event task binding by cache key, namespace and scope.")
+		Payload(EventTaskRequest)
+		HTTP(func() {
+			DELETE("/v1/eventTasks")
+			Param("key")
+			Param("namespace")
+			Param("scope")
+			Response(StatusOK)
+		})
+	})
+
+	Method("List", func() {
+		Description("List all event task bindings.")
+		Result(EventTasksResponse)
+		HTTP(func() {
+			GET("/v1/eventTasks")
+			Response(StatusOK)
+		})
+	})
+})
+
+var _ = Service("schedule", func() {
+	Description("Schedule service provides endpoints to manage recurring creation of tasks and taskLists.")
+
+	Method("Create", func() {
+		Description("Create a schedule for periodic creation of a task or a taskList.")
+		Payload(CreateScheduleRequest)
+		Result(Schedule)
+		HTTP(func() {
+			POST("/v1/schedules")
+			Response(StatusCreated)
+		})
+	})
+
+	Method("Get", func() {
+		Description("Get a schedule by ID.")
+		Payload(ScheduleRequest)
+		Result(Schedule)
+		HTTP(func() {
+			GET("/v1/schedules/{scheduleID}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("Delete", func() {
+		Description("Delete a schedule by ID.")
+		Payload(ScheduleRequest)
+		HTTP(func() {
+			DELETE("/v1/schedules/{scheduleID}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("List", func() {
+		Description("List all schedules.")
+		Result(SchedulesResponse)
+		HTTP(func() {
+			GET("/v1/schedules")
+			Response(StatusOK)
+		})
+	})
+})
+
+var _ = Service("webhook", func() {
+	Description("Webhook service provides endpoints to inspect the delivery of task and taskList completion webhooks.")
+
+	Method("Deliveries", func() {
+		Description("List the webhook deliveries and their attempts for a task or a taskList.")
+		Payload(WebhookDeliveriesRequest)
+		Result(WebhookDeliveriesResponse)
+		HTTP(func() {
+			GET("/v1/webhooks/{id}")
+			Response(StatusOK)
+		})
+	})
+})
+
+var _ = Service("deadLetter", func() {
+	Description("DeadLetter service provides endpoints to inspect, requeue and purge tasks which exhausted their retries.")
+
+	Method("List", func() {
+		Description("List the dead-lettered tasks from the newest to the oldest.")
+		Payload(ListDeadLettersRequest)
+		Result(DeadLettersResponse)
+		HTTP(func() {
+			GET("/v1/deadLetters")
+
+			Param("taskName")
+			Param("limit")
+
+			Response(StatusOK)
+		})
+	})
+
+	Method("Requeue", func() {
+		Description("Put a dead-lettered task back in the queue for execution, optionally with a new payload.")
+		Payload(RequeueDeadLetterRequest)
+		Result(RequeueDeadLetterResult)
+		HTTP(func() {
+			POST("/v1/deadLetters/{taskID}/requeue")
+			Body("data")
+			Response(StatusOK)
+		})
+	})
+
+	Method("Purge", func() {
+		Description("Permanently remove dead-lettered tasks filtered by the given criteria.")
+		Payload(PurgeDeadLettersRequest)
+		Result(PurgeDeadLettersResult)
+		HTTP(func() {
+			DELETE("/v1/deadLetters")
+
+			Param("taskName")
+			Param("before")
+
+			Response(StatusOK)
+		})
+	})
 })
 
 var _ = Service("health", func() {
@@ -8,11 +8,37 @@ var CreateTaskRequest = Type("CreateTaskRequest", func() {
 	Field(2, "data", Any, "Data contains JSON payload that will be used for task execution.")
 	Field(3, "cacheNamespace", String, "Cache key namespace.")
 	Field(4, "cacheScope", String, "Cache key scope.")
+	Field(5, "templateVersion", Int, "Version of the task template. The latest version is used if not set.", func() {
+		Minimum(1)
+	})
+	Field(6, "runAt", String, "Time at which the task should be executed. The task is executed immediately if not set.", func() {
+		Format(FormatDateTime)
+	})
+	Field(7, "delay", String, "Delay after which the task should be executed, e.g. 10m. Cannot be used together with runAt.", func() {
+		Example("10m")
+	})
+	Field(8, "priority", Int, "Priority of the task from 0 (lowest) to 9 (highest). The priority of the task template is used if not set.", func() {
+		Minimum(0)
+		Maximum(9)
+	})
+	Field(9, "idempotencyKey", String, "Unique key of the request. Repeated requests with the same key return the originally created task.", func() {
+		MaxLength(255)
+	})
+	Field(10, "wait", String, "Maximum time to wait for the task to complete, e.g. 5s. The response is returned right after the task is created if not set.", func() {
+		Example("5s")
+	})
+	Field(11, "callbackURL", String, "URL which is notified when the task is finished. The callback URL of the task template is used if not set.", func() {
+		Example("https://example.com/callback")
+	})
 	Required("taskName", "data")
 })
 
 var CreateTaskResult = Type("CreateTaskResult", func() {
 	Field(1, "taskID", String, "Unique task identifier.")
+	Field(2, "state", String, "State of the task at the end of the wait time. It is set only if the request waited for the task.", func() {
+		Example("done")
+	})
+	Field(3, "result", Any, "Result of the task. It is set only if the task completed within the wait time.")
 	Required("taskID")
 })
 
@@ -21,16 +47,129 @@ var TaskResultRequest = Type("TaskResultRequest", func() {
 	Required("taskID")
 })
 
+var TaskStatusRequest = Type("TaskStatusRequest", func() {
+	Field(1, "taskID", String, "Unique task identifier.")
+	Required("taskID")
+})
+
+var TaskStatusResponse = Type("TaskStatusResponse", func() {
+	Field(1, "id", String, "Unique task identifier.", func() {
+		Example("d16996cd-1977-42a9-90b2-b4548a35c1b4")
+	})
+	Field(2, "name", String, "Task name.", func() {
+		Example("exampleTask")
+	})
+	Field(3, "state", String, "Current state of the task.", func() {
+		Example("pending")
+	})
+	Field(4, "retries", Int, "Number of failed attempts to execute the task.", func() {
+		Example(0)
+	})
+	Field(5, "createdAt", String, "Task creation time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(6, "startedAt", String, "Task execution start time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(7, "finishedAt", String, "Task completion time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(8, "responseCode", Int, "Response code received after the task request is executed.", func() {
+		Example(200)
+	})
+	Field(9, "error", String, "Last error that occurred during task execution.")
+	Field(10, "cacheNamespace", String, "Cache key namespace.")
+	Field(11, "cacheScope", String, "Cache key scope.")
+	Field(12, "templateVersion", Int, "Version of the task template from which the task was created.", func() {
+		Example(1)
+	})
+	Field(13, "runAt", String, "Time at which the task is scheduled for execution.", func() {
+		Format(FormatDateTime)
+	})
+	Field(14, "priority", Int, "Priority of the task.", func() {
+		Example(0)
+	})
+	Field(15, "nextAttemptAt", String, "Time of the next execution attempt of a failed task.", func() {
+		Format(FormatDateTime)
+	})
+	Field(16, "failureReason", String, "Reason of the last failed execution of the task.", func() {
+		Enum("http", "policy", "cache", "status", "timeout")
+	})
+	Required("id", "name", "state", "retries", "createdAt")
+})
+
+var SearchTasksRequest = Type("SearchTasksRequest", func() {
+	Field(1, "name", String, "Filter tasks by name.")
+	Field(2, "state", String, "Filter tasks by state.", func() {
+		Enum("created", "pending", "done", "failed", "cancelled")
+	})
+	Field(3, "cacheNamespace", String, "Filter tasks by cache key namespace.")
+	Field(4, "cacheScope", String, "Filter tasks by cache key scope.")
+	Field(5, "createdAfter", String, "Filter tasks created at or after the given time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(6, "createdBefore", String, "Filter tasks created before the given time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(7, "sort", String, "Sort order by creation time.", func() {
+		Enum("createdAt", "-createdAt")
+		Default("-createdAt")
+	})
+	Field(8, "limit", Int, "Maximum number of tasks to return.", func() {
+		Minimum(1)
+		Maximum(100)
+		Default(20)
+	})
+	Field(9, "cursor", String, "Cursor for retrieving the next page of results.")
+})
+
+var SearchTasksResponse = Type("SearchTasksResponse", func() {
+	Field(1, "tasks", ArrayOf(TaskStatusResponse), "Array of tasks.")
+	Field(2, "next", String, "Cursor for retrieving the next page of results, if there are more.")
+	Required("tasks")
+})
+
+var CancelTaskRequest = Type("CancelTaskRequest", func() {
+	Field(1, "taskID", String, "Unique task identifier.")
+	Required("taskID")
+})
+
 var CreateTaskListRequest = Type("CreateTaskListRequest", func() {
 	Field(1, "taskListName", String, "TaskList name.")
 	Field(2, "data", Any, "Data contains JSON payload that will be used for taskList execution.")
 	Field(3, "cacheNamespace", String, "Cache key namespace.")
 	Field(4, "cacheScope", String, "Cache key scope.")
+	Field(5, "templateVersion", Int, "Version of the taskList template. The latest version is used if not set.", func() {
+		Minimum(1)
+	})
+	Field(6, "runAt", String, "Time at which the taskList should be executed. The taskList is executed immediately if not set.", func() {
+		Format(FormatDateTime)
+	})
+	Field(7, "delay", String, "Delay after which the taskList should be executed, e.g. 10m. Cannot be used together with runAt.", func() {
+		Example("10m")
+	})
+	Field(8, "priority", Int, "Priority of the taskList from 0 (lowest) to 9 (highest). The priority of the taskList template is used if not set.", func() {
+		Minimum(0)
+		Maximum(9)
+	})
+	Field(9, "idempotencyKey", String, "Unique key of the request. Repeated requests with the same key return the originally created taskList.", func() {
+		MaxLength(255)
+	})
+	Field(10, "wait", String, "Maximum time to wait for the taskList to complete, e.g. 5s. The response is returned right after the taskList is created if not set.", func() {
+		Example("5s")
+	})
+	Field(11, "callbackURL", String, "URL which is notified when the taskList is finished. The callback URL of the taskList template is used if not set.", func() {
+		Example("https://example.com/callback")
+	})
 	Required("taskListName", "data")
 })
 
 var CreateTaskListResult = Type("CreateTaskListResult", func() {
 	Field(1, "taskListID", String, "Unique taskList identifier.")
+	Field(2, "state", String, "State of the taskList at the end of the wait time. It is set only if the request waited for the taskList.", func() {
+		Example("done")
+	})
+	Field(3, "result", TaskListStatusResponse, "Result of the taskList. It is set only if the taskList completed within the wait time.")
 	Required("taskListID")
 })
 
@@ -47,9 +186,76 @@ var TaskListStatusResponse = Type("TaskListStatusResponse", func() {
 		Example("done")
 	})
 	Field(3, "groups", ArrayOf(GroupStatus), "Array of GroupStatus")
+	Field(4, "runAt", String, "Time at which the taskList is scheduled for execution.", func() {
+		Format(FormatDateTime)
+	})
 	Required("id", "status")
 })
 
+var SearchTaskListsRequest = Type("SearchTaskListsRequest", func() {
+	Field(1, "name", String, "Filter taskLists by name.")
+	Field(2, "state", String, "Filter taskLists by state.", func() {
+		Enum("created", "pending", "done", "failed")
+	})
+	Field(3, "cacheNamespace", String, "Filter taskLists by cache key namespace.")
+	Field(4, "cacheScope", String, "Filter taskLists by cache key scope.")
+	Field(5, "createdAfter", String, "Filter taskLists created at or after the given time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(6, "createdBefore", String, "Filter taskLists created before the given time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(7, "sort", String, "Sort order by creation time.", func() {
+		Enum("createdAt", "-createdAt")
+		Default("-createdAt")
+	})
+	Field(8, "limit", Int, "Maximum number of taskLists to return.", func() {
+		Minimum(1)
+		Maximum(100)
+		Default(20)
+	})
+	Field(9, "cursor", String, "Cursor for retrieving the next page of results.")
+})
+
+var SearchTaskListsResponse = Type("SearchTaskListsResponse", func() {
+	Field(1, "taskLists", ArrayOf(TaskListSummary), "Array of taskLists.")
+	Field(2, "next", String, "Cursor for retrieving the next page of results, if there are more.")
+	Required("taskLists")
+})
+
+var TaskListSummary = Type("TaskListSummary", func() {
+	Field(1, "id", String, "Unique taskList identifier.", func() {
+		Example("9cc9f504-2b7f-4e24-ac59-653e9533840a")
+	})
+	Field(2, "name", String, "TaskList name.", func() {
+		Example("example")
+	})
+	Field(3, "state", String, "Current state of the taskList.", func() {
+		Example("done")
+	})
+	Field(4, "cacheNamespace", String, "Cache key namespace.")
+	Field(5, "cacheScope", String, "Cache key scope.")
+	Field(6, "createdAt", String, "TaskList creation time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(7, "startedAt", String, "TaskList execution start time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(8, "finishedAt", String, "TaskList completion time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(9, "templateVersion", Int, "Version of the taskList template from which the taskList was created.", func() {
+		Example(1)
+	})
+	Field(10, "runAt", String, "Time at which the taskList is scheduled for execution.", func() {
+		Format(FormatDateTime)
+	})
+	Field(11, "priority", Int, "Priority of the taskList.", func() {
+		Example(0)
+	})
+	Required("id", "name", "state", "createdAt")
+})
+
 var GroupStatus = Type("GroupStatus", func() {
 	Field(1, "id", String, "Unique group identifier.", func() {
 		Example("a7d1349d-34b5-4c65-b671-d1aa362fc446")
@@ -69,6 +275,333 @@ var TaskStatus = Type("TaskStatus", func() {
 	})
 })
 
+var TaskTemplate = Type("TaskTemplate", func() {
+	Field(1, "name", String, "Task template name.", func() {
+		Example("exampleTask")
+	})
+	Field(2, "url", String, "URL against which the task request will be executed.", func() {
+		Example("https://jsonplaceholder.typicode.com/todos/1")
+	})
+	Field(3, "method", String, "HTTP method of the task request.", func() {
+		Example("GET")
+	})
+	Field(4, "requestPolicy", String, "Policy to be executed instead of the task request.", func() {
+		Example("policies/example/example/1.0")
+	})
+	Field(5, "responsePolicy", String, "Policy to be executed on the task response.")
+	Field(6, "finalPolicy", String, "Policy to be executed on the task response after the response policy.")
+	Field(7, "cacheNamespace", String, "Default cache key namespace.", func() {
+		Example("login")
+	})
+	Field(8, "cacheScope", String, "Default cache key scope.", func() {
+		Example("user")
+	})
+	Field(9, "version", Int, "Template version. It is assigned by the service and is ignored in requests.", func() {
+		Example(1)
+	})
+	Field(10, "priority", Int, "Default priority of the tasks from 0 (lowest) to 9 (highest).", func() {
+		Minimum(0)
+		Maximum(9)
+		Example(0)
+	})
+	Field(11, "callbackURL", String, "Default URL which is notified when the tasks are finished.", func() {
+		Example("https://example.com/callback")
+	})
+	Field(12, "retryPolicy", RetryPolicy, "Retry policy of the tasks. The default retry policy of the service is used if not set.")
+	Field(13, "acceptedStatusCodes", ArrayOf(String), "Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful responses to the task request. Only 2xx status codes are accepted if not set.", func() {
+		Example([]string{"2xx", "304"})
+	})
+	Field(14, "timeout", String, "Maximum duration of a task execution, e.g. 2m. The default task timeout of the service is used if not set.", func() {
+		Example("30s")
+	})
+	Required("name")
+})
+
+var RetryPolicy = Type("RetryPolicy", func() {
+	Field(1, "maxAttempts", Int, "Maximum number of task executions before the task is moved to the dead letters.", func() {
+		Minimum(1)
+		Example(5)
+	})
+	Field(2, "baseDelay", String, "Delay before the first retry, which is doubled on each following retry.", func() {
+		Example("1s")
+	})
+	Field(3, "maxDelay", String, "Maximum delay between retries.", func() {
+		Example("5m")
+	})
+	Field(4, "retryOn", ArrayOf(String, func() {
+		Enum("http", "policy", "cache", "status", "timeout")
+	}), "Reasons of the failures which are retried. All failures are retried if not set.")
+})
+
+var TaskTemplateRequest = Type("TaskTemplateRequest", func() {
+	Field(1, "name", String, "Task template name.")
+	Required("name")
+})
+
+var TaskTemplateVersionRequest = Type("TaskTemplateVersionRequest", func() {
+	Field(1, "name", String, "Task template name.")
+	Field(2, "version", Int, "Task template version. The latest version is returned if not set.", func() {
+		Minimum(1)
+	})
+	Required("name")
+})
+
+var TaskTemplatesResponse = Type("TaskTemplatesResponse", func() {
+	Field(1, "templates", ArrayOf(TaskTemplate), "Array of task templates.")
+	Required("templates")
+})
+
+var TaskListTemplate = Type("TaskListTemplate", func() {
+	Field(1, "name", String, "TaskList template name.", func() {
+		Example("example")
+	})
+	Field(2, "cacheNamespace", String, "Default cache key namespace.", func() {
+		Example("login")
+	})
+	Field(3, "cacheScope", String, "Default cache key scope.", func() {
+		Example("user")
+	})
+	Field(4, "groups", ArrayOf(GroupTemplate), "Groups of tasks executed sequentially one after another.")
+	Field(5, "version", Int, "Template version. It is assigned by the service and is ignored in requests.", func() {
+		Example(1)
+	})
+	Field(6, "priority", Int, "Default priority of the taskLists from 0 (lowest) to 9 (highest).", func() {
+		Minimum(0)
+		Maximum(9)
+		Example(0)
+	})
+	Field(7, "callbackURL", String, "Default URL which is notified when the taskLists are finished.", func() {
+		Example("https://example.com/callback")
+	})
+	Field(8, "timeout", String, "Deadline of the taskList execution, e.g. 10m. Tasks which are still running when it's exceeded are failed.", func() {
+		Example("10m")
+	})
+	Required("name", "groups")
+})
+
+var GroupTemplate = Type("GroupTemplate", func() {
+	Field(1, "execution", String, "Execution mode of the tasks within the group.", func() {
+		Enum("sequential", "parallel")
+	})
+	Field(2, "finalPolicy", String, "Policy to be executed on the group result.")
+	Field(3, "tasks", ArrayOf(String), "Names of task templates executed in the group.", func() {
+		Example([]string{"taskName1", "taskName2"})
+	})
+	Field(4, "timeout", String, "Deadline of the group execution, e.g. 5m. Tasks which are still running when it's exceeded are failed.", func() {
+		Example("5m")
+	})
+	Required("execution", "tasks")
+})
+
+var TaskListTemplateRequest = Type("TaskListTemplateRequest", func() {
+	Field(1, "name", String, "TaskList template name.")
+	Required("name")
+})
+
+var TaskListTemplateVersionRequest = Type("TaskListTemplateVersionRequest", func() {
+	Field(1, "name", String, "TaskList template name.")
+	Field(2, "version", Int, "TaskList template version. The latest version is returned if not set.", func() {
+		Minimum(1)
+	})
+	Required("name")
+})
+
+var TaskListTemplatesResponse = Type("TaskListTemplatesResponse", func() {
+	Field(1, "templates", ArrayOf(TaskListTemplate), "Array of taskList templates.")
+	Required("templates")
+})
+
+var EventTask = Type("EventTask", func() {
+	Field(1, "key", String, "Cache key of the entry for which events are received.", func() {
+		Example("did:web:did.actor:alice")
+	})
+	Field(2, "namespace", String, "Cache key namespace.", func() {
+		Example("Login")
+	})
+	Field(3, "scope", String, "Cache key scope.", func() {
+		Example("Administration")
+	})
+	Field(4, "taskName", String, "Name of the task template used to create a task when an event is received.", func() {
+		Example("exampleTask")
+	})
+	Required("key", "taskName")
+})
+
+var EventTaskRequest = Type("EventTaskRequest", func() {
+	Field(1, "key", String, "Cache key of the entry for which events are received.")
+	Field(2, "namespace", String, "Cache key namespace.")
+	Field(3, "scope", String, "Cache key scope.")
+	Required("key")
+})
+
+var EventTasksResponse = Type("EventTasksResponse", func() {
+	Field(1, "eventTasks", ArrayOf(EventTask), "Array of event task bindings.")
+	Required("eventTasks")
+})
+
+var CreateScheduleRequest = Type("CreateScheduleRequest", func() {
+	Field(1, "cron", String, "Standard cron expression with five fields (minute, hour, day of month, month, day of week).", func() {
+		Example("0 2 * * *")
+	})
+	Field(2, "timezone", String, "IANA timezone in which the cron expression is evaluated. Defaults to UTC.", func() {
+		Example("Europe/Berlin")
+	})
+	Field(3, "taskName", String, "Name of the task template to create tasks from.", func() {
+		Example("exampleTask")
+	})
+	Field(4, "taskListName", String, "Name of the taskList template to create taskLists from.")
+	Field(5, "data", Any, "Data contains JSON payload that will be used for task or taskList execution.")
+	Field(6, "cacheNamespace", String, "Cache key namespace.")
+	Field(7, "cacheScope", String, "Cache key scope.")
+	Required("cron")
+})
+
+var ScheduleRequest = Type("ScheduleRequest", func() {
+	Field(1, "scheduleID", String, "Unique schedule identifier.")
+	Required("scheduleID")
+})
+
+var Schedule = Type("Schedule", func() {
+	Field(1, "id", String, "Unique schedule identifier.", func() {
+		Example("5b7a0f5c-36f0-4a4c-8d6b-9c5c2b9e0d3e")
+	})
+	Field(2, "cron", String, "Standard cron expression.", func() {
+		Example("0 2 * * *")
+	})
+	Field(3, "timezone", String, "Timezone in which the cron expression is evaluated.", func() {
+		Example("UTC")
+	})
+	Field(4, "taskName", String, "Name of the task template to create tasks from.")
+	Field(5, "taskListName", String, "Name of the taskList template to create taskLists from.")
+	Field(6, "data", Any, "Data contains JSON payload that will be used for task or taskList execution.")
+	Field(7, "cacheNamespace", String, "Cache key namespace.")
+	Field(8, "cacheScope", String, "Cache key scope.")
+	Field(9, "nextRunAt", String, "Time of the next run.", func() {
+		Format(FormatDateTime)
+	})
+	Field(10, "lastRunAt", String, "Time of the last run.", func() {
+		Format(FormatDateTime)
+	})
+	Field(11, "createdAt", String, "Schedule creation time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(12, "runs", ArrayOf(ScheduleRun), "Most recent runs of the schedule.")
+	Required("id", "cron", "timezone", "nextRunAt", "createdAt", "runs")
+})
+
+var ScheduleRun = Type("ScheduleRun", func() {
+	Field(1, "scheduledAt", String, "Time for which the run was scheduled.", func() {
+		Format(FormatDateTime)
+	})
+	Field(2, "id", String, "Unique identifier of the created task or taskList.")
+	Required("scheduledAt", "id")
+})
+
+var SchedulesResponse = Type("SchedulesResponse", func() {
+	Field(1, "schedules", ArrayOf(Schedule), "Array of schedules.")
+	Required("schedules")
+})
+
+var WebhookDeliveriesRequest = Type("WebhookDeliveriesRequest", func() {
+	Field(1, "id", String, "Unique identifier of a task or a taskList.")
+	Required("id")
+})
+
+var WebhookDeliveriesResponse = Type("WebhookDeliveriesResponse", func() {
+	Field(1, "deliveries", ArrayOf(WebhookDelivery), "Array of webhook deliveries.")
+	Required("deliveries")
+})
+
+var WebhookDelivery = Type("WebhookDelivery", func() {
+	Field(1, "id", String, "Unique webhook delivery identifier.")
+	Field(2, "url", String, "Callback URL to which the webhook is delivered.", func() {
+		Example("https://example.com/callback")
+	})
+	Field(3, "state", String, "State of the webhook delivery.", func() {
+		Enum("pending", "delivered", "failed")
+	})
+	Field(4, "attempts", ArrayOf(WebhookAttempt), "Delivery attempts.")
+	Field(5, "nextAttemptAt", String, "Time of the next delivery attempt of a pending webhook.", func() {
+		Format(FormatDateTime)
+	})
+	Field(6, "createdAt", String, "Webhook delivery creation time.", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "url", "state", "attempts", "createdAt")
+})
+
+var WebhookAttempt = Type("WebhookAttempt", func() {
+	Field(1, "startedAt", String, "Time of the delivery attempt.", func() {
+		Format(FormatDateTime)
+	})
+	Field(2, "statusCode", Int, "Status code of the response, if one was received.", func() {
+		Example(200)
+	})
+	Field(3, "error", String, "Error of a failed delivery attempt.")
+	Required("startedAt")
+})
+
+var ListDeadLettersRequest = Type("ListDeadLettersRequest", func() {
+	Field(1, "taskName", String, "Filter dead-lettered tasks by task name.")
+	Field(2, "limit", Int, "Maximum number of dead-lettered tasks to return.", func() {
+		Minimum(1)
+		Maximum(100)
+		Default(20)
+	})
+})
+
+var DeadLettersResponse = Type("DeadLettersResponse", func() {
+	Field(1, "deadLetters", ArrayOf(DeadLetter), "Array of dead-lettered tasks.")
+	Required("deadLetters")
+})
+
+var DeadLetter = Type("DeadLetter", func() {
+	Field(1, "taskID", String, "Unique task identifier.")
+	Field(2, "taskName", String, "Task name.")
+	Field(3, "data", Any, "Data contains the JSON payload of the task.")
+	Field(4, "retries", Int, "Number of failed execution attempts.")
+	Field(5, "errors", ArrayOf(String), "Errors of the most recent failed execution attempts, the last one at the end.")
+	Field(6, "cacheNamespace", String, "Cache key namespace.")
+	Field(7, "cacheScope", String, "Cache key scope.")
+	Field(8, "createdAt", String, "Task creation time.", func() {
+		Format(FormatDateTime)
+	})
+	Field(9, "deadLetteredAt", String, "Time at which the task was removed from the queue.", func() {
+		Format(FormatDateTime)
+	})
+	Field(10, "responseCode", Int, "Status code of the last response to the task request.", func() {
+		Example(503)
+	})
+	Field(11, "response", String, "Body of the last response to the task request.")
+	Field(12, "failureReason", String, "Reason of the last failed execution of the task.", func() {
+		Enum("http", "policy", "cache", "status", "timeout")
+	})
+	Required("taskID", "taskName", "retries", "errors", "createdAt", "deadLetteredAt")
+})
+
+var RequeueDeadLetterRequest = Type("RequeueDeadLetterRequest", func() {
+	Field(1, "taskID", String, "Unique task identifier.")
+	Field(2, "data", Any, "Data contains a new JSON payload for the task. The original payload is used if not set.")
+	Required("taskID")
+})
+
+var RequeueDeadLetterResult = Type("RequeueDeadLetterResult", func() {
+	Field(1, "taskID", String, "Unique task identifier.")
+	Required("taskID")
+})
+
+var PurgeDeadLettersRequest = Type("PurgeDeadLettersRequest", func() {
+	Field(1, "taskName", String, "Purge only dead-lettered tasks with the given name.")
+	Field(2, "before", String, "Purge only tasks dead-lettered before the given time.", func() {
+		Format(FormatDateTime)
+	})
+})
+
+var PurgeDeadLettersResult = Type("PurgeDeadLettersResult", func() {
+	Field(1, "purged", Int, "Number of purged dead-lettered tasks.")
+	Required("purged")
+})
+
 var HealthResponse = Type("HealthResponse", func() {
 	Field(1, "service", String, "Service name.")
 	Field(2, "status", String, "Status message.")
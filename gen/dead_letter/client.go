@@ -0,0 +1,60 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// deadLetter client
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package deadletter
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "deadLetter" service client.
+type Client struct {
+	ListEndpoint    goa.Endpoint
+	RequeueEndpoint goa.Endpoint
+	PurgeEndpoint   goa.Endpoint
+}
+
+// NewClient initializes a "deadLetter" service client given the endpoints.
+func NewClient(list, requeue, purge goa.Endpoint) *Client {
+	return &Client{
+		ListEndpoint:    list,
+		RequeueEndpoint: requeue,
+		PurgeEndpoint:   purge,
+	}
+}
+
+// List calls the "List" endpoint of the "deadLetter" service.
+func (c *Client) List(ctx context.Context, p *ListDeadLettersRequest) (res *DeadLettersResponse, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeadLettersResponse), nil
+}
+
+// Requeue calls the "Requeue" endpoint of the "deadLetter" service.
+func (c *Client) Requeue(ctx context.Context, p *RequeueDeadLetterRequest) (res *RequeueDeadLetterResult, err error) {
+	var ires any
+	ires, err = c.RequeueEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RequeueDeadLetterResult), nil
+}
+
+// Purge calls the "Purge" endpoint of the "deadLetter" service.
+func (c *Client) Purge(ctx context.Context, p *PurgeDeadLettersRequest) (res *PurgeDeadLettersResult, err error) {
+	var ires any
+	ires, err = c.PurgeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PurgeDeadLettersResult), nil
+}
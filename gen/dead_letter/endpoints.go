@@ -0,0 +1,64 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// deadLetter endpoints
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package deadletter
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "deadLetter" service endpoints.
+type Endpoints struct {
+	List    goa.Endpoint
+	Requeue goa.Endpoint
+	Purge   goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "deadLetter" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		List:    NewListEndpoint(s),
+		Requeue: NewRequeueEndpoint(s),
+		Purge:   NewPurgeEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "deadLetter" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.List = m(e.List)
+	e.Requeue = m(e.Requeue)
+	e.Purge = m(e.Purge)
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "List" of
+// service "deadLetter".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListDeadLettersRequest)
+		return s.List(ctx, p)
+	}
+}
+
+// NewRequeueEndpoint returns an endpoint function that calls the method
+// "Requeue" of service "deadLetter".
+func NewRequeueEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RequeueDeadLetterRequest)
+		return s.Requeue(ctx, p)
+	}
+}
+
+// NewPurgeEndpoint returns an endpoint function that calls the method "Purge"
+// of service "deadLetter".
+func NewPurgeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PurgeDeadLettersRequest)
+		return s.Purge(ctx, p)
+	}
+}
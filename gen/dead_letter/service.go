@@ -0,0 +1,115 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// deadLetter service
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package deadletter
+
+import (
+	"context"
+)
+
+// DeadLetter service provides endpoints to inspect, requeue and purge tasks
+// which exhausted their retries.
+type Service interface {
+	// List the dead-lettered tasks from the newest to the oldest.
+	List(context.Context, *ListDeadLettersRequest) (res *DeadLettersResponse, err error)
+	// Put a dead-lettered task back in the queue for execution, optionally with a
+	// new payload.
+	Requeue(context.Context, *RequeueDeadLetterRequest) (res *RequeueDeadLetterResult, err error)
+	// Permanently remove dead-lettered tasks filtered by the given criteria.
+	Purge(context.Context, *PurgeDeadLettersRequest) (res *PurgeDeadLettersResult, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "task"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "deadLetter"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [3]string{"List", "Requeue", "Purge"}
+
+type DeadLetter struct {
+	// Unique task identifier.
+	TaskID string
+	// Task name.
+	TaskName string
+	// Data contains the JSON payload of the task.
+	Data any
+	// Number of failed execution attempts.
+	Retries int
+	// Errors of the most recent failed execution attempts, the last one at the end.
+	Errors []string
+	// Cache key namespace.
+	CacheNamespace *string
+	// Cache key scope.
+	CacheScope *string
+	// Task creation time.
+	CreatedAt string
+	// Time at which the task was removed from the queue.
+	DeadLetteredAt string
+	// Status code of the last response to the task request.
+	ResponseCode *int
+	// Body of the last response to the task request.
+	Response *string
+	// Reason of the last failed execution of the task.
+	FailureReason *string
+}
+
+// DeadLettersResponse is the result type of the deadLetter service List method.
+type DeadLettersResponse struct {
+	// Array of dead-lettered tasks.
+	DeadLetters []*DeadLetter
+}
+
+// ListDeadLettersRequest is the payload type of the deadLetter service List
+// method.
+type ListDeadLettersRequest struct {
+	// Filter dead-lettered tasks by task name.
+	TaskName *string
+	// Maximum number of dead-lettered tasks to return.
+	Limit int
+}
+
+// PurgeDeadLettersRequest is the payload type of the deadLetter service Purge
+// method.
+type PurgeDeadLettersRequest struct {
+	// Purge only dead-lettered tasks with the given name.
+	TaskName *string
+	// Purge only tasks dead-lettered before the given time.
+	Before *string
+}
+
+// PurgeDeadLettersResult is the result type of the deadLetter service Purge
+// method.
+type PurgeDeadLettersResult struct {
+	// Number of purged dead-lettered tasks.
+	Purged int
+}
+
+// RequeueDeadLetterRequest is the payload type of the deadLetter service
+// Requeue method.
+type RequeueDeadLetterRequest struct {
+	// Unique task identifier.
+	TaskID string
+	// Data contains a new JSON payload for the task. The original payload is used
+	// if not set.
+	Data any
+}
+
+// RequeueDeadLetterResult is the result type of the deadLetter service Requeue
+// method.
+type RequeueDeadLetterResult struct {
+	// Unique task identifier.
+	TaskID string
+}
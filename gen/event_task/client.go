@@ -0,0 +1,56 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// eventTask client
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package eventtask
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "eventTask" service client.
+type Client struct {
+	CreateEndpoint goa.Endpoint
+	DeleteEndpoint goa.Endpoint
+	ListEndpoint   goa.Endpoint
+}
+
+// NewClient initializes a "eventTask" service client given the endpoints.
+func NewClient(create, delete_, list goa.Endpoint) *Client {
+	return &Client{
+		CreateEndpoint: create,
+		DeleteEndpoint: delete_,
+		ListEndpoint:   list,
+	}
+}
+
+// Create calls the "Create" endpoint of the "eventTask" service.
+func (c *Client) Create(ctx context.Context, p *EventTask) (res *EventTask, err error) {
+	var ires any
+	ires, err = c.CreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EventTask), nil
+}
+
+// Delete calls the "Delete" endpoint of the "eventTask" service.
+func (c *Client) Delete(ctx context.Context, p *EventTaskRequest) (err error) {
+	_, err = c.DeleteEndpoint(ctx, p)
+	return
+}
+
+// List calls the "List" endpoint of the "eventTask" service.
+func (c *Client) List(ctx context.Context) (res *EventTasksResponse, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*EventTasksResponse), nil
+}
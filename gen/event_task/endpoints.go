@@ -0,0 +1,63 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// eventTask endpoints
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package eventtask
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "eventTask" service endpoints.
+type Endpoints struct {
+	Create goa.Endpoint
+	Delete goa.Endpoint
+	List   goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "eventTask" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Create: NewCreateEndpoint(s),
+		Delete: NewDeleteEndpoint(s),
+		List:   NewListEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "eventTask" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Create = m(e.Create)
+	e.Delete = m(e.Delete)
+	e.List = m(e.List)
+}
+
+// NewCreateEndpoint returns an endpoint function that calls the method
+// "Create" of service "eventTask".
+func NewCreateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*EventTask)
+		return s.Create(ctx, p)
+	}
+}
+
+// NewDeleteEndpoint returns an endpoint function that calls the method
+// "Delete" of service "eventTask".
+func NewDeleteEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*EventTaskRequest)
+		return nil, s.Delete(ctx, p)
+	}
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "List" of
+// service "eventTask".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.List(ctx)
+	}
+}
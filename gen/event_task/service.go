@@ -0,0 +1,67 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// eventTask service
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package eventtask
+
+import (
+	"context"
+)
+
+// EventTask service provides endpoints to manage bindings between cache events
+// and tasks.
+type Service interface {
+	// Create a binding which creates a task when a cache event is received.
+	Create(context.Context, *EventTask) (res *EventTask, err error)
+	// Delete an event task binding by cache key, namespace and scope.
+	Delete(context.Context, *EventTaskRequest) (err error)
+	// List all event task bindings.
+	List(context.Context) (res *EventTasksResponse, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "task"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "eventTask"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [3]string{"Create", "Delete", "List"}
+
+// EventTask is the payload type of the eventTask service Create method.
+type EventTask struct {
+	// Cache key of the entry for which events are received.
+	Key string
+	// Cache key namespace.
+	Namespace *string
+	// Cache key scope.
+	Scope *string
+	// Name of the task template used to create a task when an event is received.
+	TaskName string
+}
+
+// EventTaskRequest is the payload type of the eventTask service Delete method.
+type EventTaskRequest struct {
+	// Cache key of the entry for which events are received.
+	Key string
+	// Cache key namespace.
+	Namespace *string
+	// Cache key scope.
+	Scope *string
+}
+
+// EventTasksResponse is the result type of the eventTask service List method.
+type EventTasksResponse struct {
+	// Array of event task bindings.
+	EventTasks []*EventTask
+}
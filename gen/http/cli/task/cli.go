@@ -13,9 +13,15 @@ import (
 	"net/http"
 	"os"
 
+	deadletterc "github.com/eclipse-xfsc/task-sheduler/gen/http/dead_letter/client"
+	eventtaskc "github.com/eclipse-xfsc/task-sheduler/gen/http/event_task/client"
 	healthc "github.com/eclipse-xfsc/task-sheduler/gen/http/health/client"
+	schedulec "github.com/eclipse-xfsc/task-sheduler/gen/http/schedule/client"
 	taskc "github.com/eclipse-xfsc/task-sheduler/gen/http/task/client"
 	tasklistc "github.com/eclipse-xfsc/task-sheduler/gen/http/task_list/client"
+	tasklisttemplatec "github.com/eclipse-xfsc/task-sheduler/gen/http/task_list_template/client"
+	tasktemplatec "github.com/eclipse-xfsc/task-sheduler/gen/http/task_template/client"
+	webhookc "github.com/eclipse-xfsc/task-sheduler/gen/http/webhook/client"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -24,17 +30,95 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `task (create|task-result)
-task-list (create|task-list-status)
+	return `task (create|task-result|task-status|search|cancel)
+task-list (create|task-list-status|search)
+task-template (create|get|update|delete|list)
+task-list-template (create|get|update|delete|list)
+event-task (create|delete|list)
+schedule (create|get|delete|list)
+webhook deliveries
+dead-letter (list|requeue|purge)
 health (liveness|readiness)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` task create --body "Dolores atque error ab." --task-name "Ipsam et est accusantium." --cache-namespace "Vel odio et doloribus est quod laborum." --cache-scope "Harum aut autem aliquam dolorem non soluta."` + "\n" +
-		os.Args[0] + ` task-list create --body "Deserunt dolor et autem quidem fugiat sint." --task-list-name "Rerum quod error est esse nisi." --cache-namespace "Quaerat ut fugit voluptatem dolores deserunt in." --cache-scope "Et officiis aut."` + "\n" +
-		os.Args[0] + ` health liveness` + "\n" +
+	return os.Args[0] + ` task create --body "Impedit et voluptate ipsa repellendus omnis." --task-name "Quia culpa a sit." --template-version 8745863198695183710 --run-at "2003-07-14T19:26:22Z" --delay "10m" --priority 1 --wait "5s" --callback-url "https://example.com/callback" --cache-namespace "Molestiae dolore sed sequi voluptatibus." --cache-scope "Sit quam." --idempotency-key "clk"` + "\n" +
+		os.Args[0] + ` task-list create --body "Sit et suscipit et architecto minus." --task-list-name "Sint voluptate aut." --template-version 5652126706668142628 --run-at "1977-11-29T08:23:52Z" --delay "10m" --priority 6 --wait "5s" --callback-url "https://example.com/callback" --cache-namespace "Facere possimus." --cache-scope "Odit qui accusamus." --idempotency-key "ekm"` + "\n" +
+		os.Args[0] + ` task-template create --body '{
+      "acceptedStatusCodes": [
+         "2xx",
+         "304"
+      ],
+      "cacheNamespace": "login",
+      "cacheScope": "user",
+      "callbackURL": "https://example.com/callback",
+      "finalPolicy": "Modi architecto dolor nemo.",
+      "method": "GET",
+      "name": "exampleTask",
+      "priority": 0,
+      "requestPolicy": "policies/example/example/1.0",
+      "responsePolicy": "Illum iusto voluptatem et accusamus ex itaque.",
+      "retryPolicy": {
+         "baseDelay": "1s",
+         "maxAttempts": 5,
+         "maxDelay": "5m",
+         "retryOn": [
+            "timeout",
+            "timeout",
+            "status",
+            "cache"
+         ]
+      },
+      "timeout": "30s",
+      "url": "https://jsonplaceholder.typicode.com/todos/1",
+      "version": 1
+   }'` + "\n" +
+		os.Args[0] + ` task-list-template create --body '{
+      "cacheNamespace": "login",
+      "cacheScope": "user",
+      "callbackURL": "https://example.com/callback",
+      "groups": [
+         {
+            "execution": "parallel",
+            "finalPolicy": "Sapiente autem suscipit.",
+            "tasks": [
+               "taskName1",
+               "taskName2"
+            ],
+            "timeout": "5m"
+         },
+         {
+            "execution": "parallel",
+            "finalPolicy": "Sapiente autem suscipit.",
+            "tasks": [
+               "taskName1",
+               "taskName2"
+            ],
+            "timeout": "5m"
+         },
+         {
+            "execution": "parallel",
+            "finalPolicy": "Sapiente autem suscipit.",
+            "tasks": [
+               "taskName1",
+               "taskName2"
+            ],
+            "timeout": "5m"
+         }
+      ],
+      "name": "example",
+      "priority": 0,
+      "timeout": "10m",
+      "version": 1
+   }'` + "\n" +
+		os.Args[0] + ` event-task create --body '{
+      "key": "did:web:did.actor:alice",
+      "namespace": "Login",
+      "scope": "Administration",
+      "taskName": "exampleTask"
+   }'` + "\n" +
 		""
 }
 
@@ -50,26 +134,148 @@ func ParseEndpoint(
 	var (
 		taskFlags = flag.NewFlagSet("task", flag.ContinueOnError)
 
-		taskCreateFlags              = flag.NewFlagSet("create", flag.ExitOnError)
-		taskCreateBodyFlag           = taskCreateFlags.String("body", "REQUIRED", "")
-		taskCreateTaskNameFlag       = taskCreateFlags.String("task-name", "REQUIRED", "Task name.")
-		taskCreateCacheNamespaceFlag = taskCreateFlags.String("cache-namespace", "", "")
-		taskCreateCacheScopeFlag     = taskCreateFlags.String("cache-scope", "", "")
+		taskCreateFlags               = flag.NewFlagSet("create", flag.ExitOnError)
+		taskCreateBodyFlag            = taskCreateFlags.String("body", "REQUIRED", "")
+		taskCreateTaskNameFlag        = taskCreateFlags.String("task-name", "REQUIRED", "Task name.")
+		taskCreateTemplateVersionFlag = taskCreateFlags.String("template-version", "", "")
+		taskCreateRunAtFlag           = taskCreateFlags.String("run-at", "", "")
+		taskCreateDelayFlag           = taskCreateFlags.String("delay", "", "")
+		taskCreatePriorityFlag        = taskCreateFlags.String("priority", "", "")
+		taskCreateWaitFlag            = taskCreateFlags.String("wait", "", "")
+		taskCreateCallbackURLFlag     = taskCreateFlags.String("callback-url", "", "")
+		taskCreateCacheNamespaceFlag  = taskCreateFlags.String("cache-namespace", "", "")
+		taskCreateCacheScopeFlag      = taskCreateFlags.String("cache-scope", "", "")
+		taskCreateIdempotencyKeyFlag  = taskCreateFlags.String("idempotency-key", "", "")
 
 		taskTaskResultFlags      = flag.NewFlagSet("task-result", flag.ExitOnError)
 		taskTaskResultTaskIDFlag = taskTaskResultFlags.String("task-id", "REQUIRED", "Unique task identifier.")
 
+		taskTaskStatusFlags      = flag.NewFlagSet("task-status", flag.ExitOnError)
+		taskTaskStatusTaskIDFlag = taskTaskStatusFlags.String("task-id", "REQUIRED", "Unique task identifier.")
+
+		taskSearchFlags              = flag.NewFlagSet("search", flag.ExitOnError)
+		taskSearchNameFlag           = taskSearchFlags.String("name", "", "")
+		taskSearchStateFlag          = taskSearchFlags.String("state", "", "")
+		taskSearchCacheNamespaceFlag = taskSearchFlags.String("cache-namespace", "", "")
+		taskSearchCacheScopeFlag     = taskSearchFlags.String("cache-scope", "", "")
+		taskSearchCreatedAfterFlag   = taskSearchFlags.String("created-after", "", "")
+		taskSearchCreatedBeforeFlag  = taskSearchFlags.String("created-before", "", "")
+		taskSearchSortFlag           = taskSearchFlags.String("sort", "-createdAt", "")
+		taskSearchLimitFlag          = taskSearchFlags.String("limit", "20", "")
+		taskSearchCursorFlag         = taskSearchFlags.String("cursor", "", "")
+
+		taskCancelFlags      = flag.NewFlagSet("cancel", flag.ExitOnError)
+		taskCancelTaskIDFlag = taskCancelFlags.String("task-id", "REQUIRED", "Unique task identifier.")
+
 		taskListFlags = flag.NewFlagSet("task-list", flag.ContinueOnError)
 
-		taskListCreateFlags              = flag.NewFlagSet("create", flag.ExitOnError)
-		taskListCreateBodyFlag           = taskListCreateFlags.String("body", "REQUIRED", "")
-		taskListCreateTaskListNameFlag   = taskListCreateFlags.String("task-list-name", "REQUIRED", "TaskList name.")
-		taskListCreateCacheNamespaceFlag = taskListCreateFlags.String("cache-namespace", "", "")
-		taskListCreateCacheScopeFlag     = taskListCreateFlags.String("cache-scope", "", "")
+		taskListCreateFlags               = flag.NewFlagSet("create", flag.ExitOnError)
+		taskListCreateBodyFlag            = taskListCreateFlags.String("body", "REQUIRED", "")
+		taskListCreateTaskListNameFlag    = taskListCreateFlags.String("task-list-name", "REQUIRED", "TaskList name.")
+		taskListCreateTemplateVersionFlag = taskListCreateFlags.String("template-version", "", "")
+		taskListCreateRunAtFlag           = taskListCreateFlags.String("run-at", "", "")
+		taskListCreateDelayFlag           = taskListCreateFlags.String("delay", "", "")
+		taskListCreatePriorityFlag        = taskListCreateFlags.String("priority", "", "")
+		taskListCreateWaitFlag            = taskListCreateFlags.String("wait", "", "")
+		taskListCreateCallbackURLFlag     = taskListCreateFlags.String("callback-url", "", "")
+		taskListCreateCacheNamespaceFlag  = taskListCreateFlags.String("cache-namespace", "", "")
+		taskListCreateCacheScopeFlag      = taskListCreateFlags.String("cache-scope", "", "")
+		taskListCreateIdempotencyKeyFlag  = taskListCreateFlags.String("idempotency-key", "", "")
 
 		taskListTaskListStatusFlags          = flag.NewFlagSet("task-list-status", flag.ExitOnError)
 		taskListTaskListStatusTaskListIDFlag = taskListTaskListStatusFlags.String("task-list-id", "REQUIRED", "Unique taskList identifier.")
 
+		taskListSearchFlags              = flag.NewFlagSet("search", flag.ExitOnError)
+		taskListSearchNameFlag           = taskListSearchFlags.String("name", "", "")
+		taskListSearchStateFlag          = taskListSearchFlags.String("state", "", "")
+		taskListSearchCacheNamespaceFlag = taskListSearchFlags.String("cache-namespace", "", "")
+		taskListSearchCacheScopeFlag     = taskListSearchFlags.String("cache-scope", "", "")
+		taskListSearchCreatedAfterFlag   = taskListSearchFlags.String("created-after", "", "")
+		taskListSearchCreatedBeforeFlag  = taskListSearchFlags.String("created-before", "", "")
+		taskListSearchSortFlag           = taskListSearchFlags.String("sort", "-createdAt", "")
+		taskListSearchLimitFlag          = taskListSearchFlags.String("limit", "20", "")
+		taskListSearchCursorFlag         = taskListSearchFlags.String("cursor", "", "")
+
+		taskTemplateFlags = flag.NewFlagSet("task-template", flag.ContinueOnError)
+
+		taskTemplateCreateFlags    = flag.NewFlagSet("create", flag.ExitOnError)
+		taskTemplateCreateBodyFlag = taskTemplateCreateFlags.String("body", "REQUIRED", "")
+
+		taskTemplateGetFlags       = flag.NewFlagSet("get", flag.ExitOnError)
+		taskTemplateGetNameFlag    = taskTemplateGetFlags.String("name", "REQUIRED", "Task template name.")
+		taskTemplateGetVersionFlag = taskTemplateGetFlags.String("version", "", "")
+
+		taskTemplateUpdateFlags    = flag.NewFlagSet("update", flag.ExitOnError)
+		taskTemplateUpdateBodyFlag = taskTemplateUpdateFlags.String("body", "REQUIRED", "")
+		taskTemplateUpdateNameFlag = taskTemplateUpdateFlags.String("name", "REQUIRED", "Task template name.")
+
+		taskTemplateDeleteFlags    = flag.NewFlagSet("delete", flag.ExitOnError)
+		taskTemplateDeleteNameFlag = taskTemplateDeleteFlags.String("name", "REQUIRED", "Task template name.")
+
+		taskTemplateListFlags = flag.NewFlagSet("list", flag.ExitOnError)
+
+		taskListTemplateFlags = flag.NewFlagSet("task-list-template", flag.ContinueOnError)
+
+		taskListTemplateCreateFlags    = flag.NewFlagSet("create", flag.ExitOnError)
+		taskListTemplateCreateBodyFlag = taskListTemplateCreateFlags.String("body", "REQUIRED", "")
+
+		taskListTemplateGetFlags       = flag.NewFlagSet("get", flag.ExitOnError)
+		taskListTemplateGetNameFlag    = taskListTemplateGetFlags.String("name", "REQUIRED", "TaskList template name.")
+		taskListTemplateGetVersionFlag = taskListTemplateGetFlags.String("version", "", "")
+
+		taskListTemplateUpdateFlags    = flag.NewFlagSet("update", flag.ExitOnError)
+		taskListTemplateUpdateBodyFlag = taskListTemplateUpdateFlags.String("body", "REQUIRED", "")
+		taskListTemplateUpdateNameFlag = taskListTemplateUpdateFlags.String("name", "REQUIRED", "TaskList template name.")
+
+		taskListTemplateDeleteFlags    = flag.NewFlagSet("delete", flag.ExitOnError)
+		taskListTemplateDeleteNameFlag = taskListTemplateDeleteFlags.String("name", "REQUIRED", "TaskList template name.")
+
+		taskListTemplateListFlags = flag.NewFlagSet("list", flag.ExitOnError)
+
+		eventTaskFlags = flag.NewFlagSet("event-task", flag.ContinueOnError)
+
+		eventTaskCreateFlags    = flag.NewFlagSet("create", flag.ExitOnError)
+		eventTaskCreateBodyFlag = eventTaskCreateFlags.String("body", "REQUIRED", "")
+
+		eventTaskDeleteFlags         = flag.NewFlagSet("delete", flag.ExitOnError)
+		eventTaskDeleteKeyFlag       = eventTaskDeleteFlags.String("key", "REQUIRED", "")
+		eventTaskDeleteNamespaceFlag = eventTaskDeleteFlags.String("namespace", "", "")
+		eventTaskDeleteScopeFlag     = eventTaskDeleteFlags.String("scope", "", "")
+
+		eventTaskListFlags = flag.NewFlagSet("list", flag.ExitOnError)
+
+		scheduleFlags = flag.NewFlagSet("schedule", flag.ContinueOnError)
+
+		scheduleCreateFlags    = flag.NewFlagSet("create", flag.ExitOnError)
+		scheduleCreateBodyFlag = scheduleCreateFlags.String("body", "REQUIRED", "")
+
+		scheduleGetFlags          = flag.NewFlagSet("get", flag.ExitOnError)
+		scheduleGetScheduleIDFlag = scheduleGetFlags.String("schedule-id", "REQUIRED", "Unique schedule identifier.")
+
+		scheduleDeleteFlags          = flag.NewFlagSet("delete", flag.ExitOnError)
+		scheduleDeleteScheduleIDFlag = scheduleDeleteFlags.String("schedule-id", "REQUIRED", "Unique schedule identifier.")
+
+		scheduleListFlags = flag.NewFlagSet("list", flag.ExitOnError)
+
+		webhookFlags = flag.NewFlagSet("webhook", flag.ContinueOnError)
+
+		webhookDeliveriesFlags  = flag.NewFlagSet("deliveries", flag.ExitOnError)
+		webhookDeliveriesIDFlag = webhookDeliveriesFlags.String("id", "REQUIRED", "Unique identifier of a task or a taskList.")
+
+		deadLetterFlags = flag.NewFlagSet("dead-letter", flag.ContinueOnError)
+
+		deadLetterListFlags        = flag.NewFlagSet("list", flag.ExitOnError)
+		deadLetterListTaskNameFlag = deadLetterListFlags.String("task-name", "", "")
+		deadLetterListLimitFlag    = deadLetterListFlags.String("limit", "20", "")
+
+		deadLetterRequeueFlags      = flag.NewFlagSet("requeue", flag.ExitOnError)
+		deadLetterRequeueBodyFlag   = deadLetterRequeueFlags.String("body", "REQUIRED", "")
+		deadLetterRequeueTaskIDFlag = deadLetterRequeueFlags.String("task-id", "REQUIRED", "Unique task identifier.")
+
+		deadLetterPurgeFlags        = flag.NewFlagSet("purge", flag.ExitOnError)
+		deadLetterPurgeTaskNameFlag = deadLetterPurgeFlags.String("task-name", "", "")
+		deadLetterPurgeBeforeFlag   = deadLetterPurgeFlags.String("before", "", "")
+
 		healthFlags = flag.NewFlagSet("health", flag.ContinueOnError)
 
 		healthLivenessFlags = flag.NewFlagSet("liveness", flag.ExitOnError)
@@ -79,10 +285,47 @@ func ParseEndpoint(
 	taskFlags.Usage = taskUsage
 	taskCreateFlags.Usage = taskCreateUsage
 	taskTaskResultFlags.Usage = taskTaskResultUsage
+	taskTaskStatusFlags.Usage = taskTaskStatusUsage
+	taskSearchFlags.Usage = taskSearchUsage
+	taskCancelFlags.Usage = taskCancelUsage
 
 	taskListFlags.Usage = taskListUsage
 	taskListCreateFlags.Usage = taskListCreateUsage
 	taskListTaskListStatusFlags.Usage = taskListTaskListStatusUsage
+	taskListSearchFlags.Usage = taskListSearchUsage
+
+	taskTemplateFlags.Usage = taskTemplateUsage
+	taskTemplateCreateFlags.Usage = taskTemplateCreateUsage
+	taskTemplateGetFlags.Usage = taskTemplateGetUsage
+	taskTemplateUpdateFlags.Usage = taskTemplateUpdateUsage
+	taskTemplateDeleteFlags.Usage = taskTemplateDeleteUsage
+	taskTemplateListFlags.Usage = taskTemplateListUsage
+
+	taskListTemplateFlags.Usage = taskListTemplateUsage
+	taskListTemplateCreateFlags.Usage = taskListTemplateCreateUsage
+	taskListTemplateGetFlags.Usage = taskListTemplateGetUsage
+	taskListTemplateUpdateFlags.Usage = taskListTemplateUpdateUsage
+	taskListTemplateDeleteFlags.Usage = taskListTemplateDeleteUsage
+	taskListTemplateListFlags.Usage = taskListTemplateListUsage
+
+	eventTaskFlags.Usage = eventTaskUsage
+	eventTaskCreateFlags.Usage = eventTaskCreateUsage
+	eventTaskDeleteFlags.Usage = eventTaskDeleteUsage
+	eventTaskListFlags.Usage = eventTaskListUsage
+
+	scheduleFlags.Usage = scheduleUsage
+	scheduleCreateFlags.Usage = scheduleCreateUsage
+	scheduleGetFlags.Usage = scheduleGetUsage
+	scheduleDeleteFlags.Usage = scheduleDeleteUsage
+	scheduleListFlags.Usage = scheduleListUsage
+
+	webhookFlags.Usage = webhookUsage
+	webhookDeliveriesFlags.Usage = webhookDeliveriesUsage
+
+	deadLetterFlags.Usage = deadLetterUsage
+	deadLetterListFlags.Usage = deadLetterListUsage
+	deadLetterRequeueFlags.Usage = deadLetterRequeueUsage
+	deadLetterPurgeFlags.Usage = deadLetterPurgeUsage
 
 	healthFlags.Usage = healthUsage
 	healthLivenessFlags.Usage = healthLivenessUsage
@@ -107,6 +350,18 @@ func ParseEndpoint(
 			svcf = taskFlags
 		case "task-list":
 			svcf = taskListFlags
+		case "task-template":
+			svcf = taskTemplateFlags
+		case "task-list-template":
+			svcf = taskListTemplateFlags
+		case "event-task":
+			svcf = eventTaskFlags
+		case "schedule":
+			svcf = scheduleFlags
+		case "webhook":
+			svcf = webhookFlags
+		case "dead-letter":
+			svcf = deadLetterFlags
 		case "health":
 			svcf = healthFlags
 		default:
@@ -132,6 +387,15 @@ func ParseEndpoint(
 			case "task-result":
 				epf = taskTaskResultFlags
 
+			case "task-status":
+				epf = taskTaskStatusFlags
+
+			case "search":
+				epf = taskSearchFlags
+
+			case "cancel":
+				epf = taskCancelFlags
+
 			}
 
 		case "task-list":
@@ -142,6 +406,96 @@ func ParseEndpoint(
 			case "task-list-status":
 				epf = taskListTaskListStatusFlags
 
+			case "search":
+				epf = taskListSearchFlags
+
+			}
+
+		case "task-template":
+			switch epn {
+			case "create":
+				epf = taskTemplateCreateFlags
+
+			case "get":
+				epf = taskTemplateGetFlags
+
+			case "update":
+				epf = taskTemplateUpdateFlags
+
+			case "delete":
+				epf = taskTemplateDeleteFlags
+
+			case "list":
+				epf = taskTemplateListFlags
+
+			}
+
+		case "task-list-template":
+			switch epn {
+			case "create":
+				epf = taskListTemplateCreateFlags
+
+			case "get":
+				epf = taskListTemplateGetFlags
+
+			case "update":
+				epf = taskListTemplateUpdateFlags
+
+			case "delete":
+				epf = taskListTemplateDeleteFlags
+
+			case "list":
+				epf = taskListTemplateListFlags
+
+			}
+
+		case "event-task":
+			switch epn {
+			case "create":
+				epf = eventTaskCreateFlags
+
+			case "delete":
+				epf = eventTaskDeleteFlags
+
+			case "list":
+				epf = eventTaskListFlags
+
+			}
+
+		case "schedule":
+			switch epn {
+			case "create":
+				epf = scheduleCreateFlags
+
+			case "get":
+				epf = scheduleGetFlags
+
+			case "delete":
+				epf = scheduleDeleteFlags
+
+			case "list":
+				epf = scheduleListFlags
+
+			}
+
+		case "webhook":
+			switch epn {
+			case "deliveries":
+				epf = webhookDeliveriesFlags
+
+			}
+
+		case "dead-letter":
+			switch epn {
+			case "list":
+				epf = deadLetterListFlags
+
+			case "requeue":
+				epf = deadLetterRequeueFlags
+
+			case "purge":
+				epf = deadLetterPurgeFlags
+
 			}
 
 		case "health":
@@ -179,20 +533,115 @@ func ParseEndpoint(
 			switch epn {
 			case "create":
 				endpoint = c.Create()
-				data, err = taskc.BuildCreatePayload(*taskCreateBodyFlag, *taskCreateTaskNameFlag, *taskCreateCacheNamespaceFlag, *taskCreateCacheScopeFlag)
+				data, err = taskc.BuildCreatePayload(*taskCreateBodyFlag, *taskCreateTaskNameFlag, *taskCreateTemplateVersionFlag, *taskCreateRunAtFlag, *taskCreateDelayFlag, *taskCreatePriorityFlag, *taskCreateWaitFlag, *taskCreateCallbackURLFlag, *taskCreateCacheNamespaceFlag, *taskCreateCacheScopeFlag, *taskCreateIdempotencyKeyFlag)
 			case "task-result":
 				endpoint = c.TaskResult()
 				data, err = taskc.BuildTaskResultPayload(*taskTaskResultTaskIDFlag)
+			case "task-status":
+				endpoint = c.TaskStatus()
+				data, err = taskc.BuildTaskStatusPayload(*taskTaskStatusTaskIDFlag)
+			case "search":
+				endpoint = c.Search()
+				data, err = taskc.BuildSearchPayload(*taskSearchNameFlag, *taskSearchStateFlag, *taskSearchCacheNamespaceFlag, *taskSearchCacheScopeFlag, *taskSearchCreatedAfterFlag, *taskSearchCreatedBeforeFlag, *taskSearchSortFlag, *taskSearchLimitFlag, *taskSearchCursorFlag)
+			case "cancel":
+				endpoint = c.Cancel()
+				data, err = taskc.BuildCancelPayload(*taskCancelTaskIDFlag)
 			}
 		case "task-list":
 			c := tasklistc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
 			case "create":
 				endpoint = c.Create()
-				data, err = tasklistc.BuildCreatePayload(*taskListCreateBodyFlag, *taskListCreateTaskListNameFlag, *taskListCreateCacheNamespaceFlag, *taskListCreateCacheScopeFlag)
+				data, err = tasklistc.BuildCreatePayload(*taskListCreateBodyFlag, *taskListCreateTaskListNameFlag, *taskListCreateTemplateVersionFlag, *taskListCreateRunAtFlag, *taskListCreateDelayFlag, *taskListCreatePriorityFlag, *taskListCreateWaitFlag, *taskListCreateCallbackURLFlag, *taskListCreateCacheNamespaceFlag, *taskListCreateCacheScopeFlag, *taskListCreateIdempotencyKeyFlag)
 			case "task-list-status":
 				endpoint = c.TaskListStatus()
 				data, err = tasklistc.BuildTaskListStatusPayload(*taskListTaskListStatusTaskListIDFlag)
+			case "search":
+				endpoint = c.Search()
+				data, err = tasklistc.BuildSearchPayload(*taskListSearchNameFlag, *taskListSearchStateFlag, *taskListSearchCacheNamespaceFlag, *taskListSearchCacheScopeFlag, *taskListSearchCreatedAfterFlag, *taskListSearchCreatedBeforeFlag, *taskListSearchSortFlag, *taskListSearchLimitFlag, *taskListSearchCursorFlag)
+			}
+		case "task-template":
+			c := tasktemplatec.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create":
+				endpoint = c.Create()
+				data, err = tasktemplatec.BuildCreatePayload(*taskTemplateCreateBodyFlag)
+			case "get":
+				endpoint = c.Get()
+				data, err = tasktemplatec.BuildGetPayload(*taskTemplateGetNameFlag, *taskTemplateGetVersionFlag)
+			case "update":
+				endpoint = c.Update()
+				data, err = tasktemplatec.BuildUpdatePayload(*taskTemplateUpdateBodyFlag, *taskTemplateUpdateNameFlag)
+			case "delete":
+				endpoint = c.Delete()
+				data, err = tasktemplatec.BuildDeletePayload(*taskTemplateDeleteNameFlag)
+			case "list":
+				endpoint = c.List()
+			}
+		case "task-list-template":
+			c := tasklisttemplatec.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create":
+				endpoint = c.Create()
+				data, err = tasklisttemplatec.BuildCreatePayload(*taskListTemplateCreateBodyFlag)
+			case "get":
+				endpoint = c.Get()
+				data, err = tasklisttemplatec.BuildGetPayload(*taskListTemplateGetNameFlag, *taskListTemplateGetVersionFlag)
+			case "update":
+				endpoint = c.Update()
+				data, err = tasklisttemplatec.BuildUpdatePayload(*taskListTemplateUpdateBodyFlag, *taskListTemplateUpdateNameFlag)
+			case "delete":
+				endpoint = c.Delete()
+				data, err = tasklisttemplatec.BuildDeletePayload(*taskListTemplateDeleteNameFlag)
+			case "list":
+				endpoint = c.List()
+			}
+		case "event-task":
+			c := eventtaskc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create":
+				endpoint = c.Create()
+				data, err = eventtaskc.BuildCreatePayload(*eventTaskCreateBodyFlag)
+			case "delete":
+				endpoint = c.Delete()
+				data, err = eventtaskc.BuildDeletePayload(*eventTaskDeleteKeyFlag, *eventTaskDeleteNamespaceFlag, *eventTaskDeleteScopeFlag)
+			case "list":
+				endpoint = c.List()
+			}
+		case "schedule":
+			c := schedulec.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create":
+				endpoint = c.Create()
+				data, err = schedulec.BuildCreatePayload(*scheduleCreateBodyFlag)
+			case "get":
+				endpoint = c.Get()
+				data, err = schedulec.BuildGetPayload(*scheduleGetScheduleIDFlag)
+			case "delete":
+				endpoint = c.Delete()
+				data, err = schedulec.BuildDeletePayload(*scheduleDeleteScheduleIDFlag)
+			case "list":
+				endpoint = c.List()
+			}
+		case "webhook":
+			c := webhookc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "deliveries":
+				endpoint = c.Deliveries()
+				data, err = webhookc.BuildDeliveriesPayload(*webhookDeliveriesIDFlag)
+			}
+		case "dead-letter":
+			c := deadletterc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data, err = deadletterc.BuildListPayload(*deadLetterListTaskNameFlag, *deadLetterListLimitFlag)
+			case "requeue":
+				endpoint = c.Requeue()
+				data, err = deadletterc.BuildRequeuePayload(*deadLetterRequeueBodyFlag, *deadLetterRequeueTaskIDFlag)
+			case "purge":
+				endpoint = c.Purge()
+				data, err = deadletterc.BuildPurgePayload(*deadLetterPurgeTaskNameFlag, *deadLetterPurgeBeforeFlag)
 			}
 		case "health":
 			c := healthc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -220,22 +669,32 @@ Usage:
 COMMAND:
     create: Create a task and put it in a queue for execution.
     task-result: TaskResult retrieves task result from the Cache service.
+    task-status: TaskStatus retrieves the current state of a task from the queue or the history of executed tasks.
+    search: Search tasks in the queue, the history of executed tasks and the dead letters filtered by the given criteria.
+    cancel: Cancel a task which is waiting in the queue or is currently being executed.
 
 Additional help:
     %[1]s task COMMAND --help
 `, os.Args[0])
 }
 func taskCreateUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] task create -body JSON -task-name STRING -cache-namespace STRING -cache-scope STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task create -body JSON -task-name STRING -template-version INT -run-at STRING -delay STRING -priority INT -wait STRING -callback-url STRING -cache-namespace STRING -cache-scope STRING -idempotency-key STRING
 
 Create a task and put it in a queue for execution.
     -body JSON: 
     -task-name STRING: Task name.
+    -template-version INT: 
+    -run-at STRING: 
+    -delay STRING: 
+    -priority INT: 
+    -wait STRING: 
+    -callback-url STRING: 
     -cache-namespace STRING: 
     -cache-scope STRING: 
+    -idempotency-key STRING: 
 
 Example:
-    %[1]s task create --body "Dolores atque error ab." --task-name "Ipsam et est accusantium." --cache-namespace "Vel odio et doloribus est quod laborum." --cache-scope "Harum aut autem aliquam dolorem non soluta."
+    %[1]s task create --body "Impedit et voluptate ipsa repellendus omnis." --task-name "Quia culpa a sit." --template-version 8745863198695183710 --run-at "2003-07-14T19:26:22Z" --delay "10m" --priority 1 --wait "5s" --callback-url "https://example.com/callback" --cache-namespace "Molestiae dolore sed sequi voluptatibus." --cache-scope "Sit quam." --idempotency-key "clk"
 `, os.Args[0])
 }
 
@@ -246,7 +705,48 @@ TaskResult retrieves task result from the Cache service.
     -task-id STRING: Unique task identifier.
 
 Example:
-    %[1]s task task-result --task-id "Dolorem illo officiis ipsa impedit harum et."
+    %[1]s task task-result --task-id "Nesciunt quaerat quos architecto magnam optio."
+`, os.Args[0])
+}
+
+func taskTaskStatusUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task task-status -task-id STRING
+
+TaskStatus retrieves the current state of a task from the queue or the history of executed tasks.
+    -task-id STRING: Unique task identifier.
+
+Example:
+    %[1]s task task-status --task-id "Rem ab."
+`, os.Args[0])
+}
+
+func taskSearchUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task search -name STRING -state STRING -cache-namespace STRING -cache-scope STRING -created-after STRING -created-before STRING -sort STRING -limit INT -cursor STRING
+
+Search tasks in the queue, the history of executed tasks and the dead letters filtered by the given criteria.
+    -name STRING: 
+    -state STRING: 
+    -cache-namespace STRING: 
+    -cache-scope STRING: 
+    -created-after STRING: 
+    -created-before STRING: 
+    -sort STRING: 
+    -limit INT: 
+    -cursor STRING: 
+
+Example:
+    %[1]s task search --name "Libero animi quia est labore deleniti." --state "created" --cache-namespace "Harum quo quo adipisci laborum." --cache-scope "Enim deleniti possimus." --created-after "1986-10-20T22:23:05Z" --created-before "1980-03-15T16:09:07Z" --sort "createdAt" --limit 71 --cursor "Iste pariatur ut consectetur."
+`, os.Args[0])
+}
+
+func taskCancelUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task cancel -task-id STRING
+
+Cancel a task which is waiting in the queue or is currently being executed.
+    -task-id STRING: Unique task identifier.
+
+Example:
+    %[1]s task cancel --task-id "Nam nulla similique sapiente dolorem blanditiis fugiat."
 `, os.Args[0])
 }
 
@@ -260,22 +760,30 @@ Usage:
 COMMAND:
     create: Create a task list and corresponding tasks and put them in respective queues for execution.
     task-list-status: TaskListStatus retrieves a taskList status containing all tasks' unique IDs and statuses from the Cache service.
+    search: Search taskLists in the queue and the history of executed taskLists filtered by the given criteria.
 
 Additional help:
     %[1]s task-list COMMAND --help
 `, os.Args[0])
 }
 func taskListCreateUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-list create -body JSON -task-list-name STRING -cache-namespace STRING -cache-scope STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-list create -body JSON -task-list-name STRING -template-version INT -run-at STRING -delay STRING -priority INT -wait STRING -callback-url STRING -cache-namespace STRING -cache-scope STRING -idempotency-key STRING
 
 Create a task list and corresponding tasks and put them in respective queues for execution.
     -body JSON: 
     -task-list-name STRING: TaskList name.
+    -template-version INT: 
+    -run-at STRING: 
+    -delay STRING: 
+    -priority INT: 
+    -wait STRING: 
+    -callback-url STRING: 
     -cache-namespace STRING: 
     -cache-scope STRING: 
+    -idempotency-key STRING: 
 
 Example:
-    %[1]s task-list create --body "Deserunt dolor et autem quidem fugiat sint." --task-list-name "Rerum quod error est esse nisi." --cache-namespace "Quaerat ut fugit voluptatem dolores deserunt in." --cache-scope "Et officiis aut."
+    %[1]s task-list create --body "Sit et suscipit et architecto minus." --task-list-name "Sint voluptate aut." --template-version 5652126706668142628 --run-at "1977-11-29T08:23:52Z" --delay "10m" --priority 6 --wait "5s" --callback-url "https://example.com/callback" --cache-namespace "Facere possimus." --cache-scope "Odit qui accusamus." --idempotency-key "ekm"
 `, os.Args[0])
 }
 
@@ -286,7 +794,500 @@ TaskListStatus retrieves a taskList status containing all tasks' unique IDs and
     -task-list-id STRING: Unique taskList identifier.
 
 Example:
-    %[1]s task-list task-list-status --task-list-id "Facilis distinctio asperiores ut architecto ducimus."
+    %[1]s task-list task-list-status --task-list-id "Amet corporis."
+`, os.Args[0])
+}
+
+func taskListSearchUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-list search -name STRING -state STRING -cache-namespace STRING -cache-scope STRING -created-after STRING -created-before STRING -sort STRING -limit INT -cursor STRING
+
+Search taskLists in the queue and the history of executed taskLists filtered by the given criteria.
+    -name STRING: 
+    -state STRING: 
+    -cache-namespace STRING: 
+    -cache-scope STRING: 
+    -created-after STRING: 
+    -created-before STRING: 
+    -sort STRING: 
+    -limit INT: 
+    -cursor STRING: 
+
+Example:
+    %[1]s task-list search --name "Nisi fugit molestias quis qui." --state "done" --cache-namespace "Voluptas aut iure fugiat cum quasi omnis." --cache-scope "Non iusto debitis." --created-after "1972-08-29T06:39:34Z" --created-before "1998-12-18T13:19:06Z" --sort "createdAt" --limit 69 --cursor "Exercitationem distinctio unde."
+`, os.Args[0])
+}
+
+// taskTemplateUsage displays the usage of the task-template command and its
+// subcommands.
+func taskTemplateUsage() {
+	fmt.Fprintf(os.Stderr, `TaskTemplate service provides endpoints to manage task templates.
+Usage:
+    %[1]s [globalflags] task-template COMMAND [flags]
+
+COMMAND:
+    create: Create a task template.
+    get: Get a task template by name and optionally by version.
+    update: Update an existing task template by creating a new version of it.
+    delete: Delete a task template by name. Its versions are kept for the taskList templates using them.
+    list: List all task templates.
+
+Additional help:
+    %[1]s task-template COMMAND --help
+`, os.Args[0])
+}
+func taskTemplateCreateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-template create -body JSON
+
+Create a task template.
+    -body JSON: 
+
+Example:
+    %[1]s task-template create --body '{
+      "acceptedStatusCodes": [
+         "2xx",
+         "304"
+      ],
+      "cacheNamespace": "login",
+      "cacheScope": "user",
+      "callbackURL": "https://example.com/callback",
+      "finalPolicy": "Modi architecto dolor nemo.",
+      "method": "GET",
+      "name": "exampleTask",
+      "priority": 0,
+      "requestPolicy": "policies/example/example/1.0",
+      "responsePolicy": "Illum iusto voluptatem et accusamus ex itaque.",
+      "retryPolicy": {
+         "baseDelay": "1s",
+         "maxAttempts": 5,
+         "maxDelay": "5m",
+         "retryOn": [
+            "timeout",
+            "timeout",
+            "status",
+            "cache"
+         ]
+      },
+      "timeout": "30s",
+      "url": "https://jsonplaceholder.typicode.com/todos/1",
+      "version": 1
+   }'
+`, os.Args[0])
+}
+
+func taskTemplateGetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-template get -name STRING -version INT
+
+Get a task template by name and optionally by version.
+    -name STRING: Task template name.
+    -version INT: 
+
+Example:
+    %[1]s task-template get --name "Odit autem dolore temporibus ducimus." --version 5634434681577374307
+`, os.Args[0])
+}
+
+func taskTemplateUpdateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-template update -body JSON -name STRING
+
+Update an existing task template by creating a new version of it.
+    -body JSON: 
+    -name STRING: Task template name.
+
+Example:
+    %[1]s task-template update --body '{
+      "acceptedStatusCodes": [
+         "2xx",
+         "304"
+      ],
+      "cacheNamespace": "login",
+      "cacheScope": "user",
+      "callbackURL": "https://example.com/callback",
+      "finalPolicy": "Incidunt ad et velit sit sit voluptatem.",
+      "method": "GET",
+      "priority": 0,
+      "requestPolicy": "policies/example/example/1.0",
+      "responsePolicy": "Reprehenderit quaerat odio quia et et et.",
+      "retryPolicy": {
+         "baseDelay": "1s",
+         "maxAttempts": 5,
+         "maxDelay": "5m",
+         "retryOn": [
+            "timeout",
+            "timeout",
+            "status",
+            "cache"
+         ]
+      },
+      "timeout": "30s",
+      "url": "https://jsonplaceholder.typicode.com/todos/1",
+      "version": 1
+   }' --name "exampleTask"
+`, os.Args[0])
+}
+
+func taskTemplateDeleteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-template delete -name STRING
+
+Delete a task template by name. Its versions are kept for the taskList templates using them.
+    -name STRING: Task template name.
+
+Example:
+    %[1]s task-template delete --name "Illum non officia veniam et assumenda."
+`, os.Args[0])
+}
+
+func taskTemplateListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-template list
+
+List all task templates.
+
+Example:
+    %[1]s task-template list
+`, os.Args[0])
+}
+
+// taskListTemplateUsage displays the usage of the task-list-template command
+// and its subcommands.
+func taskListTemplateUsage() {
+	fmt.Fprintf(os.Stderr, `TaskListTemplate service provides endpoints to manage taskList templates.
+Usage:
+    %[1]s [globalflags] task-list-template COMMAND [flags]
+
+COMMAND:
+    create: Create a taskList template.
+    get: Get a taskList template by name and optionally by version.
+    update: Update an existing taskList template by creating a new version of it.
+    delete: Delete a taskList template by name. Its versions are kept for the taskLists created from them.
+    list: List all taskList templates.
+
+Additional help:
+    %[1]s task-list-template COMMAND --help
+`, os.Args[0])
+}
+func taskListTemplateCreateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-list-template create -body JSON
+
+Create a taskList template.
+    -body JSON: 
+
+Example:
+    %[1]s task-list-template create --body '{
+      "cacheNamespace": "login",
+      "cacheScope": "user",
+      "callbackURL": "https://example.com/callback",
+      "groups": [
+         {
+            "execution": "parallel",
+            "finalPolicy": "Sapiente autem suscipit.",
+            "tasks": [
+               "taskName1",
+               "taskName2"
+            ],
+            "timeout": "5m"
+         },
+         {
+            "execution": "parallel",
+            "finalPolicy": "Sapiente autem suscipit.",
+            "tasks": [
+               "taskName1",
+               "taskName2"
+            ],
+            "timeout": "5m"
+         },
+         {
+            "execution": "parallel",
+            "finalPolicy": "Sapiente autem suscipit.",
+            "tasks": [
+               "taskName1",
+               "taskName2"
+            ],
+            "timeout": "5m"
+         }
+      ],
+      "name": "example",
+      "priority": 0,
+      "timeout": "10m",
+      "version": 1
+   }'
+`, os.Args[0])
+}
+
+func taskListTemplateGetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-list-template get -name STRING -version INT
+
+Get a taskList template by name and optionally by version.
+    -name STRING: TaskList template name.
+    -version INT: 
+
+Example:
+    %[1]s task-list-template get --name "Ut nesciunt minima qui voluptas ea." --version 144571105646544455
+`, os.Args[0])
+}
+
+func taskListTemplateUpdateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-list-template update -body JSON -name STRING
+
+Update an existing taskList template by creating a new version of it.
+    -body JSON: 
+    -name STRING: TaskList template name.
+
+Example:
+    %[1]s task-list-template update --body '{
+      "cacheNamespace": "login",
+      "cacheScope": "user",
+      "callbackURL": "https://example.com/callback",
+      "groups": [
+         {
+            "execution": "parallel",
+            "finalPolicy": "Sapiente autem suscipit.",
+            "tasks": [
+               "taskName1",
+               "taskName2"
+            ],
+            "timeout": "5m"
+         },
+         {
+            "execution": "parallel",
+            "finalPolicy": "Sapiente autem suscipit.",
+            "tasks": [
+               "taskName1",
+               "taskName2"
+            ],
+            "timeout": "5m"
+         },
+         {
+            "execution": "parallel",
+            "finalPolicy": "Sapiente autem suscipit.",
+            "tasks": [
+               "taskName1",
+               "taskName2"
+            ],
+            "timeout": "5m"
+         }
+      ],
+      "priority": 0,
+      "timeout": "10m",
+      "version": 1
+   }' --name "example"
+`, os.Args[0])
+}
+
+func taskListTemplateDeleteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-list-template delete -name STRING
+
+Delete a taskList template by name. Its versions are kept for the taskLists created from them.
+    -name STRING: TaskList template name.
+
+Example:
+    %[1]s task-list-template delete --name "Quae iste autem quia sed minima."
+`, os.Args[0])
+}
+
+func taskListTemplateListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] task-list-template list
+
+List all taskList templates.
+
+Example:
+    %[1]s task-list-template list
+`, os.Args[0])
+}
+
+// eventTaskUsage displays the usage of the event-task command and its
+// subcommands.
+func eventTaskUsage() {
+	fmt.Fprintf(os.Stderr, `EventTask service provides endpoints to manage bindings between cache events and tasks.
+Usage:
+    %[1]s [globalflags] event-task COMMAND [flags]
+
+COMMAND:
+    create: Create a binding which creates a task when a cache event is received.
+    delete: Delete an event task binding by cache key, namespace and scope.
+    list: List all event task bindings.
+
+Additional help:
+    %[1]s event-task COMMAND --help
+`, os.Args[0])
+}
+func eventTaskCreateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] event-task create -body JSON
+
+Create a binding which creates a task when a cache event is received.
+    -body JSON: 
+
+Example:
+    %[1]s event-task create --body '{
+      "key": "did:web:did.actor:alice",
+      "namespace": "Login",
+      "scope": "Administration",
+      "taskName": "exampleTask"
+   }'
+`, os.Args[0])
+}
+
+func eventTaskDeleteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] event-task delete -key STRING -namespace STRING -scope STRING
+
+Delete an event task binding by cache key, namespace and scope.
+    -key STRING: 
+    -namespace STRING: 
+    -scope STRING: 
+
+Example:
+    %[1]s event-task delete --key "Quos pariatur voluptatem consequuntur in ut enim." --namespace "Vitae repellendus." --scope "Odit optio enim incidunt."
+`, os.Args[0])
+}
+
+func eventTaskListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] event-task list
+
+List all event task bindings.
+
+Example:
+    %[1]s event-task list
+`, os.Args[0])
+}
+
+// scheduleUsage displays the usage of the schedule command and its subcommands.
+func scheduleUsage() {
+	fmt.Fprintf(os.Stderr, `Schedule service provides endpoints to manage recurring creation of tasks and taskLists.
+Usage:
+    %[1]s [globalflags] schedule COMMAND [flags]
+
+COMMAND:
+    create: Create a schedule for periodic creation of a task or a taskList.
+    get: Get a schedule by ID.
+    delete: Delete a schedule by ID.
+    list: List all schedules.
+
+Additional help:
+    %[1]s schedule COMMAND --help
+`, os.Args[0])
+}
+func scheduleCreateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule create -body JSON
+
+Create a schedule for periodic creation of a task or a taskList.
+    -body JSON: 
+
+Example:
+    %[1]s schedule create --body '{
+      "cacheNamespace": "Iure porro repellendus eos fuga.",
+      "cacheScope": "Facere ut est accusantium nemo.",
+      "cron": "0 2 * * *",
+      "data": "Quis est et soluta vel aperiam in.",
+      "taskListName": "Et neque eligendi ut.",
+      "taskName": "exampleTask",
+      "timezone": "Europe/Berlin"
+   }'
+`, os.Args[0])
+}
+
+func scheduleGetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule get -schedule-id STRING
+
+Get a schedule by ID.
+    -schedule-id STRING: Unique schedule identifier.
+
+Example:
+    %[1]s schedule get --schedule-id "Excepturi aut dolor."
+`, os.Args[0])
+}
+
+func scheduleDeleteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule delete -schedule-id STRING
+
+Delete a schedule by ID.
+    -schedule-id STRING: Unique schedule identifier.
+
+Example:
+    %[1]s schedule delete --schedule-id "Voluptatem non."
+`, os.Args[0])
+}
+
+func scheduleListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule list
+
+List all schedules.
+
+Example:
+    %[1]s schedule list
+`, os.Args[0])
+}
+
+// webhookUsage displays the usage of the webhook command and its subcommands.
+func webhookUsage() {
+	fmt.Fprintf(os.Stderr, `Webhook service provides endpoints to inspect the delivery of task and taskList completion webhooks.
+Usage:
+    %[1]s [globalflags] webhook COMMAND [flags]
+
+COMMAND:
+    deliveries: List the webhook deliveries and their attempts for a task or a taskList.
+
+Additional help:
+    %[1]s webhook COMMAND --help
+`, os.Args[0])
+}
+func webhookDeliveriesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhook deliveries -id STRING
+
+List the webhook deliveries and their attempts for a task or a taskList.
+    -id STRING: Unique identifier of a task or a taskList.
+
+Example:
+    %[1]s webhook deliveries --id "Enim iusto doloribus odit."
+`, os.Args[0])
+}
+
+// deadLetterUsage displays the usage of the dead-letter command and its
+// subcommands.
+func deadLetterUsage() {
+	fmt.Fprintf(os.Stderr, `DeadLetter service provides endpoints to inspect, requeue and purge tasks which exhausted their retries.
+Usage:
+    %[1]s [globalflags] dead-letter COMMAND [flags]
+
+COMMAND:
+    list: List the dead-lettered tasks from the newest to the oldest.
+    requeue: Put a dead-lettered task back in the queue for execution, optionally with a new payload.
+    purge: Permanently remove dead-lettered tasks filtered by the given criteria.
+
+Additional help:
+    %[1]s dead-letter COMMAND --help
+`, os.Args[0])
+}
+func deadLetterListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] dead-letter list -task-name STRING -limit INT
+
+List the dead-lettered tasks from the newest to the oldest.
+    -task-name STRING: 
+    -limit INT: 
+
+Example:
+    %[1]s dead-letter list --task-name "Ut sed omnis quos necessitatibus nihil laudantium." --limit 66
+`, os.Args[0])
+}
+
+func deadLetterRequeueUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] dead-letter requeue -body JSON -task-id STRING
+
+Put a dead-lettered task back in the queue for execution, optionally with a new payload.
+    -body JSON: 
+    -task-id STRING: Unique task identifier.
+
+Example:
+    %[1]s dead-letter requeue --body "Et qui." --task-id "Aspernatur quis aspernatur recusandae."
+`, os.Args[0])
+}
+
+func deadLetterPurgeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] dead-letter purge -task-name STRING -before STRING
+
+Permanently remove dead-lettered tasks filtered by the given criteria.
+    -task-name STRING: 
+    -before STRING: 
+
+Example:
+    %[1]s dead-letter purge --task-name "Rerum repellendus fuga." --before "2001-04-07T23:16:46Z"
 `, os.Args[0])
 }
 
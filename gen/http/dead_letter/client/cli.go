@@ -0,0 +1,105 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// deadLetter HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	deadletter "github.com/eclipse-xfsc/task-sheduler/gen/dead_letter"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListPayload builds the payload for the deadLetter List endpoint from
+// CLI flags.
+func BuildListPayload(deadLetterListTaskName string, deadLetterListLimit string) (*deadletter.ListDeadLettersRequest, error) {
+	var err error
+	var taskName *string
+	{
+		if deadLetterListTaskName != "" {
+			taskName = &deadLetterListTaskName
+		}
+	}
+	var limit int
+	{
+		if deadLetterListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(deadLetterListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &deadletter.ListDeadLettersRequest{}
+	v.TaskName = taskName
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildRequeuePayload builds the payload for the deadLetter Requeue endpoint
+// from CLI flags.
+func BuildRequeuePayload(deadLetterRequeueBody string, deadLetterRequeueTaskID string) (*deadletter.RequeueDeadLetterRequest, error) {
+	var err error
+	var body any
+	{
+		err = json.Unmarshal([]byte(deadLetterRequeueBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Et qui.\"")
+		}
+	}
+	var taskID string
+	{
+		taskID = deadLetterRequeueTaskID
+	}
+	v := body
+	res := &deadletter.RequeueDeadLetterRequest{
+		Data: &v,
+	}
+	res.TaskID = taskID
+
+	return res, nil
+}
+
+// BuildPurgePayload builds the payload for the deadLetter Purge endpoint from
+// CLI flags.
+func BuildPurgePayload(deadLetterPurgeTaskName string, deadLetterPurgeBefore string) (*deadletter.PurgeDeadLettersRequest, error) {
+	var err error
+	var taskName *string
+	{
+		if deadLetterPurgeTaskName != "" {
+			taskName = &deadLetterPurgeTaskName
+		}
+	}
+	var before *string
+	{
+		if deadLetterPurgeBefore != "" {
+			before = &deadLetterPurgeBefore
+			err = goa.MergeErrors(err, goa.ValidateFormat("before", *before, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &deadletter.PurgeDeadLettersRequest{}
+	v.TaskName = taskName
+	v.Before = before
+
+	return v, nil
+}
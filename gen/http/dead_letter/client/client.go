@@ -0,0 +1,131 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// deadLetter client HTTP transport
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the deadLetter service endpoint HTTP clients.
+type Client struct {
+	// List Doer is the HTTP client used to make requests to the List endpoint.
+	ListDoer goahttp.Doer
+
+	// Requeue Doer is the HTTP client used to make requests to the Requeue
+	// endpoint.
+	RequeueDoer goahttp.Doer
+
+	// Purge Doer is the HTTP client used to make requests to the Purge endpoint.
+	PurgeDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the deadLetter service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		ListDoer:            doer,
+		RequeueDoer:         doer,
+		PurgeDoer:           doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// List returns an endpoint that makes HTTP requests to the deadLetter service
+// List server.
+func (c *Client) List() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListRequest(c.encoder)
+		decodeResponse = DecodeListResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("deadLetter", "List", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Requeue returns an endpoint that makes HTTP requests to the deadLetter
+// service Requeue server.
+func (c *Client) Requeue() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRequeueRequest(c.encoder)
+		decodeResponse = DecodeRequeueResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRequeueRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RequeueDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("deadLetter", "Requeue", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Purge returns an endpoint that makes HTTP requests to the deadLetter service
+// Purge server.
+func (c *Client) Purge() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePurgeRequest(c.encoder)
+		decodeResponse = DecodePurgeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPurgeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PurgeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("deadLetter", "Purge", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,273 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// deadLetter HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	deadletter "github.com/eclipse-xfsc/task-sheduler/gen/dead_letter"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "deadLetter" service "List" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDeadLetterPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("deadLetter", "List", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the deadLetter
+// List server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*deadletter.ListDeadLettersRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("deadLetter", "List", "*deadletter.ListDeadLettersRequest", v)
+		}
+		values := req.URL.Query()
+		if p.TaskName != nil {
+			values.Add("taskName", *p.TaskName)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the
+// deadLetter List endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deadLetter", "List", err)
+			}
+			err = ValidateListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deadLetter", "List", err)
+			}
+			res := NewListDeadLettersResponseOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("deadLetter", "List", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRequeueRequest instantiates a HTTP request object with method and path
+// set to call the "deadLetter" service "Requeue" endpoint
+func (c *Client) BuildRequeueRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		taskID string
+	)
+	{
+		p, ok := v.(*deadletter.RequeueDeadLetterRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("deadLetter", "Requeue", "*deadletter.RequeueDeadLetterRequest", v)
+		}
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RequeueDeadLetterPath(taskID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("deadLetter", "Requeue", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRequeueRequest returns an encoder for requests sent to the deadLetter
+// Requeue server.
+func EncodeRequeueRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*deadletter.RequeueDeadLetterRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("deadLetter", "Requeue", "*deadletter.RequeueDeadLetterRequest", v)
+		}
+		body := p.Data
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("deadLetter", "Requeue", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRequeueResponse returns a decoder for responses returned by the
+// deadLetter Requeue endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeRequeueResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RequeueResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deadLetter", "Requeue", err)
+			}
+			err = ValidateRequeueResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deadLetter", "Requeue", err)
+			}
+			res := NewRequeueDeadLetterResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("deadLetter", "Requeue", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPurgeRequest instantiates a HTTP request object with method and path
+// set to call the "deadLetter" service "Purge" endpoint
+func (c *Client) BuildPurgeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PurgeDeadLetterPath()}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("deadLetter", "Purge", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePurgeRequest returns an encoder for requests sent to the deadLetter
+// Purge server.
+func EncodePurgeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*deadletter.PurgeDeadLettersRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("deadLetter", "Purge", "*deadletter.PurgeDeadLettersRequest", v)
+		}
+		values := req.URL.Query()
+		if p.TaskName != nil {
+			values.Add("taskName", *p.TaskName)
+		}
+		if p.Before != nil {
+			values.Add("before", *p.Before)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodePurgeResponse returns a decoder for responses returned by the
+// deadLetter Purge endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodePurgeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PurgeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deadLetter", "Purge", err)
+			}
+			err = ValidatePurgeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deadLetter", "Purge", err)
+			}
+			res := NewPurgeDeadLettersResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("deadLetter", "Purge", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalDeadLetterResponseBodyToDeadletterDeadLetter builds a value of type
+// *deadletter.DeadLetter from a value of type *DeadLetterResponseBody.
+func unmarshalDeadLetterResponseBodyToDeadletterDeadLetter(v *DeadLetterResponseBody) *deadletter.DeadLetter {
+	res := &deadletter.DeadLetter{
+		TaskID:         *v.TaskID,
+		TaskName:       *v.TaskName,
+		Data:           v.Data,
+		Retries:        *v.Retries,
+		CacheNamespace: v.CacheNamespace,
+		CacheScope:     v.CacheScope,
+		CreatedAt:      *v.CreatedAt,
+		DeadLetteredAt: *v.DeadLetteredAt,
+		ResponseCode:   v.ResponseCode,
+		Response:       v.Response,
+		FailureReason:  v.FailureReason,
+	}
+	res.Errors = make([]string, len(v.Errors))
+	for i, val := range v.Errors {
+		res.Errors[i] = val
+	}
+
+	return res
+}
@@ -0,0 +1,27 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the deadLetter service.
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// ListDeadLetterPath returns the URL path to the deadLetter service List HTTP endpoint.
+func ListDeadLetterPath() string {
+	return "/v1/deadLetters"
+}
+
+// RequeueDeadLetterPath returns the URL path to the deadLetter service Requeue HTTP endpoint.
+func RequeueDeadLetterPath(taskID string) string {
+	return fmt.Sprintf("/v1/deadLetters/%v/requeue", taskID)
+}
+
+// PurgeDeadLetterPath returns the URL path to the deadLetter service Purge HTTP endpoint.
+func PurgeDeadLetterPath() string {
+	return "/v1/deadLetters"
+}
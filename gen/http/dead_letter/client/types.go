@@ -0,0 +1,161 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// deadLetter HTTP client types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	deadletter "github.com/eclipse-xfsc/task-sheduler/gen/dead_letter"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ListResponseBody is the type of the "deadLetter" service "List" endpoint
+// HTTP response body.
+type ListResponseBody struct {
+	// Array of dead-lettered tasks.
+	DeadLetters []*DeadLetterResponseBody `form:"deadLetters,omitempty" json:"deadLetters,omitempty" xml:"deadLetters,omitempty"`
+}
+
+// RequeueResponseBody is the type of the "deadLetter" service "Requeue"
+// endpoint HTTP response body.
+type RequeueResponseBody struct {
+	// Unique task identifier.
+	TaskID *string `form:"taskID,omitempty" json:"taskID,omitempty" xml:"taskID,omitempty"`
+}
+
+// PurgeResponseBody is the type of the "deadLetter" service "Purge" endpoint
+// HTTP response body.
+type PurgeResponseBody struct {
+	// Number of purged dead-lettered tasks.
+	Purged *int `form:"purged,omitempty" json:"purged,omitempty" xml:"purged,omitempty"`
+}
+
+// DeadLetterResponseBody is used to define fields on response body types.
+type DeadLetterResponseBody struct {
+	// Unique task identifier.
+	TaskID *string `form:"taskID,omitempty" json:"taskID,omitempty" xml:"taskID,omitempty"`
+	// Task name.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+	// Data contains the JSON payload of the task.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Number of failed execution attempts.
+	Retries *int `form:"retries,omitempty" json:"retries,omitempty" xml:"retries,omitempty"`
+	// Errors of the most recent failed execution attempts, the last one at the end.
+	Errors []string `form:"errors,omitempty" json:"errors,omitempty" xml:"errors,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Task creation time.
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the task was removed from the queue.
+	DeadLetteredAt *string `form:"deadLetteredAt,omitempty" json:"deadLetteredAt,omitempty" xml:"deadLetteredAt,omitempty"`
+	// Status code of the last response to the task request.
+	ResponseCode *int `form:"responseCode,omitempty" json:"responseCode,omitempty" xml:"responseCode,omitempty"`
+	// Body of the last response to the task request.
+	Response *string `form:"response,omitempty" json:"response,omitempty" xml:"response,omitempty"`
+	// Reason of the last failed execution of the task.
+	FailureReason *string `form:"failureReason,omitempty" json:"failureReason,omitempty" xml:"failureReason,omitempty"`
+}
+
+// NewListDeadLettersResponseOK builds a "deadLetter" service "List" endpoint
+// result from a HTTP "OK" response.
+func NewListDeadLettersResponseOK(body *ListResponseBody) *deadletter.DeadLettersResponse {
+	v := &deadletter.DeadLettersResponse{}
+	v.DeadLetters = make([]*deadletter.DeadLetter, len(body.DeadLetters))
+	for i, val := range body.DeadLetters {
+		v.DeadLetters[i] = unmarshalDeadLetterResponseBodyToDeadletterDeadLetter(val)
+	}
+
+	return v
+}
+
+// NewRequeueDeadLetterResultOK builds a "deadLetter" service "Requeue"
+// endpoint result from a HTTP "OK" response.
+func NewRequeueDeadLetterResultOK(body *RequeueResponseBody) *deadletter.RequeueDeadLetterResult {
+	v := &deadletter.RequeueDeadLetterResult{
+		TaskID: *body.TaskID,
+	}
+
+	return v
+}
+
+// NewPurgeDeadLettersResultOK builds a "deadLetter" service "Purge" endpoint
+// result from a HTTP "OK" response.
+func NewPurgeDeadLettersResultOK(body *PurgeResponseBody) *deadletter.PurgeDeadLettersResult {
+	v := &deadletter.PurgeDeadLettersResult{
+		Purged: *body.Purged,
+	}
+
+	return v
+}
+
+// ValidateListResponseBody runs the validations defined on ListResponseBody
+func ValidateListResponseBody(body *ListResponseBody) (err error) {
+	if body.DeadLetters == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deadLetters", "body"))
+	}
+	for _, e := range body.DeadLetters {
+		if e != nil {
+			if err2 := ValidateDeadLetterResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRequeueResponseBody runs the validations defined on
+// RequeueResponseBody
+func ValidateRequeueResponseBody(body *RequeueResponseBody) (err error) {
+	if body.TaskID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("taskID", "body"))
+	}
+	return
+}
+
+// ValidatePurgeResponseBody runs the validations defined on PurgeResponseBody
+func ValidatePurgeResponseBody(body *PurgeResponseBody) (err error) {
+	if body.Purged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("purged", "body"))
+	}
+	return
+}
+
+// ValidateDeadLetterResponseBody runs the validations defined on
+// DeadLetterResponseBody
+func ValidateDeadLetterResponseBody(body *DeadLetterResponseBody) (err error) {
+	if body.TaskID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("taskID", "body"))
+	}
+	if body.TaskName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("taskName", "body"))
+	}
+	if body.Retries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("retries", "body"))
+	}
+	if body.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.DeadLetteredAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deadLetteredAt", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.DeadLetteredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deadLetteredAt", *body.DeadLetteredAt, goa.FormatDateTime))
+	}
+	if body.FailureReason != nil {
+		if !(*body.FailureReason == "http" || *body.FailureReason == "policy" || *body.FailureReason == "cache" || *body.FailureReason == "status" || *body.FailureReason == "timeout") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.failureReason", *body.FailureReason, []any{"http", "policy", "cache", "status", "timeout"}))
+		}
+	}
+	return
+}
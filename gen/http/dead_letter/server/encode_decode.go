@@ -0,0 +1,188 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// deadLetter HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	deadletter "github.com/eclipse-xfsc/task-sheduler/gen/dead_letter"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeListResponse returns an encoder for responses returned by the
+// deadLetter List endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*deadletter.DeadLettersResponse)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListRequest returns a decoder for requests sent to the deadLetter List
+// endpoint.
+func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			taskName *string
+			limit    int
+			err      error
+		)
+		qp := r.URL.Query()
+		taskNameRaw := qp.Get("taskName")
+		if taskNameRaw != "" {
+			taskName = &taskNameRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 20
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListDeadLettersRequest(taskName, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeRequeueResponse returns an encoder for responses returned by the
+// deadLetter Requeue endpoint.
+func EncodeRequeueResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*deadletter.RequeueDeadLetterResult)
+		enc := encoder(ctx, w)
+		body := NewRequeueResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRequeueRequest returns a decoder for requests sent to the deadLetter
+// Requeue endpoint.
+func DecodeRequeueRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body any
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			} else {
+				var gerr *goa.ServiceError
+				if errors.As(err, &gerr) {
+					return nil, gerr
+				}
+				return nil, goa.DecodePayloadError(err.Error())
+			}
+		}
+
+		var (
+			taskID string
+
+			params = mux.Vars(r)
+		)
+		taskID = params["taskID"]
+		payload := NewRequeueDeadLetterRequest(body, taskID)
+
+		return payload, nil
+	}
+}
+
+// EncodePurgeResponse returns an encoder for responses returned by the
+// deadLetter Purge endpoint.
+func EncodePurgeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*deadletter.PurgeDeadLettersResult)
+		enc := encoder(ctx, w)
+		body := NewPurgeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePurgeRequest returns a decoder for requests sent to the deadLetter
+// Purge endpoint.
+func DecodePurgeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			taskName *string
+			before   *string
+			err      error
+		)
+		qp := r.URL.Query()
+		taskNameRaw := qp.Get("taskName")
+		if taskNameRaw != "" {
+			taskName = &taskNameRaw
+		}
+		beforeRaw := qp.Get("before")
+		if beforeRaw != "" {
+			before = &beforeRaw
+		}
+		if before != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("before", *before, goa.FormatDateTime))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPurgeDeadLettersRequest(taskName, before)
+
+		return payload, nil
+	}
+}
+
+// marshalDeadletterDeadLetterToDeadLetterResponseBody builds a value of type
+// *DeadLetterResponseBody from a value of type *deadletter.DeadLetter.
+func marshalDeadletterDeadLetterToDeadLetterResponseBody(v *deadletter.DeadLetter) *DeadLetterResponseBody {
+	res := &DeadLetterResponseBody{
+		TaskID:         v.TaskID,
+		TaskName:       v.TaskName,
+		Data:           v.Data,
+		Retries:        v.Retries,
+		CacheNamespace: v.CacheNamespace,
+		CacheScope:     v.CacheScope,
+		CreatedAt:      v.CreatedAt,
+		DeadLetteredAt: v.DeadLetteredAt,
+		ResponseCode:   v.ResponseCode,
+		Response:       v.Response,
+		FailureReason:  v.FailureReason,
+	}
+	if v.Errors != nil {
+		res.Errors = make([]string, len(v.Errors))
+		for i, val := range v.Errors {
+			res.Errors[i] = val
+		}
+	} else {
+		res.Errors = []string{}
+	}
+
+	return res
+}
@@ -0,0 +1,125 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// deadLetter HTTP server types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	deadletter "github.com/eclipse-xfsc/task-sheduler/gen/dead_letter"
+)
+
+// ListResponseBody is the type of the "deadLetter" service "List" endpoint
+// HTTP response body.
+type ListResponseBody struct {
+	// Array of dead-lettered tasks.
+	DeadLetters []*DeadLetterResponseBody `form:"deadLetters" json:"deadLetters" xml:"deadLetters"`
+}
+
+// RequeueResponseBody is the type of the "deadLetter" service "Requeue"
+// endpoint HTTP response body.
+type RequeueResponseBody struct {
+	// Unique task identifier.
+	TaskID string `form:"taskID" json:"taskID" xml:"taskID"`
+}
+
+// PurgeResponseBody is the type of the "deadLetter" service "Purge" endpoint
+// HTTP response body.
+type PurgeResponseBody struct {
+	// Number of purged dead-lettered tasks.
+	Purged int `form:"purged" json:"purged" xml:"purged"`
+}
+
+// DeadLetterResponseBody is used to define fields on response body types.
+type DeadLetterResponseBody struct {
+	// Unique task identifier.
+	TaskID string `form:"taskID" json:"taskID" xml:"taskID"`
+	// Task name.
+	TaskName string `form:"taskName" json:"taskName" xml:"taskName"`
+	// Data contains the JSON payload of the task.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Number of failed execution attempts.
+	Retries int `form:"retries" json:"retries" xml:"retries"`
+	// Errors of the most recent failed execution attempts, the last one at the end.
+	Errors []string `form:"errors" json:"errors" xml:"errors"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Task creation time.
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Time at which the task was removed from the queue.
+	DeadLetteredAt string `form:"deadLetteredAt" json:"deadLetteredAt" xml:"deadLetteredAt"`
+	// Status code of the last response to the task request.
+	ResponseCode *int `form:"responseCode,omitempty" json:"responseCode,omitempty" xml:"responseCode,omitempty"`
+	// Body of the last response to the task request.
+	Response *string `form:"response,omitempty" json:"response,omitempty" xml:"response,omitempty"`
+	// Reason of the last failed execution of the task.
+	FailureReason *string `form:"failureReason,omitempty" json:"failureReason,omitempty" xml:"failureReason,omitempty"`
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "List" endpoint of the "deadLetter" service.
+func NewListResponseBody(res *deadletter.DeadLettersResponse) *ListResponseBody {
+	body := &ListResponseBody{}
+	if res.DeadLetters != nil {
+		body.DeadLetters = make([]*DeadLetterResponseBody, len(res.DeadLetters))
+		for i, val := range res.DeadLetters {
+			body.DeadLetters[i] = marshalDeadletterDeadLetterToDeadLetterResponseBody(val)
+		}
+	} else {
+		body.DeadLetters = []*DeadLetterResponseBody{}
+	}
+	return body
+}
+
+// NewRequeueResponseBody builds the HTTP response body from the result of the
+// "Requeue" endpoint of the "deadLetter" service.
+func NewRequeueResponseBody(res *deadletter.RequeueDeadLetterResult) *RequeueResponseBody {
+	body := &RequeueResponseBody{
+		TaskID: res.TaskID,
+	}
+	return body
+}
+
+// NewPurgeResponseBody builds the HTTP response body from the result of the
+// "Purge" endpoint of the "deadLetter" service.
+func NewPurgeResponseBody(res *deadletter.PurgeDeadLettersResult) *PurgeResponseBody {
+	body := &PurgeResponseBody{
+		Purged: res.Purged,
+	}
+	return body
+}
+
+// NewListDeadLettersRequest builds a deadLetter service List endpoint payload.
+func NewListDeadLettersRequest(taskName *string, limit int) *deadletter.ListDeadLettersRequest {
+	v := &deadletter.ListDeadLettersRequest{}
+	v.TaskName = taskName
+	v.Limit = limit
+
+	return v
+}
+
+// NewRequeueDeadLetterRequest builds a deadLetter service Requeue endpoint
+// payload.
+func NewRequeueDeadLetterRequest(body any, taskID string) *deadletter.RequeueDeadLetterRequest {
+	v := body
+	res := &deadletter.RequeueDeadLetterRequest{
+		Data: &v,
+	}
+	res.TaskID = taskID
+
+	return res
+}
+
+// NewPurgeDeadLettersRequest builds a deadLetter service Purge endpoint
+// payload.
+func NewPurgeDeadLettersRequest(taskName *string, before *string) *deadletter.PurgeDeadLettersRequest {
+	v := &deadletter.PurgeDeadLettersRequest{}
+	v.TaskName = taskName
+	v.Before = before
+
+	return v
+}
@@ -0,0 +1,63 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// eventTask HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	eventtask "github.com/eclipse-xfsc/task-sheduler/gen/event_task"
+)
+
+// BuildCreatePayload builds the payload for the eventTask Create endpoint from
+// CLI flags.
+func BuildCreatePayload(eventTaskCreateBody string) (*eventtask.EventTask, error) {
+	var err error
+	var body CreateRequestBody
+	{
+		err = json.Unmarshal([]byte(eventTaskCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"key\": \"did:web:did.actor:alice\",\n      \"namespace\": \"Login\",\n      \"scope\": \"Administration\",\n      \"taskName\": \"exampleTask\"\n   }'")
+		}
+	}
+	v := &eventtask.EventTask{
+		Key:       body.Key,
+		Namespace: body.Namespace,
+		Scope:     body.Scope,
+		TaskName:  body.TaskName,
+	}
+
+	return v, nil
+}
+
+// BuildDeletePayload builds the payload for the eventTask Delete endpoint from
+// CLI flags.
+func BuildDeletePayload(eventTaskDeleteKey string, eventTaskDeleteNamespace string, eventTaskDeleteScope string) (*eventtask.EventTaskRequest, error) {
+	var key string
+	{
+		key = eventTaskDeleteKey
+	}
+	var namespace *string
+	{
+		if eventTaskDeleteNamespace != "" {
+			namespace = &eventTaskDeleteNamespace
+		}
+	}
+	var scope *string
+	{
+		if eventTaskDeleteScope != "" {
+			scope = &eventTaskDeleteScope
+		}
+	}
+	v := &eventtask.EventTaskRequest{}
+	v.Key = key
+	v.Namespace = namespace
+	v.Scope = scope
+
+	return v, nil
+}
@@ -0,0 +1,133 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// eventTask HTTP client types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	eventtask "github.com/eclipse-xfsc/task-sheduler/gen/event_task"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "eventTask" service "Create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Cache key of the entry for which events are received.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache key namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache key scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Name of the task template used to create a task when an event is received.
+	TaskName string `form:"taskName" json:"taskName" xml:"taskName"`
+}
+
+// CreateResponseBody is the type of the "eventTask" service "Create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// Cache key of the entry for which events are received.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache key namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache key scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Name of the task template used to create a task when an event is received.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+}
+
+// ListResponseBody is the type of the "eventTask" service "List" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// Array of event task bindings.
+	EventTasks []*EventTaskResponseBody `form:"eventTasks,omitempty" json:"eventTasks,omitempty" xml:"eventTasks,omitempty"`
+}
+
+// EventTaskResponseBody is used to define fields on response body types.
+type EventTaskResponseBody struct {
+	// Cache key of the entry for which events are received.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache key namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache key scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Name of the task template used to create a task when an event is received.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "Create" endpoint of the "eventTask" service.
+func NewCreateRequestBody(p *eventtask.EventTask) *CreateRequestBody {
+	body := &CreateRequestBody{
+		Key:       p.Key,
+		Namespace: p.Namespace,
+		Scope:     p.Scope,
+		TaskName:  p.TaskName,
+	}
+	return body
+}
+
+// NewCreateEventTaskCreated builds a "eventTask" service "Create" endpoint
+// result from a HTTP "Created" response.
+func NewCreateEventTaskCreated(body *CreateResponseBody) *eventtask.EventTask {
+	v := &eventtask.EventTask{
+		Key:       *body.Key,
+		Namespace: body.Namespace,
+		Scope:     body.Scope,
+		TaskName:  *body.TaskName,
+	}
+
+	return v
+}
+
+// NewListEventTasksResponseOK builds a "eventTask" service "List" endpoint
+// result from a HTTP "OK" response.
+func NewListEventTasksResponseOK(body *ListResponseBody) *eventtask.EventTasksResponse {
+	v := &eventtask.EventTasksResponse{}
+	v.EventTasks = make([]*eventtask.EventTask, len(body.EventTasks))
+	for i, val := range body.EventTasks {
+		v.EventTasks[i] = unmarshalEventTaskResponseBodyToEventtaskEventTask(val)
+	}
+
+	return v
+}
+
+// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
+func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.TaskName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("taskName", "body"))
+	}
+	return
+}
+
+// ValidateListResponseBody runs the validations defined on ListResponseBody
+func ValidateListResponseBody(body *ListResponseBody) (err error) {
+	if body.EventTasks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("eventTasks", "body"))
+	}
+	for _, e := range body.EventTasks {
+		if e != nil {
+			if err2 := ValidateEventTaskResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateEventTaskResponseBody runs the validations defined on
+// EventTaskResponseBody
+func ValidateEventTaskResponseBody(body *EventTaskResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.TaskName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("taskName", "body"))
+	}
+	return
+}
@@ -0,0 +1,126 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// eventTask HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	eventtask "github.com/eclipse-xfsc/task-sheduler/gen/event_task"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeCreateResponse returns an encoder for responses returned by the
+// eventTask Create endpoint.
+func EncodeCreateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*eventtask.EventTask)
+		enc := encoder(ctx, w)
+		body := NewCreateResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateRequest returns a decoder for requests sent to the eventTask
+// Create endpoint.
+func DecodeCreateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateEventTask(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteResponse returns an encoder for responses returned by the
+// eventTask Delete endpoint.
+func EncodeDeleteResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDeleteRequest returns a decoder for requests sent to the eventTask
+// Delete endpoint.
+func DecodeDeleteRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			key       string
+			namespace *string
+			scope     *string
+			err       error
+		)
+		qp := r.URL.Query()
+		key = qp.Get("key")
+		if key == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("key", "query string"))
+		}
+		namespaceRaw := qp.Get("namespace")
+		if namespaceRaw != "" {
+			namespace = &namespaceRaw
+		}
+		scopeRaw := qp.Get("scope")
+		if scopeRaw != "" {
+			scope = &scopeRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteEventTaskRequest(key, namespace, scope)
+
+		return payload, nil
+	}
+}
+
+// EncodeListResponse returns an encoder for responses returned by the
+// eventTask List endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*eventtask.EventTasksResponse)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// marshalEventtaskEventTaskToEventTaskResponseBody builds a value of type
+// *EventTaskResponseBody from a value of type *eventtask.EventTask.
+func marshalEventtaskEventTaskToEventTaskResponseBody(v *eventtask.EventTask) *EventTaskResponseBody {
+	res := &EventTaskResponseBody{
+		Key:       v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+		TaskName:  v.TaskName,
+	}
+
+	return res
+}
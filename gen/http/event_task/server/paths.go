@@ -0,0 +1,23 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the eventTask service.
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+// CreateEventTaskPath returns the URL path to the eventTask service Create HTTP endpoint.
+func CreateEventTaskPath() string {
+	return "/v1/eventTasks"
+}
+
+// DeleteEventTaskPath returns the URL path to the eventTask service Delete HTTP endpoint.
+func DeleteEventTaskPath() string {
+	return "/v1/eventTasks"
+}
+
+// ListEventTaskPath returns the URL path to the eventTask service List HTTP endpoint.
+func ListEventTaskPath() string {
+	return "/v1/eventTasks"
+}
@@ -0,0 +1,118 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// eventTask HTTP server types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	eventtask "github.com/eclipse-xfsc/task-sheduler/gen/event_task"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "eventTask" service "Create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Cache key of the entry for which events are received.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache key namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache key scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Name of the task template used to create a task when an event is received.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+}
+
+// CreateResponseBody is the type of the "eventTask" service "Create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// Cache key of the entry for which events are received.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache key namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache key scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Name of the task template used to create a task when an event is received.
+	TaskName string `form:"taskName" json:"taskName" xml:"taskName"`
+}
+
+// ListResponseBody is the type of the "eventTask" service "List" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// Array of event task bindings.
+	EventTasks []*EventTaskResponseBody `form:"eventTasks" json:"eventTasks" xml:"eventTasks"`
+}
+
+// EventTaskResponseBody is used to define fields on response body types.
+type EventTaskResponseBody struct {
+	// Cache key of the entry for which events are received.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache key namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache key scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Name of the task template used to create a task when an event is received.
+	TaskName string `form:"taskName" json:"taskName" xml:"taskName"`
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "Create" endpoint of the "eventTask" service.
+func NewCreateResponseBody(res *eventtask.EventTask) *CreateResponseBody {
+	body := &CreateResponseBody{
+		Key:       res.Key,
+		Namespace: res.Namespace,
+		Scope:     res.Scope,
+		TaskName:  res.TaskName,
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "List" endpoint of the "eventTask" service.
+func NewListResponseBody(res *eventtask.EventTasksResponse) *ListResponseBody {
+	body := &ListResponseBody{}
+	if res.EventTasks != nil {
+		body.EventTasks = make([]*EventTaskResponseBody, len(res.EventTasks))
+		for i, val := range res.EventTasks {
+			body.EventTasks[i] = marshalEventtaskEventTaskToEventTaskResponseBody(val)
+		}
+	} else {
+		body.EventTasks = []*EventTaskResponseBody{}
+	}
+	return body
+}
+
+// NewCreateEventTask builds a eventTask service Create endpoint payload.
+func NewCreateEventTask(body *CreateRequestBody) *eventtask.EventTask {
+	v := &eventtask.EventTask{
+		Key:       *body.Key,
+		Namespace: body.Namespace,
+		Scope:     body.Scope,
+		TaskName:  *body.TaskName,
+	}
+
+	return v
+}
+
+// NewDeleteEventTaskRequest builds a eventTask service Delete endpoint payload.
+func NewDeleteEventTaskRequest(key string, namespace *string, scope *string) *eventtask.EventTaskRequest {
+	v := &eventtask.EventTaskRequest{}
+	v.Key = key
+	v.Namespace = namespace
+	v.Scope = scope
+
+	return v
+}
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.TaskName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("taskName", "body"))
+	}
+	return
+}
@@ -0,0 +1,65 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// schedule HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	schedule "github.com/eclipse-xfsc/task-sheduler/gen/schedule"
+)
+
+// BuildCreatePayload builds the payload for the schedule Create endpoint from
+// CLI flags.
+func BuildCreatePayload(scheduleCreateBody string) (*schedule.CreateScheduleRequest, error) {
+	var err error
+	var body CreateRequestBody
+	{
+		err = json.Unmarshal([]byte(scheduleCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cacheNamespace\": \"Iure porro repellendus eos fuga.\",\n      \"cacheScope\": \"Facere ut est accusantium nemo.\",\n      \"cron\": \"0 2 * * *\",\n      \"data\": \"Quis est et soluta vel aperiam in.\",\n      \"taskListName\": \"Et neque eligendi ut.\",\n      \"taskName\": \"exampleTask\",\n      \"timezone\": \"Europe/Berlin\"\n   }'")
+		}
+	}
+	v := &schedule.CreateScheduleRequest{
+		Cron:           body.Cron,
+		Timezone:       body.Timezone,
+		TaskName:       body.TaskName,
+		TaskListName:   body.TaskListName,
+		Data:           body.Data,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+	}
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the schedule Get endpoint from CLI
+// flags.
+func BuildGetPayload(scheduleGetScheduleID string) (*schedule.ScheduleRequest, error) {
+	var scheduleID string
+	{
+		scheduleID = scheduleGetScheduleID
+	}
+	v := &schedule.ScheduleRequest{}
+	v.ScheduleID = scheduleID
+
+	return v, nil
+}
+
+// BuildDeletePayload builds the payload for the schedule Delete endpoint from
+// CLI flags.
+func BuildDeletePayload(scheduleDeleteScheduleID string) (*schedule.ScheduleRequest, error) {
+	var scheduleID string
+	{
+		scheduleID = scheduleDeleteScheduleID
+	}
+	v := &schedule.ScheduleRequest{}
+	v.ScheduleID = scheduleID
+
+	return v, nil
+}
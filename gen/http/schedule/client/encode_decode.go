@@ -0,0 +1,297 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// schedule HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	schedule "github.com/eclipse-xfsc/task-sheduler/gen/schedule"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildCreateRequest instantiates a HTTP request object with method and path
+// set to call the "schedule" service "Create" endpoint
+func (c *Client) BuildCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateSchedulePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "Create", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateRequest returns an encoder for requests sent to the schedule
+// Create server.
+func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*schedule.CreateScheduleRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("schedule", "Create", "*schedule.CreateScheduleRequest", v)
+		}
+		body := NewCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("schedule", "Create", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateResponse returns a decoder for responses returned by the
+// schedule Create endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "Create", err)
+			}
+			err = ValidateCreateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "Create", err)
+			}
+			res := NewCreateScheduleCreated(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "Create", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "schedule" service "Get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		scheduleID string
+	)
+	{
+		p, ok := v.(*schedule.ScheduleRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("schedule", "Get", "*schedule.ScheduleRequest", v)
+		}
+		scheduleID = p.ScheduleID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetSchedulePath(scheduleID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "Get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the schedule
+// Get endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "Get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "Get", err)
+			}
+			res := NewGetScheduleOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "Get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "schedule" service "Delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		scheduleID string
+	)
+	{
+		p, ok := v.(*schedule.ScheduleRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("schedule", "Delete", "*schedule.ScheduleRequest", v)
+		}
+		scheduleID = p.ScheduleID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteSchedulePath(scheduleID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "Delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the
+// schedule Delete endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "Delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "schedule" service "List" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSchedulePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "List", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListResponse returns a decoder for responses returned by the schedule
+// List endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "List", err)
+			}
+			err = ValidateListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "List", err)
+			}
+			res := NewListSchedulesResponseOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "List", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalScheduleRunResponseBodyToScheduleScheduleRun builds a value of type
+// *schedule.ScheduleRun from a value of type *ScheduleRunResponseBody.
+func unmarshalScheduleRunResponseBodyToScheduleScheduleRun(v *ScheduleRunResponseBody) *schedule.ScheduleRun {
+	res := &schedule.ScheduleRun{
+		ScheduledAt: *v.ScheduledAt,
+		ID:          *v.ID,
+	}
+
+	return res
+}
+
+// unmarshalScheduleResponseBodyToScheduleSchedule builds a value of type
+// *schedule.Schedule from a value of type *ScheduleResponseBody.
+func unmarshalScheduleResponseBodyToScheduleSchedule(v *ScheduleResponseBody) *schedule.Schedule {
+	res := &schedule.Schedule{
+		ID:             *v.ID,
+		Cron:           *v.Cron,
+		Timezone:       *v.Timezone,
+		TaskName:       v.TaskName,
+		TaskListName:   v.TaskListName,
+		Data:           v.Data,
+		CacheNamespace: v.CacheNamespace,
+		CacheScope:     v.CacheScope,
+		NextRunAt:      *v.NextRunAt,
+		LastRunAt:      v.LastRunAt,
+		CreatedAt:      *v.CreatedAt,
+	}
+	res.Runs = make([]*schedule.ScheduleRun, len(v.Runs))
+	for i, val := range v.Runs {
+		res.Runs[i] = unmarshalScheduleRunResponseBodyToScheduleScheduleRun(val)
+	}
+
+	return res
+}
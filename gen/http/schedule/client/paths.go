@@ -0,0 +1,32 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the schedule service.
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// CreateSchedulePath returns the URL path to the schedule service Create HTTP endpoint.
+func CreateSchedulePath() string {
+	return "/v1/schedules"
+}
+
+// GetSchedulePath returns the URL path to the schedule service Get HTTP endpoint.
+func GetSchedulePath(scheduleID string) string {
+	return fmt.Sprintf("/v1/schedules/%v", scheduleID)
+}
+
+// DeleteSchedulePath returns the URL path to the schedule service Delete HTTP endpoint.
+func DeleteSchedulePath(scheduleID string) string {
+	return fmt.Sprintf("/v1/schedules/%v", scheduleID)
+}
+
+// ListSchedulePath returns the URL path to the schedule service List HTTP endpoint.
+func ListSchedulePath() string {
+	return "/v1/schedules"
+}
@@ -0,0 +1,357 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// schedule HTTP client types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	schedule "github.com/eclipse-xfsc/task-sheduler/gen/schedule"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "schedule" service "Create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Standard cron expression with five fields (minute, hour, day of month,
+	// month, day of week).
+	Cron string `form:"cron" json:"cron" xml:"cron"`
+	// IANA timezone in which the cron expression is evaluated. Defaults to UTC.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// Name of the task template to create tasks from.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string `form:"taskListName,omitempty" json:"taskListName,omitempty" xml:"taskListName,omitempty"`
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+}
+
+// CreateResponseBody is the type of the "schedule" service "Create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// Unique schedule identifier.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Standard cron expression.
+	Cron *string `form:"cron,omitempty" json:"cron,omitempty" xml:"cron,omitempty"`
+	// Timezone in which the cron expression is evaluated.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// Name of the task template to create tasks from.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string `form:"taskListName,omitempty" json:"taskListName,omitempty" xml:"taskListName,omitempty"`
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Time of the next run.
+	NextRunAt *string `form:"nextRunAt,omitempty" json:"nextRunAt,omitempty" xml:"nextRunAt,omitempty"`
+	// Time of the last run.
+	LastRunAt *string `form:"lastRunAt,omitempty" json:"lastRunAt,omitempty" xml:"lastRunAt,omitempty"`
+	// Schedule creation time.
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Most recent runs of the schedule.
+	Runs []*ScheduleRunResponseBody `form:"runs,omitempty" json:"runs,omitempty" xml:"runs,omitempty"`
+}
+
+// GetResponseBody is the type of the "schedule" service "Get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Unique schedule identifier.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Standard cron expression.
+	Cron *string `form:"cron,omitempty" json:"cron,omitempty" xml:"cron,omitempty"`
+	// Timezone in which the cron expression is evaluated.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// Name of the task template to create tasks from.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string `form:"taskListName,omitempty" json:"taskListName,omitempty" xml:"taskListName,omitempty"`
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Time of the next run.
+	NextRunAt *string `form:"nextRunAt,omitempty" json:"nextRunAt,omitempty" xml:"nextRunAt,omitempty"`
+	// Time of the last run.
+	LastRunAt *string `form:"lastRunAt,omitempty" json:"lastRunAt,omitempty" xml:"lastRunAt,omitempty"`
+	// Schedule creation time.
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Most recent runs of the schedule.
+	Runs []*ScheduleRunResponseBody `form:"runs,omitempty" json:"runs,omitempty" xml:"runs,omitempty"`
+}
+
+// ListResponseBody is the type of the "schedule" service "List" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// Array of schedules.
+	Schedules []*ScheduleResponseBody `form:"schedules,omitempty" json:"schedules,omitempty" xml:"schedules,omitempty"`
+}
+
+// ScheduleRunResponseBody is used to define fields on response body types.
+type ScheduleRunResponseBody struct {
+	// Time for which the run was scheduled.
+	ScheduledAt *string `form:"scheduledAt,omitempty" json:"scheduledAt,omitempty" xml:"scheduledAt,omitempty"`
+	// Unique identifier of the created task or taskList.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// ScheduleResponseBody is used to define fields on response body types.
+type ScheduleResponseBody struct {
+	// Unique schedule identifier.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Standard cron expression.
+	Cron *string `form:"cron,omitempty" json:"cron,omitempty" xml:"cron,omitempty"`
+	// Timezone in which the cron expression is evaluated.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// Name of the task template to create tasks from.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string `form:"taskListName,omitempty" json:"taskListName,omitempty" xml:"taskListName,omitempty"`
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Time of the next run.
+	NextRunAt *string `form:"nextRunAt,omitempty" json:"nextRunAt,omitempty" xml:"nextRunAt,omitempty"`
+	// Time of the last run.
+	LastRunAt *string `form:"lastRunAt,omitempty" json:"lastRunAt,omitempty" xml:"lastRunAt,omitempty"`
+	// Schedule creation time.
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Most recent runs of the schedule.
+	Runs []*ScheduleRunResponseBody `form:"runs,omitempty" json:"runs,omitempty" xml:"runs,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "Create" endpoint of the "schedule" service.
+func NewCreateRequestBody(p *schedule.CreateScheduleRequest) *CreateRequestBody {
+	body := &CreateRequestBody{
+		Cron:           p.Cron,
+		Timezone:       p.Timezone,
+		TaskName:       p.TaskName,
+		TaskListName:   p.TaskListName,
+		Data:           p.Data,
+		CacheNamespace: p.CacheNamespace,
+		CacheScope:     p.CacheScope,
+	}
+	return body
+}
+
+// NewCreateScheduleCreated builds a "schedule" service "Create" endpoint
+// result from a HTTP "Created" response.
+func NewCreateScheduleCreated(body *CreateResponseBody) *schedule.Schedule {
+	v := &schedule.Schedule{
+		ID:             *body.ID,
+		Cron:           *body.Cron,
+		Timezone:       *body.Timezone,
+		TaskName:       body.TaskName,
+		TaskListName:   body.TaskListName,
+		Data:           body.Data,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		NextRunAt:      *body.NextRunAt,
+		LastRunAt:      body.LastRunAt,
+		CreatedAt:      *body.CreatedAt,
+	}
+	v.Runs = make([]*schedule.ScheduleRun, len(body.Runs))
+	for i, val := range body.Runs {
+		v.Runs[i] = unmarshalScheduleRunResponseBodyToScheduleScheduleRun(val)
+	}
+
+	return v
+}
+
+// NewGetScheduleOK builds a "schedule" service "Get" endpoint result from a
+// HTTP "OK" response.
+func NewGetScheduleOK(body *GetResponseBody) *schedule.Schedule {
+	v := &schedule.Schedule{
+		ID:             *body.ID,
+		Cron:           *body.Cron,
+		Timezone:       *body.Timezone,
+		TaskName:       body.TaskName,
+		TaskListName:   body.TaskListName,
+		Data:           body.Data,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		NextRunAt:      *body.NextRunAt,
+		LastRunAt:      body.LastRunAt,
+		CreatedAt:      *body.CreatedAt,
+	}
+	v.Runs = make([]*schedule.ScheduleRun, len(body.Runs))
+	for i, val := range body.Runs {
+		v.Runs[i] = unmarshalScheduleRunResponseBodyToScheduleScheduleRun(val)
+	}
+
+	return v
+}
+
+// NewListSchedulesResponseOK builds a "schedule" service "List" endpoint
+// result from a HTTP "OK" response.
+func NewListSchedulesResponseOK(body *ListResponseBody) *schedule.SchedulesResponse {
+	v := &schedule.SchedulesResponse{}
+	v.Schedules = make([]*schedule.Schedule, len(body.Schedules))
+	for i, val := range body.Schedules {
+		v.Schedules[i] = unmarshalScheduleResponseBodyToScheduleSchedule(val)
+	}
+
+	return v
+}
+
+// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
+func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Cron == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cron", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.NextRunAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nextRunAt", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.Runs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("runs", "body"))
+	}
+	if body.NextRunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextRunAt", *body.NextRunAt, goa.FormatDateTime))
+	}
+	if body.LastRunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.lastRunAt", *body.LastRunAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Runs {
+		if e != nil {
+			if err2 := ValidateScheduleRunResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Cron == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cron", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.NextRunAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nextRunAt", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.Runs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("runs", "body"))
+	}
+	if body.NextRunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextRunAt", *body.NextRunAt, goa.FormatDateTime))
+	}
+	if body.LastRunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.lastRunAt", *body.LastRunAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Runs {
+		if e != nil {
+			if err2 := ValidateScheduleRunResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListResponseBody runs the validations defined on ListResponseBody
+func ValidateListResponseBody(body *ListResponseBody) (err error) {
+	if body.Schedules == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schedules", "body"))
+	}
+	for _, e := range body.Schedules {
+		if e != nil {
+			if err2 := ValidateScheduleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateScheduleRunResponseBody runs the validations defined on
+// ScheduleRunResponseBody
+func ValidateScheduleRunResponseBody(body *ScheduleRunResponseBody) (err error) {
+	if body.ScheduledAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scheduledAt", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ScheduledAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.scheduledAt", *body.ScheduledAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateScheduleResponseBody runs the validations defined on
+// ScheduleResponseBody
+func ValidateScheduleResponseBody(body *ScheduleResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Cron == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cron", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.NextRunAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nextRunAt", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.Runs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("runs", "body"))
+	}
+	if body.NextRunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextRunAt", *body.NextRunAt, goa.FormatDateTime))
+	}
+	if body.LastRunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.lastRunAt", *body.LastRunAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Runs {
+		if e != nil {
+			if err2 := ValidateScheduleRunResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
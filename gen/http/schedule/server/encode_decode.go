@@ -0,0 +1,164 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// schedule HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	schedule "github.com/eclipse-xfsc/task-sheduler/gen/schedule"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeCreateResponse returns an encoder for responses returned by the
+// schedule Create endpoint.
+func EncodeCreateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*schedule.Schedule)
+		enc := encoder(ctx, w)
+		body := NewCreateResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateRequest returns a decoder for requests sent to the schedule
+// Create endpoint.
+func DecodeCreateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateScheduleRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetResponse returns an encoder for responses returned by the schedule
+// Get endpoint.
+func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*schedule.Schedule)
+		enc := encoder(ctx, w)
+		body := NewGetResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetRequest returns a decoder for requests sent to the schedule Get
+// endpoint.
+func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			scheduleID string
+
+			params = mux.Vars(r)
+		)
+		scheduleID = params["scheduleID"]
+		payload := NewGetScheduleRequest(scheduleID)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteResponse returns an encoder for responses returned by the
+// schedule Delete endpoint.
+func EncodeDeleteResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDeleteRequest returns a decoder for requests sent to the schedule
+// Delete endpoint.
+func DecodeDeleteRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			scheduleID string
+
+			params = mux.Vars(r)
+		)
+		scheduleID = params["scheduleID"]
+		payload := NewDeleteScheduleRequest(scheduleID)
+
+		return payload, nil
+	}
+}
+
+// EncodeListResponse returns an encoder for responses returned by the schedule
+// List endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*schedule.SchedulesResponse)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// marshalScheduleScheduleRunToScheduleRunResponseBody builds a value of type
+// *ScheduleRunResponseBody from a value of type *schedule.ScheduleRun.
+func marshalScheduleScheduleRunToScheduleRunResponseBody(v *schedule.ScheduleRun) *ScheduleRunResponseBody {
+	res := &ScheduleRunResponseBody{
+		ScheduledAt: v.ScheduledAt,
+		ID:          v.ID,
+	}
+
+	return res
+}
+
+// marshalScheduleScheduleToScheduleResponseBody builds a value of type
+// *ScheduleResponseBody from a value of type *schedule.Schedule.
+func marshalScheduleScheduleToScheduleResponseBody(v *schedule.Schedule) *ScheduleResponseBody {
+	res := &ScheduleResponseBody{
+		ID:             v.ID,
+		Cron:           v.Cron,
+		Timezone:       v.Timezone,
+		TaskName:       v.TaskName,
+		TaskListName:   v.TaskListName,
+		Data:           v.Data,
+		CacheNamespace: v.CacheNamespace,
+		CacheScope:     v.CacheScope,
+		NextRunAt:      v.NextRunAt,
+		LastRunAt:      v.LastRunAt,
+		CreatedAt:      v.CreatedAt,
+	}
+	if v.Runs != nil {
+		res.Runs = make([]*ScheduleRunResponseBody, len(v.Runs))
+		for i, val := range v.Runs {
+			res.Runs[i] = marshalScheduleScheduleRunToScheduleRunResponseBody(val)
+		}
+	} else {
+		res.Runs = []*ScheduleRunResponseBody{}
+	}
+
+	return res
+}
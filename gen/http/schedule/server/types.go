@@ -0,0 +1,242 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// schedule HTTP server types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	schedule "github.com/eclipse-xfsc/task-sheduler/gen/schedule"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "schedule" service "Create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Standard cron expression with five fields (minute, hour, day of month,
+	// month, day of week).
+	Cron *string `form:"cron,omitempty" json:"cron,omitempty" xml:"cron,omitempty"`
+	// IANA timezone in which the cron expression is evaluated. Defaults to UTC.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// Name of the task template to create tasks from.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string `form:"taskListName,omitempty" json:"taskListName,omitempty" xml:"taskListName,omitempty"`
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+}
+
+// CreateResponseBody is the type of the "schedule" service "Create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// Unique schedule identifier.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Standard cron expression.
+	Cron string `form:"cron" json:"cron" xml:"cron"`
+	// Timezone in which the cron expression is evaluated.
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+	// Name of the task template to create tasks from.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string `form:"taskListName,omitempty" json:"taskListName,omitempty" xml:"taskListName,omitempty"`
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Time of the next run.
+	NextRunAt string `form:"nextRunAt" json:"nextRunAt" xml:"nextRunAt"`
+	// Time of the last run.
+	LastRunAt *string `form:"lastRunAt,omitempty" json:"lastRunAt,omitempty" xml:"lastRunAt,omitempty"`
+	// Schedule creation time.
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Most recent runs of the schedule.
+	Runs []*ScheduleRunResponseBody `form:"runs" json:"runs" xml:"runs"`
+}
+
+// GetResponseBody is the type of the "schedule" service "Get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Unique schedule identifier.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Standard cron expression.
+	Cron string `form:"cron" json:"cron" xml:"cron"`
+	// Timezone in which the cron expression is evaluated.
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+	// Name of the task template to create tasks from.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string `form:"taskListName,omitempty" json:"taskListName,omitempty" xml:"taskListName,omitempty"`
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Time of the next run.
+	NextRunAt string `form:"nextRunAt" json:"nextRunAt" xml:"nextRunAt"`
+	// Time of the last run.
+	LastRunAt *string `form:"lastRunAt,omitempty" json:"lastRunAt,omitempty" xml:"lastRunAt,omitempty"`
+	// Schedule creation time.
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Most recent runs of the schedule.
+	Runs []*ScheduleRunResponseBody `form:"runs" json:"runs" xml:"runs"`
+}
+
+// ListResponseBody is the type of the "schedule" service "List" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// Array of schedules.
+	Schedules []*ScheduleResponseBody `form:"schedules" json:"schedules" xml:"schedules"`
+}
+
+// ScheduleRunResponseBody is used to define fields on response body types.
+type ScheduleRunResponseBody struct {
+	// Time for which the run was scheduled.
+	ScheduledAt string `form:"scheduledAt" json:"scheduledAt" xml:"scheduledAt"`
+	// Unique identifier of the created task or taskList.
+	ID string `form:"id" json:"id" xml:"id"`
+}
+
+// ScheduleResponseBody is used to define fields on response body types.
+type ScheduleResponseBody struct {
+	// Unique schedule identifier.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Standard cron expression.
+	Cron string `form:"cron" json:"cron" xml:"cron"`
+	// Timezone in which the cron expression is evaluated.
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+	// Name of the task template to create tasks from.
+	TaskName *string `form:"taskName,omitempty" json:"taskName,omitempty" xml:"taskName,omitempty"`
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string `form:"taskListName,omitempty" json:"taskListName,omitempty" xml:"taskListName,omitempty"`
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Time of the next run.
+	NextRunAt string `form:"nextRunAt" json:"nextRunAt" xml:"nextRunAt"`
+	// Time of the last run.
+	LastRunAt *string `form:"lastRunAt,omitempty" json:"lastRunAt,omitempty" xml:"lastRunAt,omitempty"`
+	// Schedule creation time.
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Most recent runs of the schedule.
+	Runs []*ScheduleRunResponseBody `form:"runs" json:"runs" xml:"runs"`
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "Create" endpoint of the "schedule" service.
+func NewCreateResponseBody(res *schedule.Schedule) *CreateResponseBody {
+	body := &CreateResponseBody{
+		ID:             res.ID,
+		Cron:           res.Cron,
+		Timezone:       res.Timezone,
+		TaskName:       res.TaskName,
+		TaskListName:   res.TaskListName,
+		Data:           res.Data,
+		CacheNamespace: res.CacheNamespace,
+		CacheScope:     res.CacheScope,
+		NextRunAt:      res.NextRunAt,
+		LastRunAt:      res.LastRunAt,
+		CreatedAt:      res.CreatedAt,
+	}
+	if res.Runs != nil {
+		body.Runs = make([]*ScheduleRunResponseBody, len(res.Runs))
+		for i, val := range res.Runs {
+			body.Runs[i] = marshalScheduleScheduleRunToScheduleRunResponseBody(val)
+		}
+	} else {
+		body.Runs = []*ScheduleRunResponseBody{}
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "Get" endpoint of the "schedule" service.
+func NewGetResponseBody(res *schedule.Schedule) *GetResponseBody {
+	body := &GetResponseBody{
+		ID:             res.ID,
+		Cron:           res.Cron,
+		Timezone:       res.Timezone,
+		TaskName:       res.TaskName,
+		TaskListName:   res.TaskListName,
+		Data:           res.Data,
+		CacheNamespace: res.CacheNamespace,
+		CacheScope:     res.CacheScope,
+		NextRunAt:      res.NextRunAt,
+		LastRunAt:      res.LastRunAt,
+		CreatedAt:      res.CreatedAt,
+	}
+	if res.Runs != nil {
+		body.Runs = make([]*ScheduleRunResponseBody, len(res.Runs))
+		for i, val := range res.Runs {
+			body.Runs[i] = marshalScheduleScheduleRunToScheduleRunResponseBody(val)
+		}
+	} else {
+		body.Runs = []*ScheduleRunResponseBody{}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "List" endpoint of the "schedule" service.
+func NewListResponseBody(res *schedule.SchedulesResponse) *ListResponseBody {
+	body := &ListResponseBody{}
+	if res.Schedules != nil {
+		body.Schedules = make([]*ScheduleResponseBody, len(res.Schedules))
+		for i, val := range res.Schedules {
+			body.Schedules[i] = marshalScheduleScheduleToScheduleResponseBody(val)
+		}
+	} else {
+		body.Schedules = []*ScheduleResponseBody{}
+	}
+	return body
+}
+
+// NewCreateScheduleRequest builds a schedule service Create endpoint payload.
+func NewCreateScheduleRequest(body *CreateRequestBody) *schedule.CreateScheduleRequest {
+	v := &schedule.CreateScheduleRequest{
+		Cron:           *body.Cron,
+		Timezone:       body.Timezone,
+		TaskName:       body.TaskName,
+		TaskListName:   body.TaskListName,
+		Data:           body.Data,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+	}
+
+	return v
+}
+
+// NewGetScheduleRequest builds a schedule service Get endpoint payload.
+func NewGetScheduleRequest(scheduleID string) *schedule.ScheduleRequest {
+	v := &schedule.ScheduleRequest{}
+	v.ScheduleID = scheduleID
+
+	return v
+}
+
+// NewDeleteScheduleRequest builds a schedule service Delete endpoint payload.
+func NewDeleteScheduleRequest(scheduleID string) *schedule.ScheduleRequest {
+	v := &schedule.ScheduleRequest{}
+	v.ScheduleID = scheduleID
+
+	return v
+}
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.Cron == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cron", "body"))
+	}
+	return
+}
@@ -10,25 +10,95 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 
 	task "github.com/eclipse-xfsc/task-sheduler/gen/task"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildCreatePayload builds the payload for the task Create endpoint from CLI
 // flags.
-func BuildCreatePayload(taskCreateBody string, taskCreateTaskName string, taskCreateCacheNamespace string, taskCreateCacheScope string) (*task.CreateTaskRequest, error) {
+func BuildCreatePayload(taskCreateBody string, taskCreateTaskName string, taskCreateTemplateVersion string, taskCreateRunAt string, taskCreateDelay string, taskCreatePriority string, taskCreateWait string, taskCreateCallbackURL string, taskCreateCacheNamespace string, taskCreateCacheScope string, taskCreateIdempotencyKey string) (*task.CreateTaskRequest, error) {
 	var err error
 	var body any
 	{
 		err = json.Unmarshal([]byte(taskCreateBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Dolores atque error ab.\"")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Impedit et voluptate ipsa repellendus omnis.\"")
 		}
 	}
 	var taskName string
 	{
 		taskName = taskCreateTaskName
 	}
+	var templateVersion *int
+	{
+		if taskCreateTemplateVersion != "" {
+			var v int64
+			v, err = strconv.ParseInt(taskCreateTemplateVersion, 10, strconv.IntSize)
+			val := int(v)
+			templateVersion = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for templateVersion, must be INT")
+			}
+			if *templateVersion < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("templateVersion", *templateVersion, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var runAt *string
+	{
+		if taskCreateRunAt != "" {
+			runAt = &taskCreateRunAt
+			err = goa.MergeErrors(err, goa.ValidateFormat("runAt", *runAt, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var delay *string
+	{
+		if taskCreateDelay != "" {
+			delay = &taskCreateDelay
+		}
+	}
+	var priority *int
+	{
+		if taskCreatePriority != "" {
+			var v int64
+			v, err = strconv.ParseInt(taskCreatePriority, 10, strconv.IntSize)
+			val := int(v)
+			priority = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for priority, must be INT")
+			}
+			if *priority < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("priority", *priority, 0, true))
+			}
+			if *priority > 9 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("priority", *priority, 9, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var wait *string
+	{
+		if taskCreateWait != "" {
+			wait = &taskCreateWait
+		}
+	}
+	var callbackURL *string
+	{
+		if taskCreateCallbackURL != "" {
+			callbackURL = &taskCreateCallbackURL
+		}
+	}
 	var cacheNamespace *string
 	{
 		if taskCreateCacheNamespace != "" {
@@ -41,13 +111,32 @@ func BuildCreatePayload(taskCreateBody string, taskCreateTaskName string, taskCr
 			cacheScope = &taskCreateCacheScope
 		}
 	}
+	var idempotencyKey *string
+	{
+		if taskCreateIdempotencyKey != "" {
+			idempotencyKey = &taskCreateIdempotencyKey
+			if utf8.RuneCountInString(*idempotencyKey) > 255 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("idempotencyKey", *idempotencyKey, utf8.RuneCountInString(*idempotencyKey), 255, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	v := body
 	res := &task.CreateTaskRequest{
 		Data: v,
 	}
 	res.TaskName = taskName
+	res.TemplateVersion = templateVersion
+	res.RunAt = runAt
+	res.Delay = delay
+	res.Priority = priority
+	res.Wait = wait
+	res.CallbackURL = callbackURL
 	res.CacheNamespace = cacheNamespace
 	res.CacheScope = cacheScope
+	res.IdempotencyKey = idempotencyKey
 
 	return res, nil
 }
@@ -64,3 +153,135 @@ func BuildTaskResultPayload(taskTaskResultTaskID string) (*task.TaskResultReques
 
 	return v, nil
 }
+
+// BuildTaskStatusPayload builds the payload for the task TaskStatus endpoint
+// from CLI flags.
+func BuildTaskStatusPayload(taskTaskStatusTaskID string) (*task.TaskStatusRequest, error) {
+	var taskID string
+	{
+		taskID = taskTaskStatusTaskID
+	}
+	v := &task.TaskStatusRequest{}
+	v.TaskID = taskID
+
+	return v, nil
+}
+
+// BuildSearchPayload builds the payload for the task Search endpoint from CLI
+// flags.
+func BuildSearchPayload(taskSearchName string, taskSearchState string, taskSearchCacheNamespace string, taskSearchCacheScope string, taskSearchCreatedAfter string, taskSearchCreatedBefore string, taskSearchSort string, taskSearchLimit string, taskSearchCursor string) (*task.SearchTasksRequest, error) {
+	var err error
+	var name *string
+	{
+		if taskSearchName != "" {
+			name = &taskSearchName
+		}
+	}
+	var state *string
+	{
+		if taskSearchState != "" {
+			state = &taskSearchState
+			if !(*state == "created" || *state == "pending" || *state == "done" || *state == "failed" || *state == "cancelled") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("state", *state, []any{"created", "pending", "done", "failed", "cancelled"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cacheNamespace *string
+	{
+		if taskSearchCacheNamespace != "" {
+			cacheNamespace = &taskSearchCacheNamespace
+		}
+	}
+	var cacheScope *string
+	{
+		if taskSearchCacheScope != "" {
+			cacheScope = &taskSearchCacheScope
+		}
+	}
+	var createdAfter *string
+	{
+		if taskSearchCreatedAfter != "" {
+			createdAfter = &taskSearchCreatedAfter
+			err = goa.MergeErrors(err, goa.ValidateFormat("createdAfter", *createdAfter, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var createdBefore *string
+	{
+		if taskSearchCreatedBefore != "" {
+			createdBefore = &taskSearchCreatedBefore
+			err = goa.MergeErrors(err, goa.ValidateFormat("createdBefore", *createdBefore, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sort string
+	{
+		if taskSearchSort != "" {
+			sort = taskSearchSort
+			if !(sort == "createdAt" || sort == "-createdAt") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"createdAt", "-createdAt"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if taskSearchLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(taskSearchLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if taskSearchCursor != "" {
+			cursor = &taskSearchCursor
+		}
+	}
+	v := &task.SearchTasksRequest{}
+	v.Name = name
+	v.State = state
+	v.CacheNamespace = cacheNamespace
+	v.CacheScope = cacheScope
+	v.CreatedAfter = createdAfter
+	v.CreatedBefore = createdBefore
+	v.Sort = sort
+	v.Limit = limit
+	v.Cursor = cursor
+
+	return v, nil
+}
+
+// BuildCancelPayload builds the payload for the task Cancel endpoint from CLI
+// flags.
+func BuildCancelPayload(taskCancelTaskID string) (*task.CancelTaskRequest, error) {
+	var taskID string
+	{
+		taskID = taskCancelTaskID
+	}
+	v := &task.CancelTaskRequest{}
+	v.TaskID = taskID
+
+	return v, nil
+}
@@ -24,6 +24,16 @@ type Client struct {
 	// endpoint.
 	TaskResultDoer goahttp.Doer
 
+	// TaskStatus Doer is the HTTP client used to make requests to the TaskStatus
+	// endpoint.
+	TaskStatusDoer goahttp.Doer
+
+	// Search Doer is the HTTP client used to make requests to the Search endpoint.
+	SearchDoer goahttp.Doer
+
+	// Cancel Doer is the HTTP client used to make requests to the Cancel endpoint.
+	CancelDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -46,6 +56,9 @@ func NewClient(
 	return &Client{
 		CreateDoer:          doer,
 		TaskResultDoer:      doer,
+		TaskStatusDoer:      doer,
+		SearchDoer:          doer,
+		CancelDoer:          doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -96,3 +109,65 @@ func (c *Client) TaskResult() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// TaskStatus returns an endpoint that makes HTTP requests to the task service
+// TaskStatus server.
+func (c *Client) TaskStatus() goa.Endpoint {
+	var (
+		decodeResponse = DecodeTaskStatusResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildTaskStatusRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TaskStatusDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "TaskStatus", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Search returns an endpoint that makes HTTP requests to the task service
+// Search server.
+func (c *Client) Search() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSearchRequest(c.encoder)
+		decodeResponse = DecodeSearchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSearchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SearchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "Search", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Cancel returns an endpoint that makes HTTP requests to the task service
+// Cancel server.
+func (c *Client) Cancel() goa.Endpoint {
+	var (
+		decodeResponse = DecodeCancelResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCancelRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CancelDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "Cancel", err)
+		}
+		return decodeResponse(resp)
+	}
+}
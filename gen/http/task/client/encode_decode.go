@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -59,6 +60,30 @@ func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 			head := *p.CacheScope
 			req.Header.Set("x-cache-scope", head)
 		}
+		if p.IdempotencyKey != nil {
+			head := *p.IdempotencyKey
+			req.Header.Set("Idempotency-Key", head)
+		}
+		values := req.URL.Query()
+		if p.TemplateVersion != nil {
+			values.Add("version", fmt.Sprintf("%v", *p.TemplateVersion))
+		}
+		if p.RunAt != nil {
+			values.Add("runAt", *p.RunAt)
+		}
+		if p.Delay != nil {
+			values.Add("delay", *p.Delay)
+		}
+		if p.Priority != nil {
+			values.Add("priority", fmt.Sprintf("%v", *p.Priority))
+		}
+		if p.Wait != nil {
+			values.Add("wait", *p.Wait)
+		}
+		if p.CallbackURL != nil {
+			values.Add("callbackURL", *p.CallbackURL)
+		}
+		req.URL.RawQuery = values.Encode()
 		body := p.Data
 		if err := encoder(req).Encode(&body); err != nil {
 			return goahttp.ErrEncodingError("task", "Create", err)
@@ -166,3 +191,238 @@ func DecodeTaskResultResponse(decoder func(*http.Response) goahttp.Decoder, rest
 		}
 	}
 }
+
+// BuildTaskStatusRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "TaskStatus" endpoint
+func (c *Client) BuildTaskStatusRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		taskID string
+	)
+	{
+		p, ok := v.(*task.TaskStatusRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "TaskStatus", "*task.TaskStatusRequest", v)
+		}
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TaskStatusTaskPath(taskID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "TaskStatus", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeTaskStatusResponse returns a decoder for responses returned by the
+// task TaskStatus endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeTaskStatusResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body TaskStatusResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "TaskStatus", err)
+			}
+			err = ValidateTaskStatusResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "TaskStatus", err)
+			}
+			res := NewTaskStatusResponseOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "TaskStatus", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSearchRequest instantiates a HTTP request object with method and path
+// set to call the "task" service "Search" endpoint
+func (c *Client) BuildSearchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SearchTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "Search", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSearchRequest returns an encoder for requests sent to the task Search
+// server.
+func EncodeSearchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.SearchTasksRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "Search", "*task.SearchTasksRequest", v)
+		}
+		values := req.URL.Query()
+		if p.Name != nil {
+			values.Add("name", *p.Name)
+		}
+		if p.State != nil {
+			values.Add("state", *p.State)
+		}
+		if p.CacheNamespace != nil {
+			values.Add("cacheNamespace", *p.CacheNamespace)
+		}
+		if p.CacheScope != nil {
+			values.Add("cacheScope", *p.CacheScope)
+		}
+		if p.CreatedAfter != nil {
+			values.Add("createdAfter", *p.CreatedAfter)
+		}
+		if p.CreatedBefore != nil {
+			values.Add("createdBefore", *p.CreatedBefore)
+		}
+		values.Add("sort", p.Sort)
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSearchResponse returns a decoder for responses returned by the task
+// Search endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeSearchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SearchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "Search", err)
+			}
+			err = ValidateSearchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "Search", err)
+			}
+			res := NewSearchTasksResponseOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "Search", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCancelRequest instantiates a HTTP request object with method and path
+// set to call the "task" service "Cancel" endpoint
+func (c *Client) BuildCancelRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		taskID string
+	)
+	{
+		p, ok := v.(*task.CancelTaskRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "Cancel", "*task.CancelTaskRequest", v)
+		}
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CancelTaskPath(taskID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "Cancel", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeCancelResponse returns a decoder for responses returned by the task
+// Cancel endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeCancelResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "Cancel", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalTaskStatusResponseResponseBodyToTaskTaskStatusResponse builds a
+// value of type *task.TaskStatusResponse from a value of type
+// *TaskStatusResponseResponseBody.
+func unmarshalTaskStatusResponseResponseBodyToTaskTaskStatusResponse(v *TaskStatusResponseResponseBody) *task.TaskStatusResponse {
+	res := &task.TaskStatusResponse{
+		ID:              *v.ID,
+		Name:            *v.Name,
+		State:           *v.State,
+		Retries:         *v.Retries,
+		CreatedAt:       *v.CreatedAt,
+		StartedAt:       v.StartedAt,
+		FinishedAt:      v.FinishedAt,
+		ResponseCode:    v.ResponseCode,
+		Error:           v.Error,
+		CacheNamespace:  v.CacheNamespace,
+		CacheScope:      v.CacheScope,
+		TemplateVersion: v.TemplateVersion,
+		RunAt:           v.RunAt,
+		Priority:        v.Priority,
+		NextAttemptAt:   v.NextAttemptAt,
+		FailureReason:   v.FailureReason,
+	}
+
+	return res
+}
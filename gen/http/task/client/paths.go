@@ -20,3 +20,18 @@ func CreateTaskPath(taskName string) string {
 func TaskResultTaskPath(taskID string) string {
 	return fmt.Sprintf("/v1/taskResult/%v", taskID)
 }
+
+// TaskStatusTaskPath returns the URL path to the task service TaskStatus HTTP endpoint.
+func TaskStatusTaskPath(taskID string) string {
+	return fmt.Sprintf("/v1/task/%v/status", taskID)
+}
+
+// SearchTaskPath returns the URL path to the task service Search HTTP endpoint.
+func SearchTaskPath() string {
+	return "/v1/tasks"
+}
+
+// CancelTaskPath returns the URL path to the task service Cancel HTTP endpoint.
+func CancelTaskPath(taskID string) string {
+	return fmt.Sprintf("/v1/task/%v", taskID)
+}
@@ -17,6 +17,95 @@ import (
 type CreateResponseBody struct {
 	// Unique task identifier.
 	TaskID *string `form:"taskID,omitempty" json:"taskID,omitempty" xml:"taskID,omitempty"`
+	// State of the task at the end of the wait time. It is set only if the request
+	// waited for the task.
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Result of the task. It is set only if the task completed within the wait
+	// time.
+	Result any `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+}
+
+// TaskStatusResponseBody is the type of the "task" service "TaskStatus"
+// endpoint HTTP response body.
+type TaskStatusResponseBody struct {
+	// Unique task identifier.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Task name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Current state of the task.
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Number of failed attempts to execute the task.
+	Retries *int `form:"retries,omitempty" json:"retries,omitempty" xml:"retries,omitempty"`
+	// Task creation time.
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Task execution start time.
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Task completion time.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Response code received after the task request is executed.
+	ResponseCode *int `form:"responseCode,omitempty" json:"responseCode,omitempty" xml:"responseCode,omitempty"`
+	// Last error that occurred during task execution.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Version of the task template from which the task was created.
+	TemplateVersion *int `form:"templateVersion,omitempty" json:"templateVersion,omitempty" xml:"templateVersion,omitempty"`
+	// Time at which the task is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
+	// Priority of the task.
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Time of the next execution attempt of a failed task.
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// Reason of the last failed execution of the task.
+	FailureReason *string `form:"failureReason,omitempty" json:"failureReason,omitempty" xml:"failureReason,omitempty"`
+}
+
+// SearchResponseBody is the type of the "task" service "Search" endpoint HTTP
+// response body.
+type SearchResponseBody struct {
+	// Array of tasks.
+	Tasks []*TaskStatusResponseResponseBody `form:"tasks,omitempty" json:"tasks,omitempty" xml:"tasks,omitempty"`
+	// Cursor for retrieving the next page of results, if there are more.
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
+}
+
+// TaskStatusResponseResponseBody is used to define fields on response body
+// types.
+type TaskStatusResponseResponseBody struct {
+	// Unique task identifier.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Task name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Current state of the task.
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Number of failed attempts to execute the task.
+	Retries *int `form:"retries,omitempty" json:"retries,omitempty" xml:"retries,omitempty"`
+	// Task creation time.
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Task execution start time.
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Task completion time.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Response code received after the task request is executed.
+	ResponseCode *int `form:"responseCode,omitempty" json:"responseCode,omitempty" xml:"responseCode,omitempty"`
+	// Last error that occurred during task execution.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Version of the task template from which the task was created.
+	TemplateVersion *int `form:"templateVersion,omitempty" json:"templateVersion,omitempty" xml:"templateVersion,omitempty"`
+	// Time at which the task is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
+	// Priority of the task.
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Time of the next execution attempt of a failed task.
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// Reason of the last failed execution of the task.
+	FailureReason *string `form:"failureReason,omitempty" json:"failureReason,omitempty" xml:"failureReason,omitempty"`
 }
 
 // NewCreateTaskResultOK builds a "task" service "Create" endpoint result from
@@ -24,6 +113,47 @@ type CreateResponseBody struct {
 func NewCreateTaskResultOK(body *CreateResponseBody) *task.CreateTaskResult {
 	v := &task.CreateTaskResult{
 		TaskID: *body.TaskID,
+		State:  body.State,
+		Result: body.Result,
+	}
+
+	return v
+}
+
+// NewTaskStatusResponseOK builds a "task" service "TaskStatus" endpoint result
+// from a HTTP "OK" response.
+func NewTaskStatusResponseOK(body *TaskStatusResponseBody) *task.TaskStatusResponse {
+	v := &task.TaskStatusResponse{
+		ID:              *body.ID,
+		Name:            *body.Name,
+		State:           *body.State,
+		Retries:         *body.Retries,
+		CreatedAt:       *body.CreatedAt,
+		StartedAt:       body.StartedAt,
+		FinishedAt:      body.FinishedAt,
+		ResponseCode:    body.ResponseCode,
+		Error:           body.Error,
+		CacheNamespace:  body.CacheNamespace,
+		CacheScope:      body.CacheScope,
+		TemplateVersion: body.TemplateVersion,
+		RunAt:           body.RunAt,
+		Priority:        body.Priority,
+		NextAttemptAt:   body.NextAttemptAt,
+		FailureReason:   body.FailureReason,
+	}
+
+	return v
+}
+
+// NewSearchTasksResponseOK builds a "task" service "Search" endpoint result
+// from a HTTP "OK" response.
+func NewSearchTasksResponseOK(body *SearchResponseBody) *task.SearchTasksResponse {
+	v := &task.SearchTasksResponse{
+		Next: body.Next,
+	}
+	v.Tasks = make([]*task.TaskStatusResponse, len(body.Tasks))
+	for i, val := range body.Tasks {
+		v.Tasks[i] = unmarshalTaskStatusResponseResponseBodyToTaskTaskStatusResponse(val)
 	}
 
 	return v
@@ -36,3 +166,100 @@ func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
 	}
 	return
 }
+
+// ValidateTaskStatusResponseBody runs the validations defined on
+// TaskStatusResponseBody
+func ValidateTaskStatusResponseBody(body *TaskStatusResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.Retries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("retries", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	if body.RunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.runAt", *body.RunAt, goa.FormatDateTime))
+	}
+	if body.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextAttemptAt", *body.NextAttemptAt, goa.FormatDateTime))
+	}
+	if body.FailureReason != nil {
+		if !(*body.FailureReason == "http" || *body.FailureReason == "policy" || *body.FailureReason == "cache" || *body.FailureReason == "status" || *body.FailureReason == "timeout") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.failureReason", *body.FailureReason, []any{"http", "policy", "cache", "status", "timeout"}))
+		}
+	}
+	return
+}
+
+// ValidateSearchResponseBody runs the validations defined on SearchResponseBody
+func ValidateSearchResponseBody(body *SearchResponseBody) (err error) {
+	if body.Tasks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tasks", "body"))
+	}
+	for _, e := range body.Tasks {
+		if e != nil {
+			if err2 := ValidateTaskStatusResponseResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateTaskStatusResponseResponseBody runs the validations defined on
+// TaskStatusResponseResponseBody
+func ValidateTaskStatusResponseResponseBody(body *TaskStatusResponseResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.Retries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("retries", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	if body.RunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.runAt", *body.RunAt, goa.FormatDateTime))
+	}
+	if body.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextAttemptAt", *body.NextAttemptAt, goa.FormatDateTime))
+	}
+	if body.FailureReason != nil {
+		if !(*body.FailureReason == "http" || *body.FailureReason == "policy" || *body.FailureReason == "cache" || *body.FailureReason == "status" || *body.FailureReason == "timeout") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.failureReason", *body.FailureReason, []any{"http", "policy", "cache", "status", "timeout"}))
+		}
+	}
+	return
+}
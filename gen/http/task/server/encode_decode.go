@@ -12,6 +12,8 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
+	"unicode/utf8"
 
 	task "github.com/eclipse-xfsc/task-sheduler/gen/task"
 	goahttp "goa.design/goa/v3/http"
@@ -51,13 +53,77 @@ func DecodeCreateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.
 		}
 
 		var (
-			taskName       string
-			cacheNamespace *string
-			cacheScope     *string
+			taskName        string
+			templateVersion *int
+			runAt           *string
+			delay           *string
+			priority        *int
+			wait            *string
+			callbackURL     *string
+			cacheNamespace  *string
+			cacheScope      *string
+			idempotencyKey  *string
 
 			params = mux.Vars(r)
 		)
 		taskName = params["taskName"]
+		qp := r.URL.Query()
+		{
+			templateVersionRaw := qp.Get("version")
+			if templateVersionRaw != "" {
+				v, err2 := strconv.ParseInt(templateVersionRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("templateVersion", templateVersionRaw, "integer"))
+				}
+				pv := int(v)
+				templateVersion = &pv
+			}
+		}
+		if templateVersion != nil {
+			if *templateVersion < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("templateVersion", *templateVersion, 1, true))
+			}
+		}
+		runAtRaw := qp.Get("runAt")
+		if runAtRaw != "" {
+			runAt = &runAtRaw
+		}
+		if runAt != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("runAt", *runAt, goa.FormatDateTime))
+		}
+		delayRaw := qp.Get("delay")
+		if delayRaw != "" {
+			delay = &delayRaw
+		}
+		{
+			priorityRaw := qp.Get("priority")
+			if priorityRaw != "" {
+				v, err2 := strconv.ParseInt(priorityRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("priority", priorityRaw, "integer"))
+				}
+				pv := int(v)
+				priority = &pv
+			}
+		}
+		if priority != nil {
+			if *priority < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("priority", *priority, 0, true))
+			}
+		}
+		if priority != nil {
+			if *priority > 9 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("priority", *priority, 9, false))
+			}
+		}
+		waitRaw := qp.Get("wait")
+		if waitRaw != "" {
+			wait = &waitRaw
+		}
+		callbackURLRaw := qp.Get("callbackURL")
+		if callbackURLRaw != "" {
+			callbackURL = &callbackURLRaw
+		}
 		cacheNamespaceRaw := r.Header.Get("x-cache-namespace")
 		if cacheNamespaceRaw != "" {
 			cacheNamespace = &cacheNamespaceRaw
@@ -66,7 +132,19 @@ func DecodeCreateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.
 		if cacheScopeRaw != "" {
 			cacheScope = &cacheScopeRaw
 		}
-		payload := NewCreateTaskRequest(body, taskName, cacheNamespace, cacheScope)
+		idempotencyKeyRaw := r.Header.Get("Idempotency-Key")
+		if idempotencyKeyRaw != "" {
+			idempotencyKey = &idempotencyKeyRaw
+		}
+		if idempotencyKey != nil {
+			if utf8.RuneCountInString(*idempotencyKey) > 255 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("idempotencyKey", *idempotencyKey, utf8.RuneCountInString(*idempotencyKey), 255, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateTaskRequest(body, taskName, templateVersion, runAt, delay, priority, wait, callbackURL, cacheNamespace, cacheScope, idempotencyKey)
 
 		return payload, nil
 	}
@@ -99,3 +177,186 @@ func DecodeTaskResultRequest(mux goahttp.Muxer, decoder func(*http.Request) goah
 		return payload, nil
 	}
 }
+
+// EncodeTaskStatusResponse returns an encoder for responses returned by the
+// task TaskStatus endpoint.
+func EncodeTaskStatusResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.TaskStatusResponse)
+		enc := encoder(ctx, w)
+		body := NewTaskStatusResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeTaskStatusRequest returns a decoder for requests sent to the task
+// TaskStatus endpoint.
+func DecodeTaskStatusRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			taskID string
+
+			params = mux.Vars(r)
+		)
+		taskID = params["taskID"]
+		payload := NewTaskStatusRequest(taskID)
+
+		return payload, nil
+	}
+}
+
+// EncodeSearchResponse returns an encoder for responses returned by the task
+// Search endpoint.
+func EncodeSearchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.SearchTasksResponse)
+		enc := encoder(ctx, w)
+		body := NewSearchResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSearchRequest returns a decoder for requests sent to the task Search
+// endpoint.
+func DecodeSearchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			name           *string
+			state          *string
+			cacheNamespace *string
+			cacheScope     *string
+			createdAfter   *string
+			createdBefore  *string
+			sort           string
+			limit          int
+			cursor         *string
+			err            error
+		)
+		qp := r.URL.Query()
+		nameRaw := qp.Get("name")
+		if nameRaw != "" {
+			name = &nameRaw
+		}
+		stateRaw := qp.Get("state")
+		if stateRaw != "" {
+			state = &stateRaw
+		}
+		if state != nil {
+			if !(*state == "created" || *state == "pending" || *state == "done" || *state == "failed" || *state == "cancelled") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("state", *state, []any{"created", "pending", "done", "failed", "cancelled"}))
+			}
+		}
+		cacheNamespaceRaw := qp.Get("cacheNamespace")
+		if cacheNamespaceRaw != "" {
+			cacheNamespace = &cacheNamespaceRaw
+		}
+		cacheScopeRaw := qp.Get("cacheScope")
+		if cacheScopeRaw != "" {
+			cacheScope = &cacheScopeRaw
+		}
+		createdAfterRaw := qp.Get("createdAfter")
+		if createdAfterRaw != "" {
+			createdAfter = &createdAfterRaw
+		}
+		if createdAfter != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("createdAfter", *createdAfter, goa.FormatDateTime))
+		}
+		createdBeforeRaw := qp.Get("createdBefore")
+		if createdBeforeRaw != "" {
+			createdBefore = &createdBeforeRaw
+		}
+		if createdBefore != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("createdBefore", *createdBefore, goa.FormatDateTime))
+		}
+		sortRaw := qp.Get("sort")
+		if sortRaw != "" {
+			sort = sortRaw
+		} else {
+			sort = "-createdAt"
+		}
+		if !(sort == "createdAt" || sort == "-createdAt") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"createdAt", "-createdAt"}))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 20
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSearchTasksRequest(name, state, cacheNamespace, cacheScope, createdAfter, createdBefore, sort, limit, cursor)
+
+		return payload, nil
+	}
+}
+
+// EncodeCancelResponse returns an encoder for responses returned by the task
+// Cancel endpoint.
+func EncodeCancelResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeCancelRequest returns a decoder for requests sent to the task Cancel
+// endpoint.
+func DecodeCancelRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			taskID string
+
+			params = mux.Vars(r)
+		)
+		taskID = params["taskID"]
+		payload := NewCancelTaskRequest(taskID)
+
+		return payload, nil
+	}
+}
+
+// marshalTaskTaskStatusResponseToTaskStatusResponseResponseBody builds a value
+// of type *TaskStatusResponseResponseBody from a value of type
+// *task.TaskStatusResponse.
+func marshalTaskTaskStatusResponseToTaskStatusResponseResponseBody(v *task.TaskStatusResponse) *TaskStatusResponseResponseBody {
+	res := &TaskStatusResponseResponseBody{
+		ID:              v.ID,
+		Name:            v.Name,
+		State:           v.State,
+		Retries:         v.Retries,
+		CreatedAt:       v.CreatedAt,
+		StartedAt:       v.StartedAt,
+		FinishedAt:      v.FinishedAt,
+		ResponseCode:    v.ResponseCode,
+		Error:           v.Error,
+		CacheNamespace:  v.CacheNamespace,
+		CacheScope:      v.CacheScope,
+		TemplateVersion: v.TemplateVersion,
+		RunAt:           v.RunAt,
+		Priority:        v.Priority,
+		NextAttemptAt:   v.NextAttemptAt,
+		FailureReason:   v.FailureReason,
+	}
+
+	return res
+}
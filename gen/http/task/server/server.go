@@ -21,6 +21,9 @@ type Server struct {
 	Mounts     []*MountPoint
 	Create     http.Handler
 	TaskResult http.Handler
+	TaskStatus http.Handler
+	Search     http.Handler
+	Cancel     http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -52,9 +55,15 @@ func New(
 		Mounts: []*MountPoint{
 			{"Create", "POST", "/v1/task/{taskName}"},
 			{"TaskResult", "GET", "/v1/taskResult/{taskID}"},
+			{"TaskStatus", "GET", "/v1/task/{taskID}/status"},
+			{"Search", "GET", "/v1/tasks"},
+			{"Cancel", "DELETE", "/v1/task/{taskID}"},
 		},
 		Create:     NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
 		TaskResult: NewTaskResultHandler(e.TaskResult, mux, decoder, encoder, errhandler, formatter),
+		TaskStatus: NewTaskStatusHandler(e.TaskStatus, mux, decoder, encoder, errhandler, formatter),
+		Search:     NewSearchHandler(e.Search, mux, decoder, encoder, errhandler, formatter),
+		Cancel:     NewCancelHandler(e.Cancel, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -65,6 +74,9 @@ func (s *Server) Service() string { return "task" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Create = m(s.Create)
 	s.TaskResult = m(s.TaskResult)
+	s.TaskStatus = m(s.TaskStatus)
+	s.Search = m(s.Search)
+	s.Cancel = m(s.Cancel)
 }
 
 // MethodNames returns the methods served.
@@ -74,6 +86,9 @@ func (s *Server) MethodNames() []string { return task.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountCreateHandler(mux, h.Create)
 	MountTaskResultHandler(mux, h.TaskResult)
+	MountTaskStatusHandler(mux, h.TaskStatus)
+	MountSearchHandler(mux, h.Search)
+	MountCancelHandler(mux, h.Cancel)
 }
 
 // Mount configures the mux to serve the task endpoints.
@@ -182,3 +197,156 @@ func NewTaskResultHandler(
 		}
 	})
 }
+
+// MountTaskStatusHandler configures the mux to serve the "task" service
+// "TaskStatus" endpoint.
+func MountTaskStatusHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/task/{taskID}/status", f)
+}
+
+// NewTaskStatusHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "TaskStatus" endpoint.
+func NewTaskStatusHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTaskStatusRequest(mux, decoder)
+		encodeResponse = EncodeTaskStatusResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "TaskStatus")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountSearchHandler configures the mux to serve the "task" service "Search"
+// endpoint.
+func MountSearchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/tasks", f)
+}
+
+// NewSearchHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "Search" endpoint.
+func NewSearchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSearchRequest(mux, decoder)
+		encodeResponse = EncodeSearchResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Search")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCancelHandler configures the mux to serve the "task" service "Cancel"
+// endpoint.
+func MountCancelHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/task/{taskID}", f)
+}
+
+// NewCancelHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "Cancel" endpoint.
+func NewCancelHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCancelRequest(mux, decoder)
+		encodeResponse = EncodeCancelResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Cancel")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
@@ -16,6 +16,95 @@ import (
 type CreateResponseBody struct {
 	// Unique task identifier.
 	TaskID string `form:"taskID" json:"taskID" xml:"taskID"`
+	// State of the task at the end of the wait time. It is set only if the request
+	// waited for the task.
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Result of the task. It is set only if the task completed within the wait
+	// time.
+	Result any `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+}
+
+// TaskStatusResponseBody is the type of the "task" service "TaskStatus"
+// endpoint HTTP response body.
+type TaskStatusResponseBody struct {
+	// Unique task identifier.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Task name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Current state of the task.
+	State string `form:"state" json:"state" xml:"state"`
+	// Number of failed attempts to execute the task.
+	Retries int `form:"retries" json:"retries" xml:"retries"`
+	// Task creation time.
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Task execution start time.
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Task completion time.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Response code received after the task request is executed.
+	ResponseCode *int `form:"responseCode,omitempty" json:"responseCode,omitempty" xml:"responseCode,omitempty"`
+	// Last error that occurred during task execution.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Version of the task template from which the task was created.
+	TemplateVersion *int `form:"templateVersion,omitempty" json:"templateVersion,omitempty" xml:"templateVersion,omitempty"`
+	// Time at which the task is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
+	// Priority of the task.
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Time of the next execution attempt of a failed task.
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// Reason of the last failed execution of the task.
+	FailureReason *string `form:"failureReason,omitempty" json:"failureReason,omitempty" xml:"failureReason,omitempty"`
+}
+
+// SearchResponseBody is the type of the "task" service "Search" endpoint HTTP
+// response body.
+type SearchResponseBody struct {
+	// Array of tasks.
+	Tasks []*TaskStatusResponseResponseBody `form:"tasks" json:"tasks" xml:"tasks"`
+	// Cursor for retrieving the next page of results, if there are more.
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
+}
+
+// TaskStatusResponseResponseBody is used to define fields on response body
+// types.
+type TaskStatusResponseResponseBody struct {
+	// Unique task identifier.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Task name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Current state of the task.
+	State string `form:"state" json:"state" xml:"state"`
+	// Number of failed attempts to execute the task.
+	Retries int `form:"retries" json:"retries" xml:"retries"`
+	// Task creation time.
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Task execution start time.
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Task completion time.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Response code received after the task request is executed.
+	ResponseCode *int `form:"responseCode,omitempty" json:"responseCode,omitempty" xml:"responseCode,omitempty"`
+	// Last error that occurred during task execution.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Version of the task template from which the task was created.
+	TemplateVersion *int `form:"templateVersion,omitempty" json:"templateVersion,omitempty" xml:"templateVersion,omitempty"`
+	// Time at which the task is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
+	// Priority of the task.
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Time of the next execution attempt of a failed task.
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// Reason of the last failed execution of the task.
+	FailureReason *string `form:"failureReason,omitempty" json:"failureReason,omitempty" xml:"failureReason,omitempty"`
 }
 
 // NewCreateResponseBody builds the HTTP response body from the result of the
@@ -23,19 +112,69 @@ type CreateResponseBody struct {
 func NewCreateResponseBody(res *task.CreateTaskResult) *CreateResponseBody {
 	body := &CreateResponseBody{
 		TaskID: res.TaskID,
+		State:  res.State,
+		Result: res.Result,
+	}
+	return body
+}
+
+// NewTaskStatusResponseBody builds the HTTP response body from the result of
+// the "TaskStatus" endpoint of the "task" service.
+func NewTaskStatusResponseBody(res *task.TaskStatusResponse) *TaskStatusResponseBody {
+	body := &TaskStatusResponseBody{
+		ID:              res.ID,
+		Name:            res.Name,
+		State:           res.State,
+		Retries:         res.Retries,
+		CreatedAt:       res.CreatedAt,
+		StartedAt:       res.StartedAt,
+		FinishedAt:      res.FinishedAt,
+		ResponseCode:    res.ResponseCode,
+		Error:           res.Error,
+		CacheNamespace:  res.CacheNamespace,
+		CacheScope:      res.CacheScope,
+		TemplateVersion: res.TemplateVersion,
+		RunAt:           res.RunAt,
+		Priority:        res.Priority,
+		NextAttemptAt:   res.NextAttemptAt,
+		FailureReason:   res.FailureReason,
+	}
+	return body
+}
+
+// NewSearchResponseBody builds the HTTP response body from the result of the
+// "Search" endpoint of the "task" service.
+func NewSearchResponseBody(res *task.SearchTasksResponse) *SearchResponseBody {
+	body := &SearchResponseBody{
+		Next: res.Next,
+	}
+	if res.Tasks != nil {
+		body.Tasks = make([]*TaskStatusResponseResponseBody, len(res.Tasks))
+		for i, val := range res.Tasks {
+			body.Tasks[i] = marshalTaskTaskStatusResponseToTaskStatusResponseResponseBody(val)
+		}
+	} else {
+		body.Tasks = []*TaskStatusResponseResponseBody{}
 	}
 	return body
 }
 
 // NewCreateTaskRequest builds a task service Create endpoint payload.
-func NewCreateTaskRequest(body any, taskName string, cacheNamespace *string, cacheScope *string) *task.CreateTaskRequest {
+func NewCreateTaskRequest(body any, taskName string, templateVersion *int, runAt *string, delay *string, priority *int, wait *string, callbackURL *string, cacheNamespace *string, cacheScope *string, idempotencyKey *string) *task.CreateTaskRequest {
 	v := body
 	res := &task.CreateTaskRequest{
 		Data: v,
 	}
 	res.TaskName = taskName
+	res.TemplateVersion = templateVersion
+	res.RunAt = runAt
+	res.Delay = delay
+	res.Priority = priority
+	res.Wait = wait
+	res.CallbackURL = callbackURL
 	res.CacheNamespace = cacheNamespace
 	res.CacheScope = cacheScope
+	res.IdempotencyKey = idempotencyKey
 
 	return res
 }
@@ -47,3 +186,35 @@ func NewTaskResultRequest(taskID string) *task.TaskResultRequest {
 
 	return v
 }
+
+// NewTaskStatusRequest builds a task service TaskStatus endpoint payload.
+func NewTaskStatusRequest(taskID string) *task.TaskStatusRequest {
+	v := &task.TaskStatusRequest{}
+	v.TaskID = taskID
+
+	return v
+}
+
+// NewSearchTasksRequest builds a task service Search endpoint payload.
+func NewSearchTasksRequest(name *string, state *string, cacheNamespace *string, cacheScope *string, createdAfter *string, createdBefore *string, sort string, limit int, cursor *string) *task.SearchTasksRequest {
+	v := &task.SearchTasksRequest{}
+	v.Name = name
+	v.State = state
+	v.CacheNamespace = cacheNamespace
+	v.CacheScope = cacheScope
+	v.CreatedAfter = createdAfter
+	v.CreatedBefore = createdBefore
+	v.Sort = sort
+	v.Limit = limit
+	v.Cursor = cursor
+
+	return v
+}
+
+// NewCancelTaskRequest builds a task service Cancel endpoint payload.
+func NewCancelTaskRequest(taskID string) *task.CancelTaskRequest {
+	v := &task.CancelTaskRequest{}
+	v.TaskID = taskID
+
+	return v
+}
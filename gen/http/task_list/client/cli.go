@@ -10,25 +10,95 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 
 	tasklist "github.com/eclipse-xfsc/task-sheduler/gen/task_list"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildCreatePayload builds the payload for the taskList Create endpoint from
 // CLI flags.
-func BuildCreatePayload(taskListCreateBody string, taskListCreateTaskListName string, taskListCreateCacheNamespace string, taskListCreateCacheScope string) (*tasklist.CreateTaskListRequest, error) {
+func BuildCreatePayload(taskListCreateBody string, taskListCreateTaskListName string, taskListCreateTemplateVersion string, taskListCreateRunAt string, taskListCreateDelay string, taskListCreatePriority string, taskListCreateWait string, taskListCreateCallbackURL string, taskListCreateCacheNamespace string, taskListCreateCacheScope string, taskListCreateIdempotencyKey string) (*tasklist.CreateTaskListRequest, error) {
 	var err error
 	var body any
 	{
 		err = json.Unmarshal([]byte(taskListCreateBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Deserunt dolor et autem quidem fugiat sint.\"")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Sit et suscipit et architecto minus.\"")
 		}
 	}
 	var taskListName string
 	{
 		taskListName = taskListCreateTaskListName
 	}
+	var templateVersion *int
+	{
+		if taskListCreateTemplateVersion != "" {
+			var v int64
+			v, err = strconv.ParseInt(taskListCreateTemplateVersion, 10, strconv.IntSize)
+			val := int(v)
+			templateVersion = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for templateVersion, must be INT")
+			}
+			if *templateVersion < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("templateVersion", *templateVersion, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var runAt *string
+	{
+		if taskListCreateRunAt != "" {
+			runAt = &taskListCreateRunAt
+			err = goa.MergeErrors(err, goa.ValidateFormat("runAt", *runAt, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var delay *string
+	{
+		if taskListCreateDelay != "" {
+			delay = &taskListCreateDelay
+		}
+	}
+	var priority *int
+	{
+		if taskListCreatePriority != "" {
+			var v int64
+			v, err = strconv.ParseInt(taskListCreatePriority, 10, strconv.IntSize)
+			val := int(v)
+			priority = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for priority, must be INT")
+			}
+			if *priority < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("priority", *priority, 0, true))
+			}
+			if *priority > 9 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("priority", *priority, 9, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var wait *string
+	{
+		if taskListCreateWait != "" {
+			wait = &taskListCreateWait
+		}
+	}
+	var callbackURL *string
+	{
+		if taskListCreateCallbackURL != "" {
+			callbackURL = &taskListCreateCallbackURL
+		}
+	}
 	var cacheNamespace *string
 	{
 		if taskListCreateCacheNamespace != "" {
@@ -41,13 +111,32 @@ func BuildCreatePayload(taskListCreateBody string, taskListCreateTaskListName st
 			cacheScope = &taskListCreateCacheScope
 		}
 	}
+	var idempotencyKey *string
+	{
+		if taskListCreateIdempotencyKey != "" {
+			idempotencyKey = &taskListCreateIdempotencyKey
+			if utf8.RuneCountInString(*idempotencyKey) > 255 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("idempotencyKey", *idempotencyKey, utf8.RuneCountInString(*idempotencyKey), 255, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	v := body
 	res := &tasklist.CreateTaskListRequest{
 		Data: v,
 	}
 	res.TaskListName = taskListName
+	res.TemplateVersion = templateVersion
+	res.RunAt = runAt
+	res.Delay = delay
+	res.Priority = priority
+	res.Wait = wait
+	res.CallbackURL = callbackURL
 	res.CacheNamespace = cacheNamespace
 	res.CacheScope = cacheScope
+	res.IdempotencyKey = idempotencyKey
 
 	return res, nil
 }
@@ -64,3 +153,109 @@ func BuildTaskListStatusPayload(taskListTaskListStatusTaskListID string) (*taskl
 
 	return v, nil
 }
+
+// BuildSearchPayload builds the payload for the taskList Search endpoint from
+// CLI flags.
+func BuildSearchPayload(taskListSearchName string, taskListSearchState string, taskListSearchCacheNamespace string, taskListSearchCacheScope string, taskListSearchCreatedAfter string, taskListSearchCreatedBefore string, taskListSearchSort string, taskListSearchLimit string, taskListSearchCursor string) (*tasklist.SearchTaskListsRequest, error) {
+	var err error
+	var name *string
+	{
+		if taskListSearchName != "" {
+			name = &taskListSearchName
+		}
+	}
+	var state *string
+	{
+		if taskListSearchState != "" {
+			state = &taskListSearchState
+			if !(*state == "created" || *state == "pending" || *state == "done" || *state == "failed") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("state", *state, []any{"created", "pending", "done", "failed"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cacheNamespace *string
+	{
+		if taskListSearchCacheNamespace != "" {
+			cacheNamespace = &taskListSearchCacheNamespace
+		}
+	}
+	var cacheScope *string
+	{
+		if taskListSearchCacheScope != "" {
+			cacheScope = &taskListSearchCacheScope
+		}
+	}
+	var createdAfter *string
+	{
+		if taskListSearchCreatedAfter != "" {
+			createdAfter = &taskListSearchCreatedAfter
+			err = goa.MergeErrors(err, goa.ValidateFormat("createdAfter", *createdAfter, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var createdBefore *string
+	{
+		if taskListSearchCreatedBefore != "" {
+			createdBefore = &taskListSearchCreatedBefore
+			err = goa.MergeErrors(err, goa.ValidateFormat("createdBefore", *createdBefore, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sort string
+	{
+		if taskListSearchSort != "" {
+			sort = taskListSearchSort
+			if !(sort == "createdAt" || sort == "-createdAt") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"createdAt", "-createdAt"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if taskListSearchLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(taskListSearchLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if taskListSearchCursor != "" {
+			cursor = &taskListSearchCursor
+		}
+	}
+	v := &tasklist.SearchTaskListsRequest{}
+	v.Name = name
+	v.State = state
+	v.CacheNamespace = cacheNamespace
+	v.CacheScope = cacheScope
+	v.CreatedAfter = createdAfter
+	v.CreatedBefore = createdBefore
+	v.Sort = sort
+	v.Limit = limit
+	v.Cursor = cursor
+
+	return v, nil
+}
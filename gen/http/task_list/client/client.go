@@ -24,6 +24,9 @@ type Client struct {
 	// TaskListStatus endpoint.
 	TaskListStatusDoer goahttp.Doer
 
+	// Search Doer is the HTTP client used to make requests to the Search endpoint.
+	SearchDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -46,6 +49,7 @@ func NewClient(
 	return &Client{
 		CreateDoer:          doer,
 		TaskListStatusDoer:  doer,
+		SearchDoer:          doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -96,3 +100,27 @@ func (c *Client) TaskListStatus() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Search returns an endpoint that makes HTTP requests to the taskList service
+// Search server.
+func (c *Client) Search() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSearchRequest(c.encoder)
+		decodeResponse = DecodeSearchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSearchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SearchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("taskList", "Search", err)
+		}
+		return decodeResponse(resp)
+	}
+}
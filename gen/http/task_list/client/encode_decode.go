@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -59,6 +60,30 @@ func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 			head := *p.CacheScope
 			req.Header.Set("x-cache-scope", head)
 		}
+		if p.IdempotencyKey != nil {
+			head := *p.IdempotencyKey
+			req.Header.Set("Idempotency-Key", head)
+		}
+		values := req.URL.Query()
+		if p.TemplateVersion != nil {
+			values.Add("version", fmt.Sprintf("%v", *p.TemplateVersion))
+		}
+		if p.RunAt != nil {
+			values.Add("runAt", *p.RunAt)
+		}
+		if p.Delay != nil {
+			values.Add("delay", *p.Delay)
+		}
+		if p.Priority != nil {
+			values.Add("priority", fmt.Sprintf("%v", *p.Priority))
+		}
+		if p.Wait != nil {
+			values.Add("wait", *p.Wait)
+		}
+		if p.CallbackURL != nil {
+			values.Add("callbackURL", *p.CallbackURL)
+		}
+		req.URL.RawQuery = values.Encode()
 		body := p.Data
 		if err := encoder(req).Encode(&body); err != nil {
 			return goahttp.ErrEncodingError("taskList", "Create", err)
@@ -220,6 +245,120 @@ func DecodeTaskListStatusResponse(decoder func(*http.Response) goahttp.Decoder,
 	}
 }
 
+// BuildSearchRequest instantiates a HTTP request object with method and path
+// set to call the "taskList" service "Search" endpoint
+func (c *Client) BuildSearchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SearchTaskListPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskList", "Search", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSearchRequest returns an encoder for requests sent to the taskList
+// Search server.
+func EncodeSearchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*tasklist.SearchTaskListsRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("taskList", "Search", "*tasklist.SearchTaskListsRequest", v)
+		}
+		values := req.URL.Query()
+		if p.Name != nil {
+			values.Add("name", *p.Name)
+		}
+		if p.State != nil {
+			values.Add("state", *p.State)
+		}
+		if p.CacheNamespace != nil {
+			values.Add("cacheNamespace", *p.CacheNamespace)
+		}
+		if p.CacheScope != nil {
+			values.Add("cacheScope", *p.CacheScope)
+		}
+		if p.CreatedAfter != nil {
+			values.Add("createdAfter", *p.CreatedAfter)
+		}
+		if p.CreatedBefore != nil {
+			values.Add("createdBefore", *p.CreatedBefore)
+		}
+		values.Add("sort", p.Sort)
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSearchResponse returns a decoder for responses returned by the
+// taskList Search endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeSearchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SearchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("taskList", "Search", err)
+			}
+			err = ValidateSearchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("taskList", "Search", err)
+			}
+			res := NewSearchTaskListsResponseOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskList", "Search", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalTaskListStatusResponseResponseBodyToTasklistTaskListStatusResponse
+// builds a value of type *tasklist.TaskListStatusResponse from a value of type
+// *TaskListStatusResponseResponseBody.
+func unmarshalTaskListStatusResponseResponseBodyToTasklistTaskListStatusResponse(v *TaskListStatusResponseResponseBody) *tasklist.TaskListStatusResponse {
+	if v == nil {
+		return nil
+	}
+	res := &tasklist.TaskListStatusResponse{
+		ID:     *v.ID,
+		Status: *v.Status,
+		RunAt:  v.RunAt,
+	}
+	if v.Groups != nil {
+		res.Groups = make([]*tasklist.GroupStatus, len(v.Groups))
+		for i, val := range v.Groups {
+			res.Groups[i] = unmarshalGroupStatusResponseBodyToTasklistGroupStatus(val)
+		}
+	}
+
+	return res
+}
+
 // unmarshalGroupStatusResponseBodyToTasklistGroupStatus builds a value of type
 // *tasklist.GroupStatus from a value of type *GroupStatusResponseBody.
 func unmarshalGroupStatusResponseBodyToTasklistGroupStatus(v *GroupStatusResponseBody) *tasklist.GroupStatus {
@@ -253,3 +392,24 @@ func unmarshalTaskStatusResponseBodyToTasklistTaskStatus(v *TaskStatusResponseBo
 
 	return res
 }
+
+// unmarshalTaskListSummaryResponseBodyToTasklistTaskListSummary builds a value
+// of type *tasklist.TaskListSummary from a value of type
+// *TaskListSummaryResponseBody.
+func unmarshalTaskListSummaryResponseBodyToTasklistTaskListSummary(v *TaskListSummaryResponseBody) *tasklist.TaskListSummary {
+	res := &tasklist.TaskListSummary{
+		ID:              *v.ID,
+		Name:            *v.Name,
+		State:           *v.State,
+		CacheNamespace:  v.CacheNamespace,
+		CacheScope:      v.CacheScope,
+		CreatedAt:       *v.CreatedAt,
+		StartedAt:       v.StartedAt,
+		FinishedAt:      v.FinishedAt,
+		TemplateVersion: v.TemplateVersion,
+		RunAt:           v.RunAt,
+		Priority:        v.Priority,
+	}
+
+	return res
+}
@@ -20,3 +20,8 @@ func CreateTaskListPath(taskListName string) string {
 func TaskListStatusTaskListPath(taskListID string) string {
 	return fmt.Sprintf("/v1/taskListStatus/%v", taskListID)
 }
+
+// SearchTaskListPath returns the URL path to the taskList service Search HTTP endpoint.
+func SearchTaskListPath() string {
+	return "/v1/taskLists"
+}
@@ -17,6 +17,12 @@ import (
 type CreateResponseBody struct {
 	// Unique taskList identifier.
 	TaskListID *string `form:"taskListID,omitempty" json:"taskListID,omitempty" xml:"taskListID,omitempty"`
+	// State of the taskList at the end of the wait time. It is set only if the
+	// request waited for the taskList.
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Result of the taskList. It is set only if the taskList completed within the
+	// wait time.
+	Result *TaskListStatusResponseResponseBody `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
 }
 
 // TaskListStatusMultiStatusResponseBody is the type of the "taskList" service
@@ -28,6 +34,8 @@ type TaskListStatusMultiStatusResponseBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	// Array of GroupStatus
 	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
 }
 
 // TaskListStatusCreatedResponseBody is the type of the "taskList" service
@@ -39,6 +47,8 @@ type TaskListStatusCreatedResponseBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	// Array of GroupStatus
 	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
 }
 
 // TaskListStatusAcceptedResponseBody is the type of the "taskList" service
@@ -50,6 +60,8 @@ type TaskListStatusAcceptedResponseBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	// Array of GroupStatus
 	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
 }
 
 // TaskListStatusOKResponseBody is the type of the "taskList" service
@@ -61,6 +73,30 @@ type TaskListStatusOKResponseBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	// Array of GroupStatus
 	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
+}
+
+// SearchResponseBody is the type of the "taskList" service "Search" endpoint
+// HTTP response body.
+type SearchResponseBody struct {
+	// Array of taskLists.
+	TaskLists []*TaskListSummaryResponseBody `form:"taskLists,omitempty" json:"taskLists,omitempty" xml:"taskLists,omitempty"`
+	// Cursor for retrieving the next page of results, if there are more.
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
+}
+
+// TaskListStatusResponseResponseBody is used to define fields on response body
+// types.
+type TaskListStatusResponseResponseBody struct {
+	// Unique taskList identifier.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Current status of the taskList
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Array of GroupStatus
+	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
 }
 
 // GroupStatusResponseBody is used to define fields on response body types.
@@ -81,11 +117,41 @@ type TaskStatusResponseBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 }
 
+// TaskListSummaryResponseBody is used to define fields on response body types.
+type TaskListSummaryResponseBody struct {
+	// Unique taskList identifier.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// TaskList name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Current state of the taskList.
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// TaskList creation time.
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// TaskList execution start time.
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// TaskList completion time.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Version of the taskList template from which the taskList was created.
+	TemplateVersion *int `form:"templateVersion,omitempty" json:"templateVersion,omitempty" xml:"templateVersion,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
+	// Priority of the taskList.
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+}
+
 // NewCreateTaskListResultOK builds a "taskList" service "Create" endpoint
 // result from a HTTP "OK" response.
 func NewCreateTaskListResultOK(body *CreateResponseBody) *tasklist.CreateTaskListResult {
 	v := &tasklist.CreateTaskListResult{
 		TaskListID: *body.TaskListID,
+		State:      body.State,
+	}
+	if body.Result != nil {
+		v.Result = unmarshalTaskListStatusResponseResponseBodyToTasklistTaskListStatusResponse(body.Result)
 	}
 
 	return v
@@ -97,6 +163,7 @@ func NewTaskListStatusResponseMultiStatus(body *TaskListStatusMultiStatusRespons
 	v := &tasklist.TaskListStatusResponse{
 		ID:     *body.ID,
 		Status: *body.Status,
+		RunAt:  body.RunAt,
 	}
 	if body.Groups != nil {
 		v.Groups = make([]*tasklist.GroupStatus, len(body.Groups))
@@ -114,6 +181,7 @@ func NewTaskListStatusResponseCreated(body *TaskListStatusCreatedResponseBody) *
 	v := &tasklist.TaskListStatusResponse{
 		ID:     *body.ID,
 		Status: *body.Status,
+		RunAt:  body.RunAt,
 	}
 	if body.Groups != nil {
 		v.Groups = make([]*tasklist.GroupStatus, len(body.Groups))
@@ -131,6 +199,7 @@ func NewTaskListStatusResponseAccepted(body *TaskListStatusAcceptedResponseBody)
 	v := &tasklist.TaskListStatusResponse{
 		ID:     *body.ID,
 		Status: *body.Status,
+		RunAt:  body.RunAt,
 	}
 	if body.Groups != nil {
 		v.Groups = make([]*tasklist.GroupStatus, len(body.Groups))
@@ -148,6 +217,7 @@ func NewTaskListStatusResponseOK(body *TaskListStatusOKResponseBody) *tasklist.T
 	v := &tasklist.TaskListStatusResponse{
 		ID:     *body.ID,
 		Status: *body.Status,
+		RunAt:  body.RunAt,
 	}
 	if body.Groups != nil {
 		v.Groups = make([]*tasklist.GroupStatus, len(body.Groups))
@@ -159,11 +229,30 @@ func NewTaskListStatusResponseOK(body *TaskListStatusOKResponseBody) *tasklist.T
 	return v
 }
 
+// NewSearchTaskListsResponseOK builds a "taskList" service "Search" endpoint
+// result from a HTTP "OK" response.
+func NewSearchTaskListsResponseOK(body *SearchResponseBody) *tasklist.SearchTaskListsResponse {
+	v := &tasklist.SearchTaskListsResponse{
+		Next: body.Next,
+	}
+	v.TaskLists = make([]*tasklist.TaskListSummary, len(body.TaskLists))
+	for i, val := range body.TaskLists {
+		v.TaskLists[i] = unmarshalTaskListSummaryResponseBodyToTasklistTaskListSummary(val)
+	}
+
+	return v
+}
+
 // ValidateCreateResponseBody runs the validations defined on CreateResponseBody
 func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
 	if body.TaskListID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("taskListID", "body"))
 	}
+	if body.Result != nil {
+		if err2 := ValidateTaskListStatusResponseResponseBody(body.Result); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -176,6 +265,9 @@ func ValidateTaskListStatusMultiStatusResponseBody(body *TaskListStatusMultiStat
 	if body.Status == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
+	if body.RunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.runAt", *body.RunAt, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -188,6 +280,9 @@ func ValidateTaskListStatusCreatedResponseBody(body *TaskListStatusCreatedRespon
 	if body.Status == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
+	if body.RunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.runAt", *body.RunAt, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -200,6 +295,9 @@ func ValidateTaskListStatusAcceptedResponseBody(body *TaskListStatusAcceptedResp
 	if body.Status == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
+	if body.RunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.runAt", *body.RunAt, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -212,5 +310,68 @@ func ValidateTaskListStatusOKResponseBody(body *TaskListStatusOKResponseBody) (e
 	if body.Status == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
+	if body.RunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.runAt", *body.RunAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSearchResponseBody runs the validations defined on SearchResponseBody
+func ValidateSearchResponseBody(body *SearchResponseBody) (err error) {
+	if body.TaskLists == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("taskLists", "body"))
+	}
+	for _, e := range body.TaskLists {
+		if e != nil {
+			if err2 := ValidateTaskListSummaryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateTaskListStatusResponseResponseBody runs the validations defined on
+// TaskListStatusResponseResponseBody
+func ValidateTaskListStatusResponseResponseBody(body *TaskListStatusResponseResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.RunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.runAt", *body.RunAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateTaskListSummaryResponseBody runs the validations defined on
+// TaskListSummaryResponseBody
+func ValidateTaskListSummaryResponseBody(body *TaskListSummaryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	if body.RunAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.runAt", *body.RunAt, goa.FormatDateTime))
+	}
 	return
 }
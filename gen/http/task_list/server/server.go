@@ -21,6 +21,7 @@ type Server struct {
 	Mounts         []*MountPoint
 	Create         http.Handler
 	TaskListStatus http.Handler
+	Search         http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -52,9 +53,11 @@ func New(
 		Mounts: []*MountPoint{
 			{"Create", "POST", "/v1/taskList/{taskListName}"},
 			{"TaskListStatus", "GET", "/v1/taskListStatus/{taskListID}"},
+			{"Search", "GET", "/v1/taskLists"},
 		},
 		Create:         NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
 		TaskListStatus: NewTaskListStatusHandler(e.TaskListStatus, mux, decoder, encoder, errhandler, formatter),
+		Search:         NewSearchHandler(e.Search, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -65,6 +68,7 @@ func (s *Server) Service() string { return "taskList" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Create = m(s.Create)
 	s.TaskListStatus = m(s.TaskListStatus)
+	s.Search = m(s.Search)
 }
 
 // MethodNames returns the methods served.
@@ -74,6 +78,7 @@ func (s *Server) MethodNames() []string { return tasklist.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountCreateHandler(mux, h.Create)
 	MountTaskListStatusHandler(mux, h.TaskListStatus)
+	MountSearchHandler(mux, h.Search)
 }
 
 // Mount configures the mux to serve the taskList endpoints.
@@ -182,3 +187,54 @@ func NewTaskListStatusHandler(
 		}
 	})
 }
+
+// MountSearchHandler configures the mux to serve the "taskList" service
+// "Search" endpoint.
+func MountSearchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/taskLists", f)
+}
+
+// NewSearchHandler creates a HTTP handler which loads the HTTP request and
+// calls the "taskList" service "Search" endpoint.
+func NewSearchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSearchRequest(mux, decoder)
+		encodeResponse = EncodeSearchResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Search")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "taskList")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
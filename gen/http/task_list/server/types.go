@@ -16,6 +16,12 @@ import (
 type CreateResponseBody struct {
 	// Unique taskList identifier.
 	TaskListID string `form:"taskListID" json:"taskListID" xml:"taskListID"`
+	// State of the taskList at the end of the wait time. It is set only if the
+	// request waited for the taskList.
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Result of the taskList. It is set only if the taskList completed within the
+	// wait time.
+	Result *TaskListStatusResponseResponseBody `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
 }
 
 // TaskListStatusMultiStatusResponseBody is the type of the "taskList" service
@@ -27,6 +33,8 @@ type TaskListStatusMultiStatusResponseBody struct {
 	Status string `form:"status" json:"status" xml:"status"`
 	// Array of GroupStatus
 	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
 }
 
 // TaskListStatusCreatedResponseBody is the type of the "taskList" service
@@ -38,6 +46,8 @@ type TaskListStatusCreatedResponseBody struct {
 	Status string `form:"status" json:"status" xml:"status"`
 	// Array of GroupStatus
 	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
 }
 
 // TaskListStatusAcceptedResponseBody is the type of the "taskList" service
@@ -49,6 +59,8 @@ type TaskListStatusAcceptedResponseBody struct {
 	Status string `form:"status" json:"status" xml:"status"`
 	// Array of GroupStatus
 	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
 }
 
 // TaskListStatusOKResponseBody is the type of the "taskList" service
@@ -60,6 +72,30 @@ type TaskListStatusOKResponseBody struct {
 	Status string `form:"status" json:"status" xml:"status"`
 	// Array of GroupStatus
 	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
+}
+
+// SearchResponseBody is the type of the "taskList" service "Search" endpoint
+// HTTP response body.
+type SearchResponseBody struct {
+	// Array of taskLists.
+	TaskLists []*TaskListSummaryResponseBody `form:"taskLists" json:"taskLists" xml:"taskLists"`
+	// Cursor for retrieving the next page of results, if there are more.
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
+}
+
+// TaskListStatusResponseResponseBody is used to define fields on response body
+// types.
+type TaskListStatusResponseResponseBody struct {
+	// Unique taskList identifier.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Current status of the taskList
+	Status string `form:"status" json:"status" xml:"status"`
+	// Array of GroupStatus
+	Groups []*GroupStatusResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
 }
 
 // GroupStatusResponseBody is used to define fields on response body types.
@@ -80,11 +116,41 @@ type TaskStatusResponseBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 }
 
+// TaskListSummaryResponseBody is used to define fields on response body types.
+type TaskListSummaryResponseBody struct {
+	// Unique taskList identifier.
+	ID string `form:"id" json:"id" xml:"id"`
+	// TaskList name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Current state of the taskList.
+	State string `form:"state" json:"state" xml:"state"`
+	// Cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// TaskList creation time.
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// TaskList execution start time.
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// TaskList completion time.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Version of the taskList template from which the taskList was created.
+	TemplateVersion *int `form:"templateVersion,omitempty" json:"templateVersion,omitempty" xml:"templateVersion,omitempty"`
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string `form:"runAt,omitempty" json:"runAt,omitempty" xml:"runAt,omitempty"`
+	// Priority of the taskList.
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+}
+
 // NewCreateResponseBody builds the HTTP response body from the result of the
 // "Create" endpoint of the "taskList" service.
 func NewCreateResponseBody(res *tasklist.CreateTaskListResult) *CreateResponseBody {
 	body := &CreateResponseBody{
 		TaskListID: res.TaskListID,
+		State:      res.State,
+	}
+	if res.Result != nil {
+		body.Result = marshalTasklistTaskListStatusResponseToTaskListStatusResponseResponseBody(res.Result)
 	}
 	return body
 }
@@ -95,6 +161,7 @@ func NewTaskListStatusMultiStatusResponseBody(res *tasklist.TaskListStatusRespon
 	body := &TaskListStatusMultiStatusResponseBody{
 		ID:     res.ID,
 		Status: res.Status,
+		RunAt:  res.RunAt,
 	}
 	if res.Groups != nil {
 		body.Groups = make([]*GroupStatusResponseBody, len(res.Groups))
@@ -111,6 +178,7 @@ func NewTaskListStatusCreatedResponseBody(res *tasklist.TaskListStatusResponse)
 	body := &TaskListStatusCreatedResponseBody{
 		ID:     res.ID,
 		Status: res.Status,
+		RunAt:  res.RunAt,
 	}
 	if res.Groups != nil {
 		body.Groups = make([]*GroupStatusResponseBody, len(res.Groups))
@@ -127,6 +195,7 @@ func NewTaskListStatusAcceptedResponseBody(res *tasklist.TaskListStatusResponse)
 	body := &TaskListStatusAcceptedResponseBody{
 		ID:     res.ID,
 		Status: res.Status,
+		RunAt:  res.RunAt,
 	}
 	if res.Groups != nil {
 		body.Groups = make([]*GroupStatusResponseBody, len(res.Groups))
@@ -143,6 +212,7 @@ func NewTaskListStatusOKResponseBody(res *tasklist.TaskListStatusResponse) *Task
 	body := &TaskListStatusOKResponseBody{
 		ID:     res.ID,
 		Status: res.Status,
+		RunAt:  res.RunAt,
 	}
 	if res.Groups != nil {
 		body.Groups = make([]*GroupStatusResponseBody, len(res.Groups))
@@ -153,15 +223,39 @@ func NewTaskListStatusOKResponseBody(res *tasklist.TaskListStatusResponse) *Task
 	return body
 }
 
+// NewSearchResponseBody builds the HTTP response body from the result of the
+// "Search" endpoint of the "taskList" service.
+func NewSearchResponseBody(res *tasklist.SearchTaskListsResponse) *SearchResponseBody {
+	body := &SearchResponseBody{
+		Next: res.Next,
+	}
+	if res.TaskLists != nil {
+		body.TaskLists = make([]*TaskListSummaryResponseBody, len(res.TaskLists))
+		for i, val := range res.TaskLists {
+			body.TaskLists[i] = marshalTasklistTaskListSummaryToTaskListSummaryResponseBody(val)
+		}
+	} else {
+		body.TaskLists = []*TaskListSummaryResponseBody{}
+	}
+	return body
+}
+
 // NewCreateTaskListRequest builds a taskList service Create endpoint payload.
-func NewCreateTaskListRequest(body any, taskListName string, cacheNamespace *string, cacheScope *string) *tasklist.CreateTaskListRequest {
+func NewCreateTaskListRequest(body any, taskListName string, templateVersion *int, runAt *string, delay *string, priority *int, wait *string, callbackURL *string, cacheNamespace *string, cacheScope *string, idempotencyKey *string) *tasklist.CreateTaskListRequest {
 	v := body
 	res := &tasklist.CreateTaskListRequest{
 		Data: v,
 	}
 	res.TaskListName = taskListName
+	res.TemplateVersion = templateVersion
+	res.RunAt = runAt
+	res.Delay = delay
+	res.Priority = priority
+	res.Wait = wait
+	res.CallbackURL = callbackURL
 	res.CacheNamespace = cacheNamespace
 	res.CacheScope = cacheScope
+	res.IdempotencyKey = idempotencyKey
 
 	return res
 }
@@ -174,3 +268,19 @@ func NewTaskListStatusRequest(taskListID string) *tasklist.TaskListStatusRequest
 
 	return v
 }
+
+// NewSearchTaskListsRequest builds a taskList service Search endpoint payload.
+func NewSearchTaskListsRequest(name *string, state *string, cacheNamespace *string, cacheScope *string, createdAfter *string, createdBefore *string, sort string, limit int, cursor *string) *tasklist.SearchTaskListsRequest {
+	v := &tasklist.SearchTaskListsRequest{}
+	v.Name = name
+	v.State = state
+	v.CacheNamespace = cacheNamespace
+	v.CacheScope = cacheScope
+	v.CreatedAfter = createdAfter
+	v.CreatedBefore = createdBefore
+	v.Sort = sort
+	v.Limit = limit
+	v.Cursor = cursor
+
+	return v
+}
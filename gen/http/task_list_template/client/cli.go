@@ -0,0 +1,177 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskListTemplate HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	tasklisttemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_list_template"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreatePayload builds the payload for the taskListTemplate Create
+// endpoint from CLI flags.
+func BuildCreatePayload(taskListTemplateCreateBody string) (*tasklisttemplate.TaskListTemplate, error) {
+	var err error
+	var body CreateRequestBody
+	{
+		err = json.Unmarshal([]byte(taskListTemplateCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cacheNamespace\": \"login\",\n      \"cacheScope\": \"user\",\n      \"callbackURL\": \"https://example.com/callback\",\n      \"groups\": [\n         {\n            \"execution\": \"parallel\",\n            \"finalPolicy\": \"Sapiente autem suscipit.\",\n            \"tasks\": [\n               \"taskName1\",\n               \"taskName2\"\n            ],\n            \"timeout\": \"5m\"\n         },\n         {\n            \"execution\": \"parallel\",\n            \"finalPolicy\": \"Sapiente autem suscipit.\",\n            \"tasks\": [\n               \"taskName1\",\n               \"taskName2\"\n            ],\n            \"timeout\": \"5m\"\n         },\n         {\n            \"execution\": \"parallel\",\n            \"finalPolicy\": \"Sapiente autem suscipit.\",\n            \"tasks\": [\n               \"taskName1\",\n               \"taskName2\"\n            ],\n            \"timeout\": \"5m\"\n         }\n      ],\n      \"name\": \"example\",\n      \"priority\": 0,\n      \"timeout\": \"10m\",\n      \"version\": 1\n   }'")
+		}
+		if body.Groups == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("groups", "body"))
+		}
+		for _, e := range body.Groups {
+			if e != nil {
+				if err2 := ValidateGroupTemplateRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if body.Priority != nil {
+			if *body.Priority < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+			}
+		}
+		if body.Priority != nil {
+			if *body.Priority > 9 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &tasklisttemplate.TaskListTemplate{
+		Name:           body.Name,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	if body.Groups != nil {
+		v.Groups = make([]*tasklisttemplate.GroupTemplate, len(body.Groups))
+		for i, val := range body.Groups {
+			v.Groups[i] = marshalGroupTemplateRequestBodyToTasklisttemplateGroupTemplate(val)
+		}
+	} else {
+		v.Groups = []*tasklisttemplate.GroupTemplate{}
+	}
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the taskListTemplate Get endpoint
+// from CLI flags.
+func BuildGetPayload(taskListTemplateGetName string, taskListTemplateGetVersion string) (*tasklisttemplate.TaskListTemplateVersionRequest, error) {
+	var err error
+	var name string
+	{
+		name = taskListTemplateGetName
+	}
+	var version *int
+	{
+		if taskListTemplateGetVersion != "" {
+			var v int64
+			v, err = strconv.ParseInt(taskListTemplateGetVersion, 10, strconv.IntSize)
+			val := int(v)
+			version = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for version, must be INT")
+			}
+			if *version < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("version", *version, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &tasklisttemplate.TaskListTemplateVersionRequest{}
+	v.Name = name
+	v.Version = version
+
+	return v, nil
+}
+
+// BuildUpdatePayload builds the payload for the taskListTemplate Update
+// endpoint from CLI flags.
+func BuildUpdatePayload(taskListTemplateUpdateBody string, taskListTemplateUpdateName string) (*tasklisttemplate.TaskListTemplate, error) {
+	var err error
+	var body UpdateRequestBody
+	{
+		err = json.Unmarshal([]byte(taskListTemplateUpdateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cacheNamespace\": \"login\",\n      \"cacheScope\": \"user\",\n      \"callbackURL\": \"https://example.com/callback\",\n      \"groups\": [\n         {\n            \"execution\": \"parallel\",\n            \"finalPolicy\": \"Sapiente autem suscipit.\",\n            \"tasks\": [\n               \"taskName1\",\n               \"taskName2\"\n            ],\n            \"timeout\": \"5m\"\n         },\n         {\n            \"execution\": \"parallel\",\n            \"finalPolicy\": \"Sapiente autem suscipit.\",\n            \"tasks\": [\n               \"taskName1\",\n               \"taskName2\"\n            ],\n            \"timeout\": \"5m\"\n         },\n         {\n            \"execution\": \"parallel\",\n            \"finalPolicy\": \"Sapiente autem suscipit.\",\n            \"tasks\": [\n               \"taskName1\",\n               \"taskName2\"\n            ],\n            \"timeout\": \"5m\"\n         }\n      ],\n      \"priority\": 0,\n      \"timeout\": \"10m\",\n      \"version\": 1\n   }'")
+		}
+		if body.Groups == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("groups", "body"))
+		}
+		for _, e := range body.Groups {
+			if e != nil {
+				if err2 := ValidateGroupTemplateRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if body.Priority != nil {
+			if *body.Priority < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+			}
+		}
+		if body.Priority != nil {
+			if *body.Priority > 9 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name string
+	{
+		name = taskListTemplateUpdateName
+	}
+	v := &tasklisttemplate.TaskListTemplate{
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	if body.Groups != nil {
+		v.Groups = make([]*tasklisttemplate.GroupTemplate, len(body.Groups))
+		for i, val := range body.Groups {
+			v.Groups[i] = marshalGroupTemplateRequestBodyToTasklisttemplateGroupTemplate(val)
+		}
+	} else {
+		v.Groups = []*tasklisttemplate.GroupTemplate{}
+	}
+	v.Name = name
+
+	return v, nil
+}
+
+// BuildDeletePayload builds the payload for the taskListTemplate Delete
+// endpoint from CLI flags.
+func BuildDeletePayload(taskListTemplateDeleteName string) (*tasklisttemplate.TaskListTemplateRequest, error) {
+	var name string
+	{
+		name = taskListTemplateDeleteName
+	}
+	v := &tasklisttemplate.TaskListTemplateRequest{}
+	v.Name = name
+
+	return v, nil
+}
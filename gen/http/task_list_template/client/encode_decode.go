@@ -0,0 +1,441 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskListTemplate HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	tasklisttemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_list_template"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildCreateRequest instantiates a HTTP request object with method and path
+// set to call the "taskListTemplate" service "Create" endpoint
+func (c *Client) BuildCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateTaskListTemplatePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskListTemplate", "Create", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateRequest returns an encoder for requests sent to the
+// taskListTemplate Create server.
+func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*tasklisttemplate.TaskListTemplate)
+		if !ok {
+			return goahttp.ErrInvalidType("taskListTemplate", "Create", "*tasklisttemplate.TaskListTemplate", v)
+		}
+		body := NewCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("taskListTemplate", "Create", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateResponse returns a decoder for responses returned by the
+// taskListTemplate Create endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("taskListTemplate", "Create", err)
+			}
+			err = ValidateCreateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("taskListTemplate", "Create", err)
+			}
+			res := NewCreateTaskListTemplateCreated(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskListTemplate", "Create", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "taskListTemplate" service "Get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*tasklisttemplate.TaskListTemplateVersionRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("taskListTemplate", "Get", "*tasklisttemplate.TaskListTemplateVersionRequest", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetTaskListTemplatePath(name)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskListTemplate", "Get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRequest returns an encoder for requests sent to the
+// taskListTemplate Get server.
+func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*tasklisttemplate.TaskListTemplateVersionRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("taskListTemplate", "Get", "*tasklisttemplate.TaskListTemplateVersionRequest", v)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("version", fmt.Sprintf("%v", *p.Version))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the
+// taskListTemplate Get endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("taskListTemplate", "Get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("taskListTemplate", "Get", err)
+			}
+			res := NewGetTaskListTemplateOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskListTemplate", "Get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateRequest instantiates a HTTP request object with method and path
+// set to call the "taskListTemplate" service "Update" endpoint
+func (c *Client) BuildUpdateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*tasklisttemplate.TaskListTemplate)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("taskListTemplate", "Update", "*tasklisttemplate.TaskListTemplate", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateTaskListTemplatePath(name)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskListTemplate", "Update", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateRequest returns an encoder for requests sent to the
+// taskListTemplate Update server.
+func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*tasklisttemplate.TaskListTemplate)
+		if !ok {
+			return goahttp.ErrInvalidType("taskListTemplate", "Update", "*tasklisttemplate.TaskListTemplate", v)
+		}
+		body := NewUpdateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("taskListTemplate", "Update", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateResponse returns a decoder for responses returned by the
+// taskListTemplate Update endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("taskListTemplate", "Update", err)
+			}
+			err = ValidateUpdateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("taskListTemplate", "Update", err)
+			}
+			res := NewUpdateTaskListTemplateOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskListTemplate", "Update", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "taskListTemplate" service "Delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*tasklisttemplate.TaskListTemplateRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("taskListTemplate", "Delete", "*tasklisttemplate.TaskListTemplateRequest", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteTaskListTemplatePath(name)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskListTemplate", "Delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the
+// taskListTemplate Delete endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskListTemplate", "Delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "taskListTemplate" service "List" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTaskListTemplatePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskListTemplate", "List", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListResponse returns a decoder for responses returned by the
+// taskListTemplate List endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("taskListTemplate", "List", err)
+			}
+			err = ValidateListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("taskListTemplate", "List", err)
+			}
+			res := NewListTaskListTemplatesResponseOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskListTemplate", "List", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// marshalTasklisttemplateGroupTemplateToGroupTemplateRequestBody builds a
+// value of type *GroupTemplateRequestBody from a value of type
+// *tasklisttemplate.GroupTemplate.
+func marshalTasklisttemplateGroupTemplateToGroupTemplateRequestBody(v *tasklisttemplate.GroupTemplate) *GroupTemplateRequestBody {
+	res := &GroupTemplateRequestBody{
+		Execution:   v.Execution,
+		FinalPolicy: v.FinalPolicy,
+		Timeout:     v.Timeout,
+	}
+	if v.Tasks != nil {
+		res.Tasks = make([]string, len(v.Tasks))
+		for i, val := range v.Tasks {
+			res.Tasks[i] = val
+		}
+	} else {
+		res.Tasks = []string{}
+	}
+
+	return res
+}
+
+// marshalGroupTemplateRequestBodyToTasklisttemplateGroupTemplate builds a
+// value of type *tasklisttemplate.GroupTemplate from a value of type
+// *GroupTemplateRequestBody.
+func marshalGroupTemplateRequestBodyToTasklisttemplateGroupTemplate(v *GroupTemplateRequestBody) *tasklisttemplate.GroupTemplate {
+	res := &tasklisttemplate.GroupTemplate{
+		Execution:   v.Execution,
+		FinalPolicy: v.FinalPolicy,
+		Timeout:     v.Timeout,
+	}
+	if v.Tasks != nil {
+		res.Tasks = make([]string, len(v.Tasks))
+		for i, val := range v.Tasks {
+			res.Tasks[i] = val
+		}
+	} else {
+		res.Tasks = []string{}
+	}
+
+	return res
+}
+
+// unmarshalGroupTemplateResponseBodyToTasklisttemplateGroupTemplate builds a
+// value of type *tasklisttemplate.GroupTemplate from a value of type
+// *GroupTemplateResponseBody.
+func unmarshalGroupTemplateResponseBodyToTasklisttemplateGroupTemplate(v *GroupTemplateResponseBody) *tasklisttemplate.GroupTemplate {
+	res := &tasklisttemplate.GroupTemplate{
+		Execution:   *v.Execution,
+		FinalPolicy: v.FinalPolicy,
+		Timeout:     v.Timeout,
+	}
+	res.Tasks = make([]string, len(v.Tasks))
+	for i, val := range v.Tasks {
+		res.Tasks[i] = val
+	}
+
+	return res
+}
+
+// unmarshalTaskListTemplateResponseBodyToTasklisttemplateTaskListTemplate
+// builds a value of type *tasklisttemplate.TaskListTemplate from a value of
+// type *TaskListTemplateResponseBody.
+func unmarshalTaskListTemplateResponseBodyToTasklisttemplateTaskListTemplate(v *TaskListTemplateResponseBody) *tasklisttemplate.TaskListTemplate {
+	res := &tasklisttemplate.TaskListTemplate{
+		Name:           *v.Name,
+		CacheNamespace: v.CacheNamespace,
+		CacheScope:     v.CacheScope,
+		Version:        v.Version,
+		Priority:       v.Priority,
+		CallbackURL:    v.CallbackURL,
+		Timeout:        v.Timeout,
+	}
+	res.Groups = make([]*tasklisttemplate.GroupTemplate, len(v.Groups))
+	for i, val := range v.Groups {
+		res.Groups[i] = unmarshalGroupTemplateResponseBodyToTasklisttemplateGroupTemplate(val)
+	}
+
+	return res
+}
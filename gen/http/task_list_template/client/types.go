@@ -0,0 +1,449 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskListTemplate HTTP client types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	tasklisttemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_list_template"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "taskListTemplate" service "Create"
+// endpoint HTTP request body.
+type CreateRequestBody struct {
+	// TaskList template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateRequestBody `form:"groups" json:"groups" xml:"groups"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// UpdateRequestBody is the type of the "taskListTemplate" service "Update"
+// endpoint HTTP request body.
+type UpdateRequestBody struct {
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateRequestBody `form:"groups" json:"groups" xml:"groups"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// CreateResponseBody is the type of the "taskListTemplate" service "Create"
+// endpoint HTTP response body.
+type CreateResponseBody struct {
+	// TaskList template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// GetResponseBody is the type of the "taskListTemplate" service "Get" endpoint
+// HTTP response body.
+type GetResponseBody struct {
+	// TaskList template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// UpdateResponseBody is the type of the "taskListTemplate" service "Update"
+// endpoint HTTP response body.
+type UpdateResponseBody struct {
+	// TaskList template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListResponseBody is the type of the "taskListTemplate" service "List"
+// endpoint HTTP response body.
+type ListResponseBody struct {
+	// Array of taskList templates.
+	Templates []*TaskListTemplateResponseBody `form:"templates,omitempty" json:"templates,omitempty" xml:"templates,omitempty"`
+}
+
+// GroupTemplateRequestBody is used to define fields on request body types.
+type GroupTemplateRequestBody struct {
+	// Execution mode of the tasks within the group.
+	Execution string `form:"execution" json:"execution" xml:"execution"`
+	// Policy to be executed on the group result.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Names of task templates executed in the group.
+	Tasks []string `form:"tasks" json:"tasks" xml:"tasks"`
+	// Deadline of the group execution, e.g. 5m. Tasks which are still running when
+	// it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// GroupTemplateResponseBody is used to define fields on response body types.
+type GroupTemplateResponseBody struct {
+	// Execution mode of the tasks within the group.
+	Execution *string `form:"execution,omitempty" json:"execution,omitempty" xml:"execution,omitempty"`
+	// Policy to be executed on the group result.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Names of task templates executed in the group.
+	Tasks []string `form:"tasks,omitempty" json:"tasks,omitempty" xml:"tasks,omitempty"`
+	// Deadline of the group execution, e.g. 5m. Tasks which are still running when
+	// it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// TaskListTemplateResponseBody is used to define fields on response body types.
+type TaskListTemplateResponseBody struct {
+	// TaskList template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateResponseBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "Create" endpoint of the "taskListTemplate" service.
+func NewCreateRequestBody(p *tasklisttemplate.TaskListTemplate) *CreateRequestBody {
+	body := &CreateRequestBody{
+		Name:           p.Name,
+		CacheNamespace: p.CacheNamespace,
+		CacheScope:     p.CacheScope,
+		Version:        p.Version,
+		Priority:       p.Priority,
+		CallbackURL:    p.CallbackURL,
+		Timeout:        p.Timeout,
+	}
+	if p.Groups != nil {
+		body.Groups = make([]*GroupTemplateRequestBody, len(p.Groups))
+		for i, val := range p.Groups {
+			body.Groups[i] = marshalTasklisttemplateGroupTemplateToGroupTemplateRequestBody(val)
+		}
+	} else {
+		body.Groups = []*GroupTemplateRequestBody{}
+	}
+	return body
+}
+
+// NewUpdateRequestBody builds the HTTP request body from the payload of the
+// "Update" endpoint of the "taskListTemplate" service.
+func NewUpdateRequestBody(p *tasklisttemplate.TaskListTemplate) *UpdateRequestBody {
+	body := &UpdateRequestBody{
+		CacheNamespace: p.CacheNamespace,
+		CacheScope:     p.CacheScope,
+		Version:        p.Version,
+		Priority:       p.Priority,
+		CallbackURL:    p.CallbackURL,
+		Timeout:        p.Timeout,
+	}
+	if p.Groups != nil {
+		body.Groups = make([]*GroupTemplateRequestBody, len(p.Groups))
+		for i, val := range p.Groups {
+			body.Groups[i] = marshalTasklisttemplateGroupTemplateToGroupTemplateRequestBody(val)
+		}
+	} else {
+		body.Groups = []*GroupTemplateRequestBody{}
+	}
+	return body
+}
+
+// NewCreateTaskListTemplateCreated builds a "taskListTemplate" service
+// "Create" endpoint result from a HTTP "Created" response.
+func NewCreateTaskListTemplateCreated(body *CreateResponseBody) *tasklisttemplate.TaskListTemplate {
+	v := &tasklisttemplate.TaskListTemplate{
+		Name:           *body.Name,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	v.Groups = make([]*tasklisttemplate.GroupTemplate, len(body.Groups))
+	for i, val := range body.Groups {
+		v.Groups[i] = unmarshalGroupTemplateResponseBodyToTasklisttemplateGroupTemplate(val)
+	}
+
+	return v
+}
+
+// NewGetTaskListTemplateOK builds a "taskListTemplate" service "Get" endpoint
+// result from a HTTP "OK" response.
+func NewGetTaskListTemplateOK(body *GetResponseBody) *tasklisttemplate.TaskListTemplate {
+	v := &tasklisttemplate.TaskListTemplate{
+		Name:           *body.Name,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	v.Groups = make([]*tasklisttemplate.GroupTemplate, len(body.Groups))
+	for i, val := range body.Groups {
+		v.Groups[i] = unmarshalGroupTemplateResponseBodyToTasklisttemplateGroupTemplate(val)
+	}
+
+	return v
+}
+
+// NewUpdateTaskListTemplateOK builds a "taskListTemplate" service "Update"
+// endpoint result from a HTTP "OK" response.
+func NewUpdateTaskListTemplateOK(body *UpdateResponseBody) *tasklisttemplate.TaskListTemplate {
+	v := &tasklisttemplate.TaskListTemplate{
+		Name:           *body.Name,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	v.Groups = make([]*tasklisttemplate.GroupTemplate, len(body.Groups))
+	for i, val := range body.Groups {
+		v.Groups[i] = unmarshalGroupTemplateResponseBodyToTasklisttemplateGroupTemplate(val)
+	}
+
+	return v
+}
+
+// NewListTaskListTemplatesResponseOK builds a "taskListTemplate" service
+// "List" endpoint result from a HTTP "OK" response.
+func NewListTaskListTemplatesResponseOK(body *ListResponseBody) *tasklisttemplate.TaskListTemplatesResponse {
+	v := &tasklisttemplate.TaskListTemplatesResponse{}
+	v.Templates = make([]*tasklisttemplate.TaskListTemplate, len(body.Templates))
+	for i, val := range body.Templates {
+		v.Templates[i] = unmarshalTaskListTemplateResponseBodyToTasklisttemplateTaskListTemplate(val)
+	}
+
+	return v
+}
+
+// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
+func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Groups == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("groups", "body"))
+	}
+	for _, e := range body.Groups {
+		if e != nil {
+			if err2 := ValidateGroupTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	return
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Groups == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("groups", "body"))
+	}
+	for _, e := range body.Groups {
+		if e != nil {
+			if err2 := ValidateGroupTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	return
+}
+
+// ValidateUpdateResponseBody runs the validations defined on UpdateResponseBody
+func ValidateUpdateResponseBody(body *UpdateResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Groups == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("groups", "body"))
+	}
+	for _, e := range body.Groups {
+		if e != nil {
+			if err2 := ValidateGroupTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	return
+}
+
+// ValidateListResponseBody runs the validations defined on ListResponseBody
+func ValidateListResponseBody(body *ListResponseBody) (err error) {
+	if body.Templates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("templates", "body"))
+	}
+	for _, e := range body.Templates {
+		if e != nil {
+			if err2 := ValidateTaskListTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGroupTemplateRequestBody runs the validations defined on
+// GroupTemplateRequestBody
+func ValidateGroupTemplateRequestBody(body *GroupTemplateRequestBody) (err error) {
+	if body.Tasks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tasks", "body"))
+	}
+	if !(body.Execution == "sequential" || body.Execution == "parallel") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.execution", body.Execution, []any{"sequential", "parallel"}))
+	}
+	return
+}
+
+// ValidateGroupTemplateResponseBody runs the validations defined on
+// GroupTemplateResponseBody
+func ValidateGroupTemplateResponseBody(body *GroupTemplateResponseBody) (err error) {
+	if body.Execution == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("execution", "body"))
+	}
+	if body.Tasks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tasks", "body"))
+	}
+	if body.Execution != nil {
+		if !(*body.Execution == "sequential" || *body.Execution == "parallel") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.execution", *body.Execution, []any{"sequential", "parallel"}))
+		}
+	}
+	return
+}
+
+// ValidateTaskListTemplateResponseBody runs the validations defined on
+// TaskListTemplateResponseBody
+func ValidateTaskListTemplateResponseBody(body *TaskListTemplateResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Groups == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("groups", "body"))
+	}
+	for _, e := range body.Groups {
+		if e != nil {
+			if err2 := ValidateGroupTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	return
+}
@@ -0,0 +1,258 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskListTemplate HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	tasklisttemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_list_template"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeCreateResponse returns an encoder for responses returned by the
+// taskListTemplate Create endpoint.
+func EncodeCreateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*tasklisttemplate.TaskListTemplate)
+		enc := encoder(ctx, w)
+		body := NewCreateResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateRequest returns a decoder for requests sent to the
+// taskListTemplate Create endpoint.
+func DecodeCreateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateTaskListTemplate(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetResponse returns an encoder for responses returned by the
+// taskListTemplate Get endpoint.
+func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*tasklisttemplate.TaskListTemplate)
+		enc := encoder(ctx, w)
+		body := NewGetResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetRequest returns a decoder for requests sent to the taskListTemplate
+// Get endpoint.
+func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			name    string
+			version *int
+			err     error
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		{
+			versionRaw := r.URL.Query().Get("version")
+			if versionRaw != "" {
+				v, err2 := strconv.ParseInt(versionRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("version", versionRaw, "integer"))
+				}
+				pv := int(v)
+				version = &pv
+			}
+		}
+		if version != nil {
+			if *version < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("version", *version, 1, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetTaskListTemplateVersionRequest(name, version)
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateResponse returns an encoder for responses returned by the
+// taskListTemplate Update endpoint.
+func EncodeUpdateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*tasklisttemplate.TaskListTemplate)
+		enc := encoder(ctx, w)
+		body := NewUpdateResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateRequest returns a decoder for requests sent to the
+// taskListTemplate Update endpoint.
+func DecodeUpdateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			name string
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		payload := NewUpdateTaskListTemplate(&body, name)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteResponse returns an encoder for responses returned by the
+// taskListTemplate Delete endpoint.
+func EncodeDeleteResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDeleteRequest returns a decoder for requests sent to the
+// taskListTemplate Delete endpoint.
+func DecodeDeleteRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			name string
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		payload := NewDeleteTaskListTemplateRequest(name)
+
+		return payload, nil
+	}
+}
+
+// EncodeListResponse returns an encoder for responses returned by the
+// taskListTemplate List endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*tasklisttemplate.TaskListTemplatesResponse)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// unmarshalGroupTemplateRequestBodyToTasklisttemplateGroupTemplate builds a
+// value of type *tasklisttemplate.GroupTemplate from a value of type
+// *GroupTemplateRequestBody.
+func unmarshalGroupTemplateRequestBodyToTasklisttemplateGroupTemplate(v *GroupTemplateRequestBody) *tasklisttemplate.GroupTemplate {
+	res := &tasklisttemplate.GroupTemplate{
+		Execution:   *v.Execution,
+		FinalPolicy: v.FinalPolicy,
+		Timeout:     v.Timeout,
+	}
+	res.Tasks = make([]string, len(v.Tasks))
+	for i, val := range v.Tasks {
+		res.Tasks[i] = val
+	}
+
+	return res
+}
+
+// marshalTasklisttemplateGroupTemplateToGroupTemplateResponseBody builds a
+// value of type *GroupTemplateResponseBody from a value of type
+// *tasklisttemplate.GroupTemplate.
+func marshalTasklisttemplateGroupTemplateToGroupTemplateResponseBody(v *tasklisttemplate.GroupTemplate) *GroupTemplateResponseBody {
+	res := &GroupTemplateResponseBody{
+		Execution:   v.Execution,
+		FinalPolicy: v.FinalPolicy,
+		Timeout:     v.Timeout,
+	}
+	if v.Tasks != nil {
+		res.Tasks = make([]string, len(v.Tasks))
+		for i, val := range v.Tasks {
+			res.Tasks[i] = val
+		}
+	} else {
+		res.Tasks = []string{}
+	}
+
+	return res
+}
+
+// marshalTasklisttemplateTaskListTemplateToTaskListTemplateResponseBody builds
+// a value of type *TaskListTemplateResponseBody from a value of type
+// *tasklisttemplate.TaskListTemplate.
+func marshalTasklisttemplateTaskListTemplateToTaskListTemplateResponseBody(v *tasklisttemplate.TaskListTemplate) *TaskListTemplateResponseBody {
+	res := &TaskListTemplateResponseBody{
+		Name:           v.Name,
+		CacheNamespace: v.CacheNamespace,
+		CacheScope:     v.CacheScope,
+		Version:        v.Version,
+		Priority:       v.Priority,
+		CallbackURL:    v.CallbackURL,
+		Timeout:        v.Timeout,
+	}
+	if v.Groups != nil {
+		res.Groups = make([]*GroupTemplateResponseBody, len(v.Groups))
+		for i, val := range v.Groups {
+			res.Groups[i] = marshalTasklisttemplateGroupTemplateToGroupTemplateResponseBody(val)
+		}
+	} else {
+		res.Groups = []*GroupTemplateResponseBody{}
+	}
+
+	return res
+}
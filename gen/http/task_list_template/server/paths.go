@@ -0,0 +1,37 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the taskListTemplate service.
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// CreateTaskListTemplatePath returns the URL path to the taskListTemplate service Create HTTP endpoint.
+func CreateTaskListTemplatePath() string {
+	return "/v1/taskListTemplates"
+}
+
+// GetTaskListTemplatePath returns the URL path to the taskListTemplate service Get HTTP endpoint.
+func GetTaskListTemplatePath(name string) string {
+	return fmt.Sprintf("/v1/taskListTemplates/%v", name)
+}
+
+// UpdateTaskListTemplatePath returns the URL path to the taskListTemplate service Update HTTP endpoint.
+func UpdateTaskListTemplatePath(name string) string {
+	return fmt.Sprintf("/v1/taskListTemplates/%v", name)
+}
+
+// DeleteTaskListTemplatePath returns the URL path to the taskListTemplate service Delete HTTP endpoint.
+func DeleteTaskListTemplatePath(name string) string {
+	return fmt.Sprintf("/v1/taskListTemplates/%v", name)
+}
+
+// ListTaskListTemplatePath returns the URL path to the taskListTemplate service List HTTP endpoint.
+func ListTaskListTemplatePath() string {
+	return "/v1/taskListTemplates"
+}
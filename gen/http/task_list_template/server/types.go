@@ -0,0 +1,388 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskListTemplate HTTP server types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	tasklisttemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_list_template"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "taskListTemplate" service "Create"
+// endpoint HTTP request body.
+type CreateRequestBody struct {
+	// TaskList template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateRequestBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// UpdateRequestBody is the type of the "taskListTemplate" service "Update"
+// endpoint HTTP request body.
+type UpdateRequestBody struct {
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateRequestBody `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// CreateResponseBody is the type of the "taskListTemplate" service "Create"
+// endpoint HTTP response body.
+type CreateResponseBody struct {
+	// TaskList template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateResponseBody `form:"groups" json:"groups" xml:"groups"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// GetResponseBody is the type of the "taskListTemplate" service "Get" endpoint
+// HTTP response body.
+type GetResponseBody struct {
+	// TaskList template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateResponseBody `form:"groups" json:"groups" xml:"groups"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// UpdateResponseBody is the type of the "taskListTemplate" service "Update"
+// endpoint HTTP response body.
+type UpdateResponseBody struct {
+	// TaskList template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateResponseBody `form:"groups" json:"groups" xml:"groups"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListResponseBody is the type of the "taskListTemplate" service "List"
+// endpoint HTTP response body.
+type ListResponseBody struct {
+	// Array of taskList templates.
+	Templates []*TaskListTemplateResponseBody `form:"templates" json:"templates" xml:"templates"`
+}
+
+// GroupTemplateResponseBody is used to define fields on response body types.
+type GroupTemplateResponseBody struct {
+	// Execution mode of the tasks within the group.
+	Execution string `form:"execution" json:"execution" xml:"execution"`
+	// Policy to be executed on the group result.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Names of task templates executed in the group.
+	Tasks []string `form:"tasks" json:"tasks" xml:"tasks"`
+	// Deadline of the group execution, e.g. 5m. Tasks which are still running when
+	// it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// TaskListTemplateResponseBody is used to define fields on response body types.
+type TaskListTemplateResponseBody struct {
+	// TaskList template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplateResponseBody `form:"groups" json:"groups" xml:"groups"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// GroupTemplateRequestBody is used to define fields on request body types.
+type GroupTemplateRequestBody struct {
+	// Execution mode of the tasks within the group.
+	Execution *string `form:"execution,omitempty" json:"execution,omitempty" xml:"execution,omitempty"`
+	// Policy to be executed on the group result.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Names of task templates executed in the group.
+	Tasks []string `form:"tasks,omitempty" json:"tasks,omitempty" xml:"tasks,omitempty"`
+	// Deadline of the group execution, e.g. 5m. Tasks which are still running when
+	// it's exceeded are failed.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "Create" endpoint of the "taskListTemplate" service.
+func NewCreateResponseBody(res *tasklisttemplate.TaskListTemplate) *CreateResponseBody {
+	body := &CreateResponseBody{
+		Name:           res.Name,
+		CacheNamespace: res.CacheNamespace,
+		CacheScope:     res.CacheScope,
+		Version:        res.Version,
+		Priority:       res.Priority,
+		CallbackURL:    res.CallbackURL,
+		Timeout:        res.Timeout,
+	}
+	if res.Groups != nil {
+		body.Groups = make([]*GroupTemplateResponseBody, len(res.Groups))
+		for i, val := range res.Groups {
+			body.Groups[i] = marshalTasklisttemplateGroupTemplateToGroupTemplateResponseBody(val)
+		}
+	} else {
+		body.Groups = []*GroupTemplateResponseBody{}
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "Get" endpoint of the "taskListTemplate" service.
+func NewGetResponseBody(res *tasklisttemplate.TaskListTemplate) *GetResponseBody {
+	body := &GetResponseBody{
+		Name:           res.Name,
+		CacheNamespace: res.CacheNamespace,
+		CacheScope:     res.CacheScope,
+		Version:        res.Version,
+		Priority:       res.Priority,
+		CallbackURL:    res.CallbackURL,
+		Timeout:        res.Timeout,
+	}
+	if res.Groups != nil {
+		body.Groups = make([]*GroupTemplateResponseBody, len(res.Groups))
+		for i, val := range res.Groups {
+			body.Groups[i] = marshalTasklisttemplateGroupTemplateToGroupTemplateResponseBody(val)
+		}
+	} else {
+		body.Groups = []*GroupTemplateResponseBody{}
+	}
+	return body
+}
+
+// NewUpdateResponseBody builds the HTTP response body from the result of the
+// "Update" endpoint of the "taskListTemplate" service.
+func NewUpdateResponseBody(res *tasklisttemplate.TaskListTemplate) *UpdateResponseBody {
+	body := &UpdateResponseBody{
+		Name:           res.Name,
+		CacheNamespace: res.CacheNamespace,
+		CacheScope:     res.CacheScope,
+		Version:        res.Version,
+		Priority:       res.Priority,
+		CallbackURL:    res.CallbackURL,
+		Timeout:        res.Timeout,
+	}
+	if res.Groups != nil {
+		body.Groups = make([]*GroupTemplateResponseBody, len(res.Groups))
+		for i, val := range res.Groups {
+			body.Groups[i] = marshalTasklisttemplateGroupTemplateToGroupTemplateResponseBody(val)
+		}
+	} else {
+		body.Groups = []*GroupTemplateResponseBody{}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "List" endpoint of the "taskListTemplate" service.
+func NewListResponseBody(res *tasklisttemplate.TaskListTemplatesResponse) *ListResponseBody {
+	body := &ListResponseBody{}
+	if res.Templates != nil {
+		body.Templates = make([]*TaskListTemplateResponseBody, len(res.Templates))
+		for i, val := range res.Templates {
+			body.Templates[i] = marshalTasklisttemplateTaskListTemplateToTaskListTemplateResponseBody(val)
+		}
+	} else {
+		body.Templates = []*TaskListTemplateResponseBody{}
+	}
+	return body
+}
+
+// NewCreateTaskListTemplate builds a taskListTemplate service Create endpoint
+// payload.
+func NewCreateTaskListTemplate(body *CreateRequestBody) *tasklisttemplate.TaskListTemplate {
+	v := &tasklisttemplate.TaskListTemplate{
+		Name:           *body.Name,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	v.Groups = make([]*tasklisttemplate.GroupTemplate, len(body.Groups))
+	for i, val := range body.Groups {
+		v.Groups[i] = unmarshalGroupTemplateRequestBodyToTasklisttemplateGroupTemplate(val)
+	}
+
+	return v
+}
+
+// NewGetTaskListTemplateVersionRequest builds a taskListTemplate service Get
+// endpoint payload.
+func NewGetTaskListTemplateVersionRequest(name string, version *int) *tasklisttemplate.TaskListTemplateVersionRequest {
+	v := &tasklisttemplate.TaskListTemplateVersionRequest{}
+	v.Name = name
+	v.Version = version
+
+	return v
+}
+
+// NewUpdateTaskListTemplate builds a taskListTemplate service Update endpoint
+// payload.
+func NewUpdateTaskListTemplate(body *UpdateRequestBody, name string) *tasklisttemplate.TaskListTemplate {
+	v := &tasklisttemplate.TaskListTemplate{
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	v.Groups = make([]*tasklisttemplate.GroupTemplate, len(body.Groups))
+	for i, val := range body.Groups {
+		v.Groups[i] = unmarshalGroupTemplateRequestBodyToTasklisttemplateGroupTemplate(val)
+	}
+	v.Name = name
+
+	return v
+}
+
+// NewDeleteTaskListTemplateRequest builds a taskListTemplate service Delete
+// endpoint payload.
+func NewDeleteTaskListTemplateRequest(name string) *tasklisttemplate.TaskListTemplateRequest {
+	v := &tasklisttemplate.TaskListTemplateRequest{}
+	v.Name = name
+
+	return v
+}
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Groups == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("groups", "body"))
+	}
+	for _, e := range body.Groups {
+		if e != nil {
+			if err2 := ValidateGroupTemplateRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	return
+}
+
+// ValidateUpdateRequestBody runs the validations defined on UpdateRequestBody
+func ValidateUpdateRequestBody(body *UpdateRequestBody) (err error) {
+	if body.Groups == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("groups", "body"))
+	}
+	for _, e := range body.Groups {
+		if e != nil {
+			if err2 := ValidateGroupTemplateRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	return
+}
+
+// ValidateGroupTemplateRequestBody runs the validations defined on
+// GroupTemplateRequestBody
+func ValidateGroupTemplateRequestBody(body *GroupTemplateRequestBody) (err error) {
+	if body.Execution == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("execution", "body"))
+	}
+	if body.Tasks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tasks", "body"))
+	}
+	if body.Execution != nil {
+		if !(*body.Execution == "sequential" || *body.Execution == "parallel") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.execution", *body.Execution, []any{"sequential", "parallel"}))
+		}
+	}
+	return
+}
@@ -0,0 +1,179 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskTemplate HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	tasktemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_template"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreatePayload builds the payload for the taskTemplate Create endpoint
+// from CLI flags.
+func BuildCreatePayload(taskTemplateCreateBody string) (*tasktemplate.TaskTemplate, error) {
+	var err error
+	var body CreateRequestBody
+	{
+		err = json.Unmarshal([]byte(taskTemplateCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"acceptedStatusCodes\": [\n         \"2xx\",\n         \"304\"\n      ],\n      \"cacheNamespace\": \"login\",\n      \"cacheScope\": \"user\",\n      \"callbackURL\": \"https://example.com/callback\",\n      \"finalPolicy\": \"Modi architecto dolor nemo.\",\n      \"method\": \"GET\",\n      \"name\": \"exampleTask\",\n      \"priority\": 0,\n      \"requestPolicy\": \"policies/example/example/1.0\",\n      \"responsePolicy\": \"Illum iusto voluptatem et accusamus ex itaque.\",\n      \"retryPolicy\": {\n         \"baseDelay\": \"1s\",\n         \"maxAttempts\": 5,\n         \"maxDelay\": \"5m\",\n         \"retryOn\": [\n            \"timeout\",\n            \"timeout\",\n            \"status\",\n            \"cache\"\n         ]\n      },\n      \"timeout\": \"30s\",\n      \"url\": \"https://jsonplaceholder.typicode.com/todos/1\",\n      \"version\": 1\n   }'")
+		}
+		if body.Priority != nil {
+			if *body.Priority < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+			}
+		}
+		if body.Priority != nil {
+			if *body.Priority > 9 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+			}
+		}
+		if body.RetryPolicy != nil {
+			if err2 := ValidateRetryPolicyRequestBody(body.RetryPolicy); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &tasktemplate.TaskTemplate{
+		Name:           body.Name,
+		URL:            body.URL,
+		Method:         body.Method,
+		RequestPolicy:  body.RequestPolicy,
+		ResponsePolicy: body.ResponsePolicy,
+		FinalPolicy:    body.FinalPolicy,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	if body.RetryPolicy != nil {
+		v.RetryPolicy = marshalRetryPolicyRequestBodyToTasktemplateRetryPolicy(body.RetryPolicy)
+	}
+	if body.AcceptedStatusCodes != nil {
+		v.AcceptedStatusCodes = make([]string, len(body.AcceptedStatusCodes))
+		for i, val := range body.AcceptedStatusCodes {
+			v.AcceptedStatusCodes[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the taskTemplate Get endpoint from
+// CLI flags.
+func BuildGetPayload(taskTemplateGetName string, taskTemplateGetVersion string) (*tasktemplate.TaskTemplateVersionRequest, error) {
+	var err error
+	var name string
+	{
+		name = taskTemplateGetName
+	}
+	var version *int
+	{
+		if taskTemplateGetVersion != "" {
+			var v int64
+			v, err = strconv.ParseInt(taskTemplateGetVersion, 10, strconv.IntSize)
+			val := int(v)
+			version = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for version, must be INT")
+			}
+			if *version < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("version", *version, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &tasktemplate.TaskTemplateVersionRequest{}
+	v.Name = name
+	v.Version = version
+
+	return v, nil
+}
+
+// BuildUpdatePayload builds the payload for the taskTemplate Update endpoint
+// from CLI flags.
+func BuildUpdatePayload(taskTemplateUpdateBody string, taskTemplateUpdateName string) (*tasktemplate.TaskTemplate, error) {
+	var err error
+	var body UpdateRequestBody
+	{
+		err = json.Unmarshal([]byte(taskTemplateUpdateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"acceptedStatusCodes\": [\n         \"2xx\",\n         \"304\"\n      ],\n      \"cacheNamespace\": \"login\",\n      \"cacheScope\": \"user\",\n      \"callbackURL\": \"https://example.com/callback\",\n      \"finalPolicy\": \"Incidunt ad et velit sit sit voluptatem.\",\n      \"method\": \"GET\",\n      \"priority\": 0,\n      \"requestPolicy\": \"policies/example/example/1.0\",\n      \"responsePolicy\": \"Reprehenderit quaerat odio quia et et et.\",\n      \"retryPolicy\": {\n         \"baseDelay\": \"1s\",\n         \"maxAttempts\": 5,\n         \"maxDelay\": \"5m\",\n         \"retryOn\": [\n            \"timeout\",\n            \"timeout\",\n            \"status\",\n            \"cache\"\n         ]\n      },\n      \"timeout\": \"30s\",\n      \"url\": \"https://jsonplaceholder.typicode.com/todos/1\",\n      \"version\": 1\n   }'")
+		}
+		if body.Priority != nil {
+			if *body.Priority < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+			}
+		}
+		if body.Priority != nil {
+			if *body.Priority > 9 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+			}
+		}
+		if body.RetryPolicy != nil {
+			if err2 := ValidateRetryPolicyRequestBody(body.RetryPolicy); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name string
+	{
+		name = taskTemplateUpdateName
+	}
+	v := &tasktemplate.TaskTemplate{
+		URL:            body.URL,
+		Method:         body.Method,
+		RequestPolicy:  body.RequestPolicy,
+		ResponsePolicy: body.ResponsePolicy,
+		FinalPolicy:    body.FinalPolicy,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	if body.RetryPolicy != nil {
+		v.RetryPolicy = marshalRetryPolicyRequestBodyToTasktemplateRetryPolicy(body.RetryPolicy)
+	}
+	if body.AcceptedStatusCodes != nil {
+		v.AcceptedStatusCodes = make([]string, len(body.AcceptedStatusCodes))
+		for i, val := range body.AcceptedStatusCodes {
+			v.AcceptedStatusCodes[i] = val
+		}
+	}
+	v.Name = name
+
+	return v, nil
+}
+
+// BuildDeletePayload builds the payload for the taskTemplate Delete endpoint
+// from CLI flags.
+func BuildDeletePayload(taskTemplateDeleteName string) (*tasktemplate.TaskTemplateRequest, error) {
+	var name string
+	{
+		name = taskTemplateDeleteName
+	}
+	v := &tasktemplate.TaskTemplateRequest{}
+	v.Name = name
+
+	return v, nil
+}
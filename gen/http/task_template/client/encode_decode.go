@@ -0,0 +1,455 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskTemplate HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	tasktemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_template"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildCreateRequest instantiates a HTTP request object with method and path
+// set to call the "taskTemplate" service "Create" endpoint
+func (c *Client) BuildCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateTaskTemplatePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskTemplate", "Create", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateRequest returns an encoder for requests sent to the taskTemplate
+// Create server.
+func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*tasktemplate.TaskTemplate)
+		if !ok {
+			return goahttp.ErrInvalidType("taskTemplate", "Create", "*tasktemplate.TaskTemplate", v)
+		}
+		body := NewCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("taskTemplate", "Create", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateResponse returns a decoder for responses returned by the
+// taskTemplate Create endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("taskTemplate", "Create", err)
+			}
+			err = ValidateCreateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("taskTemplate", "Create", err)
+			}
+			res := NewCreateTaskTemplateCreated(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskTemplate", "Create", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "taskTemplate" service "Get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*tasktemplate.TaskTemplateVersionRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("taskTemplate", "Get", "*tasktemplate.TaskTemplateVersionRequest", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetTaskTemplatePath(name)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskTemplate", "Get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRequest returns an encoder for requests sent to the taskTemplate
+// Get server.
+func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*tasktemplate.TaskTemplateVersionRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("taskTemplate", "Get", "*tasktemplate.TaskTemplateVersionRequest", v)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("version", fmt.Sprintf("%v", *p.Version))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the
+// taskTemplate Get endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("taskTemplate", "Get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("taskTemplate", "Get", err)
+			}
+			res := NewGetTaskTemplateOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskTemplate", "Get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateRequest instantiates a HTTP request object with method and path
+// set to call the "taskTemplate" service "Update" endpoint
+func (c *Client) BuildUpdateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*tasktemplate.TaskTemplate)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("taskTemplate", "Update", "*tasktemplate.TaskTemplate", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateTaskTemplatePath(name)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskTemplate", "Update", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateRequest returns an encoder for requests sent to the taskTemplate
+// Update server.
+func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*tasktemplate.TaskTemplate)
+		if !ok {
+			return goahttp.ErrInvalidType("taskTemplate", "Update", "*tasktemplate.TaskTemplate", v)
+		}
+		body := NewUpdateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("taskTemplate", "Update", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateResponse returns a decoder for responses returned by the
+// taskTemplate Update endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("taskTemplate", "Update", err)
+			}
+			err = ValidateUpdateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("taskTemplate", "Update", err)
+			}
+			res := NewUpdateTaskTemplateOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskTemplate", "Update", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "taskTemplate" service "Delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*tasktemplate.TaskTemplateRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("taskTemplate", "Delete", "*tasktemplate.TaskTemplateRequest", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteTaskTemplatePath(name)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskTemplate", "Delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the
+// taskTemplate Delete endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskTemplate", "Delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "taskTemplate" service "List" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTaskTemplatePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("taskTemplate", "List", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListResponse returns a decoder for responses returned by the
+// taskTemplate List endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("taskTemplate", "List", err)
+			}
+			err = ValidateListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("taskTemplate", "List", err)
+			}
+			res := NewListTaskTemplatesResponseOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("taskTemplate", "List", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// marshalTasktemplateRetryPolicyToRetryPolicyRequestBody builds a value of
+// type *RetryPolicyRequestBody from a value of type *tasktemplate.RetryPolicy.
+func marshalTasktemplateRetryPolicyToRetryPolicyRequestBody(v *tasktemplate.RetryPolicy) *RetryPolicyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &RetryPolicyRequestBody{
+		MaxAttempts: v.MaxAttempts,
+		BaseDelay:   v.BaseDelay,
+		MaxDelay:    v.MaxDelay,
+	}
+	if v.RetryOn != nil {
+		res.RetryOn = make([]string, len(v.RetryOn))
+		for i, val := range v.RetryOn {
+			res.RetryOn[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalRetryPolicyRequestBodyToTasktemplateRetryPolicy builds a value of
+// type *tasktemplate.RetryPolicy from a value of type *RetryPolicyRequestBody.
+func marshalRetryPolicyRequestBodyToTasktemplateRetryPolicy(v *RetryPolicyRequestBody) *tasktemplate.RetryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &tasktemplate.RetryPolicy{
+		MaxAttempts: v.MaxAttempts,
+		BaseDelay:   v.BaseDelay,
+		MaxDelay:    v.MaxDelay,
+	}
+	if v.RetryOn != nil {
+		res.RetryOn = make([]string, len(v.RetryOn))
+		for i, val := range v.RetryOn {
+			res.RetryOn[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalRetryPolicyResponseBodyToTasktemplateRetryPolicy builds a value of
+// type *tasktemplate.RetryPolicy from a value of type *RetryPolicyResponseBody.
+func unmarshalRetryPolicyResponseBodyToTasktemplateRetryPolicy(v *RetryPolicyResponseBody) *tasktemplate.RetryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &tasktemplate.RetryPolicy{
+		MaxAttempts: v.MaxAttempts,
+		BaseDelay:   v.BaseDelay,
+		MaxDelay:    v.MaxDelay,
+	}
+	if v.RetryOn != nil {
+		res.RetryOn = make([]string, len(v.RetryOn))
+		for i, val := range v.RetryOn {
+			res.RetryOn[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalTaskTemplateResponseBodyToTasktemplateTaskTemplate builds a value
+// of type *tasktemplate.TaskTemplate from a value of type
+// *TaskTemplateResponseBody.
+func unmarshalTaskTemplateResponseBodyToTasktemplateTaskTemplate(v *TaskTemplateResponseBody) *tasktemplate.TaskTemplate {
+	res := &tasktemplate.TaskTemplate{
+		Name:           *v.Name,
+		URL:            v.URL,
+		Method:         v.Method,
+		RequestPolicy:  v.RequestPolicy,
+		ResponsePolicy: v.ResponsePolicy,
+		FinalPolicy:    v.FinalPolicy,
+		CacheNamespace: v.CacheNamespace,
+		CacheScope:     v.CacheScope,
+		Version:        v.Version,
+		Priority:       v.Priority,
+		CallbackURL:    v.CallbackURL,
+		Timeout:        v.Timeout,
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = unmarshalRetryPolicyResponseBodyToTasktemplateRetryPolicy(v.RetryPolicy)
+	}
+	if v.AcceptedStatusCodes != nil {
+		res.AcceptedStatusCodes = make([]string, len(v.AcceptedStatusCodes))
+		for i, val := range v.AcceptedStatusCodes {
+			res.AcceptedStatusCodes[i] = val
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,37 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the taskTemplate service.
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// CreateTaskTemplatePath returns the URL path to the taskTemplate service Create HTTP endpoint.
+func CreateTaskTemplatePath() string {
+	return "/v1/taskTemplates"
+}
+
+// GetTaskTemplatePath returns the URL path to the taskTemplate service Get HTTP endpoint.
+func GetTaskTemplatePath(name string) string {
+	return fmt.Sprintf("/v1/taskTemplates/%v", name)
+}
+
+// UpdateTaskTemplatePath returns the URL path to the taskTemplate service Update HTTP endpoint.
+func UpdateTaskTemplatePath(name string) string {
+	return fmt.Sprintf("/v1/taskTemplates/%v", name)
+}
+
+// DeleteTaskTemplatePath returns the URL path to the taskTemplate service Delete HTTP endpoint.
+func DeleteTaskTemplatePath(name string) string {
+	return fmt.Sprintf("/v1/taskTemplates/%v", name)
+}
+
+// ListTaskTemplatePath returns the URL path to the taskTemplate service List HTTP endpoint.
+func ListTaskTemplatePath() string {
+	return "/v1/taskTemplates"
+}
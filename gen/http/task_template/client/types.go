@@ -0,0 +1,560 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskTemplate HTTP client types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	tasktemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_template"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "taskTemplate" service "Create"
+// endpoint HTTP request body.
+type CreateRequestBody struct {
+	// Task template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyRequestBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// UpdateRequestBody is the type of the "taskTemplate" service "Update"
+// endpoint HTTP request body.
+type UpdateRequestBody struct {
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyRequestBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// CreateResponseBody is the type of the "taskTemplate" service "Create"
+// endpoint HTTP response body.
+type CreateResponseBody struct {
+	// Task template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyResponseBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// GetResponseBody is the type of the "taskTemplate" service "Get" endpoint
+// HTTP response body.
+type GetResponseBody struct {
+	// Task template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyResponseBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// UpdateResponseBody is the type of the "taskTemplate" service "Update"
+// endpoint HTTP response body.
+type UpdateResponseBody struct {
+	// Task template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyResponseBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListResponseBody is the type of the "taskTemplate" service "List" endpoint
+// HTTP response body.
+type ListResponseBody struct {
+	// Array of task templates.
+	Templates []*TaskTemplateResponseBody `form:"templates,omitempty" json:"templates,omitempty" xml:"templates,omitempty"`
+}
+
+// RetryPolicyRequestBody is used to define fields on request body types.
+type RetryPolicyRequestBody struct {
+	// Maximum number of task executions before the task is moved to the dead
+	// letters.
+	MaxAttempts *int `form:"maxAttempts,omitempty" json:"maxAttempts,omitempty" xml:"maxAttempts,omitempty"`
+	// Delay before the first retry, which is doubled on each following retry.
+	BaseDelay *string `form:"baseDelay,omitempty" json:"baseDelay,omitempty" xml:"baseDelay,omitempty"`
+	// Maximum delay between retries.
+	MaxDelay *string `form:"maxDelay,omitempty" json:"maxDelay,omitempty" xml:"maxDelay,omitempty"`
+	// Reasons of the failures which are retried. All failures are retried if not
+	// set.
+	RetryOn []string `form:"retryOn,omitempty" json:"retryOn,omitempty" xml:"retryOn,omitempty"`
+}
+
+// RetryPolicyResponseBody is used to define fields on response body types.
+type RetryPolicyResponseBody struct {
+	// Maximum number of task executions before the task is moved to the dead
+	// letters.
+	MaxAttempts *int `form:"maxAttempts,omitempty" json:"maxAttempts,omitempty" xml:"maxAttempts,omitempty"`
+	// Delay before the first retry, which is doubled on each following retry.
+	BaseDelay *string `form:"baseDelay,omitempty" json:"baseDelay,omitempty" xml:"baseDelay,omitempty"`
+	// Maximum delay between retries.
+	MaxDelay *string `form:"maxDelay,omitempty" json:"maxDelay,omitempty" xml:"maxDelay,omitempty"`
+	// Reasons of the failures which are retried. All failures are retried if not
+	// set.
+	RetryOn []string `form:"retryOn,omitempty" json:"retryOn,omitempty" xml:"retryOn,omitempty"`
+}
+
+// TaskTemplateResponseBody is used to define fields on response body types.
+type TaskTemplateResponseBody struct {
+	// Task template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyResponseBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "Create" endpoint of the "taskTemplate" service.
+func NewCreateRequestBody(p *tasktemplate.TaskTemplate) *CreateRequestBody {
+	body := &CreateRequestBody{
+		Name:           p.Name,
+		URL:            p.URL,
+		Method:         p.Method,
+		RequestPolicy:  p.RequestPolicy,
+		ResponsePolicy: p.ResponsePolicy,
+		FinalPolicy:    p.FinalPolicy,
+		CacheNamespace: p.CacheNamespace,
+		CacheScope:     p.CacheScope,
+		Version:        p.Version,
+		Priority:       p.Priority,
+		CallbackURL:    p.CallbackURL,
+		Timeout:        p.Timeout,
+	}
+	if p.RetryPolicy != nil {
+		body.RetryPolicy = marshalTasktemplateRetryPolicyToRetryPolicyRequestBody(p.RetryPolicy)
+	}
+	if p.AcceptedStatusCodes != nil {
+		body.AcceptedStatusCodes = make([]string, len(p.AcceptedStatusCodes))
+		for i, val := range p.AcceptedStatusCodes {
+			body.AcceptedStatusCodes[i] = val
+		}
+	}
+	return body
+}
+
+// NewUpdateRequestBody builds the HTTP request body from the payload of the
+// "Update" endpoint of the "taskTemplate" service.
+func NewUpdateRequestBody(p *tasktemplate.TaskTemplate) *UpdateRequestBody {
+	body := &UpdateRequestBody{
+		URL:            p.URL,
+		Method:         p.Method,
+		RequestPolicy:  p.RequestPolicy,
+		ResponsePolicy: p.ResponsePolicy,
+		FinalPolicy:    p.FinalPolicy,
+		CacheNamespace: p.CacheNamespace,
+		CacheScope:     p.CacheScope,
+		Version:        p.Version,
+		Priority:       p.Priority,
+		CallbackURL:    p.CallbackURL,
+		Timeout:        p.Timeout,
+	}
+	if p.RetryPolicy != nil {
+		body.RetryPolicy = marshalTasktemplateRetryPolicyToRetryPolicyRequestBody(p.RetryPolicy)
+	}
+	if p.AcceptedStatusCodes != nil {
+		body.AcceptedStatusCodes = make([]string, len(p.AcceptedStatusCodes))
+		for i, val := range p.AcceptedStatusCodes {
+			body.AcceptedStatusCodes[i] = val
+		}
+	}
+	return body
+}
+
+// NewCreateTaskTemplateCreated builds a "taskTemplate" service "Create"
+// endpoint result from a HTTP "Created" response.
+func NewCreateTaskTemplateCreated(body *CreateResponseBody) *tasktemplate.TaskTemplate {
+	v := &tasktemplate.TaskTemplate{
+		Name:           *body.Name,
+		URL:            body.URL,
+		Method:         body.Method,
+		RequestPolicy:  body.RequestPolicy,
+		ResponsePolicy: body.ResponsePolicy,
+		FinalPolicy:    body.FinalPolicy,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	if body.RetryPolicy != nil {
+		v.RetryPolicy = unmarshalRetryPolicyResponseBodyToTasktemplateRetryPolicy(body.RetryPolicy)
+	}
+	if body.AcceptedStatusCodes != nil {
+		v.AcceptedStatusCodes = make([]string, len(body.AcceptedStatusCodes))
+		for i, val := range body.AcceptedStatusCodes {
+			v.AcceptedStatusCodes[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewGetTaskTemplateOK builds a "taskTemplate" service "Get" endpoint result
+// from a HTTP "OK" response.
+func NewGetTaskTemplateOK(body *GetResponseBody) *tasktemplate.TaskTemplate {
+	v := &tasktemplate.TaskTemplate{
+		Name:           *body.Name,
+		URL:            body.URL,
+		Method:         body.Method,
+		RequestPolicy:  body.RequestPolicy,
+		ResponsePolicy: body.ResponsePolicy,
+		FinalPolicy:    body.FinalPolicy,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	if body.RetryPolicy != nil {
+		v.RetryPolicy = unmarshalRetryPolicyResponseBodyToTasktemplateRetryPolicy(body.RetryPolicy)
+	}
+	if body.AcceptedStatusCodes != nil {
+		v.AcceptedStatusCodes = make([]string, len(body.AcceptedStatusCodes))
+		for i, val := range body.AcceptedStatusCodes {
+			v.AcceptedStatusCodes[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewUpdateTaskTemplateOK builds a "taskTemplate" service "Update" endpoint
+// result from a HTTP "OK" response.
+func NewUpdateTaskTemplateOK(body *UpdateResponseBody) *tasktemplate.TaskTemplate {
+	v := &tasktemplate.TaskTemplate{
+		Name:           *body.Name,
+		URL:            body.URL,
+		Method:         body.Method,
+		RequestPolicy:  body.RequestPolicy,
+		ResponsePolicy: body.ResponsePolicy,
+		FinalPolicy:    body.FinalPolicy,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	if body.RetryPolicy != nil {
+		v.RetryPolicy = unmarshalRetryPolicyResponseBodyToTasktemplateRetryPolicy(body.RetryPolicy)
+	}
+	if body.AcceptedStatusCodes != nil {
+		v.AcceptedStatusCodes = make([]string, len(body.AcceptedStatusCodes))
+		for i, val := range body.AcceptedStatusCodes {
+			v.AcceptedStatusCodes[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewListTaskTemplatesResponseOK builds a "taskTemplate" service "List"
+// endpoint result from a HTTP "OK" response.
+func NewListTaskTemplatesResponseOK(body *ListResponseBody) *tasktemplate.TaskTemplatesResponse {
+	v := &tasktemplate.TaskTemplatesResponse{}
+	v.Templates = make([]*tasktemplate.TaskTemplate, len(body.Templates))
+	for i, val := range body.Templates {
+		v.Templates[i] = unmarshalTaskTemplateResponseBodyToTasktemplateTaskTemplate(val)
+	}
+
+	return v
+}
+
+// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
+func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateUpdateResponseBody runs the validations defined on UpdateResponseBody
+func ValidateUpdateResponseBody(body *UpdateResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateListResponseBody runs the validations defined on ListResponseBody
+func ValidateListResponseBody(body *ListResponseBody) (err error) {
+	if body.Templates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("templates", "body"))
+	}
+	for _, e := range body.Templates {
+		if e != nil {
+			if err2 := ValidateTaskTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRetryPolicyRequestBody runs the validations defined on
+// RetryPolicyRequestBody
+func ValidateRetryPolicyRequestBody(body *RetryPolicyRequestBody) (err error) {
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maxAttempts", *body.MaxAttempts, 1, true))
+		}
+	}
+	for _, e := range body.RetryOn {
+		if !(e == "http" || e == "policy" || e == "cache" || e == "status" || e == "timeout") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.retryOn[*]", e, []any{"http", "policy", "cache", "status", "timeout"}))
+		}
+	}
+	return
+}
+
+// ValidateRetryPolicyResponseBody runs the validations defined on
+// RetryPolicyResponseBody
+func ValidateRetryPolicyResponseBody(body *RetryPolicyResponseBody) (err error) {
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maxAttempts", *body.MaxAttempts, 1, true))
+		}
+	}
+	for _, e := range body.RetryOn {
+		if !(e == "http" || e == "policy" || e == "cache" || e == "status" || e == "timeout") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.retryOn[*]", e, []any{"http", "policy", "cache", "status", "timeout"}))
+		}
+	}
+	return
+}
+
+// ValidateTaskTemplateResponseBody runs the validations defined on
+// TaskTemplateResponseBody
+func ValidateTaskTemplateResponseBody(body *TaskTemplateResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
@@ -0,0 +1,268 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskTemplate HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	tasktemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_template"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeCreateResponse returns an encoder for responses returned by the
+// taskTemplate Create endpoint.
+func EncodeCreateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*tasktemplate.TaskTemplate)
+		enc := encoder(ctx, w)
+		body := NewCreateResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateRequest returns a decoder for requests sent to the taskTemplate
+// Create endpoint.
+func DecodeCreateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateTaskTemplate(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetResponse returns an encoder for responses returned by the
+// taskTemplate Get endpoint.
+func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*tasktemplate.TaskTemplate)
+		enc := encoder(ctx, w)
+		body := NewGetResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetRequest returns a decoder for requests sent to the taskTemplate Get
+// endpoint.
+func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			name    string
+			version *int
+			err     error
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		{
+			versionRaw := r.URL.Query().Get("version")
+			if versionRaw != "" {
+				v, err2 := strconv.ParseInt(versionRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("version", versionRaw, "integer"))
+				}
+				pv := int(v)
+				version = &pv
+			}
+		}
+		if version != nil {
+			if *version < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("version", *version, 1, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetTaskTemplateVersionRequest(name, version)
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateResponse returns an encoder for responses returned by the
+// taskTemplate Update endpoint.
+func EncodeUpdateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*tasktemplate.TaskTemplate)
+		enc := encoder(ctx, w)
+		body := NewUpdateResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateRequest returns a decoder for requests sent to the taskTemplate
+// Update endpoint.
+func DecodeUpdateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			name string
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		payload := NewUpdateTaskTemplate(&body, name)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteResponse returns an encoder for responses returned by the
+// taskTemplate Delete endpoint.
+func EncodeDeleteResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDeleteRequest returns a decoder for requests sent to the taskTemplate
+// Delete endpoint.
+func DecodeDeleteRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			name string
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		payload := NewDeleteTaskTemplateRequest(name)
+
+		return payload, nil
+	}
+}
+
+// EncodeListResponse returns an encoder for responses returned by the
+// taskTemplate List endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*tasktemplate.TaskTemplatesResponse)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// unmarshalRetryPolicyRequestBodyToTasktemplateRetryPolicy builds a value of
+// type *tasktemplate.RetryPolicy from a value of type *RetryPolicyRequestBody.
+func unmarshalRetryPolicyRequestBodyToTasktemplateRetryPolicy(v *RetryPolicyRequestBody) *tasktemplate.RetryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &tasktemplate.RetryPolicy{
+		MaxAttempts: v.MaxAttempts,
+		BaseDelay:   v.BaseDelay,
+		MaxDelay:    v.MaxDelay,
+	}
+	if v.RetryOn != nil {
+		res.RetryOn = make([]string, len(v.RetryOn))
+		for i, val := range v.RetryOn {
+			res.RetryOn[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalTasktemplateRetryPolicyToRetryPolicyResponseBody builds a value of
+// type *RetryPolicyResponseBody from a value of type *tasktemplate.RetryPolicy.
+func marshalTasktemplateRetryPolicyToRetryPolicyResponseBody(v *tasktemplate.RetryPolicy) *RetryPolicyResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &RetryPolicyResponseBody{
+		MaxAttempts: v.MaxAttempts,
+		BaseDelay:   v.BaseDelay,
+		MaxDelay:    v.MaxDelay,
+	}
+	if v.RetryOn != nil {
+		res.RetryOn = make([]string, len(v.RetryOn))
+		for i, val := range v.RetryOn {
+			res.RetryOn[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalTasktemplateTaskTemplateToTaskTemplateResponseBody builds a value of
+// type *TaskTemplateResponseBody from a value of type
+// *tasktemplate.TaskTemplate.
+func marshalTasktemplateTaskTemplateToTaskTemplateResponseBody(v *tasktemplate.TaskTemplate) *TaskTemplateResponseBody {
+	res := &TaskTemplateResponseBody{
+		Name:           v.Name,
+		URL:            v.URL,
+		Method:         v.Method,
+		RequestPolicy:  v.RequestPolicy,
+		ResponsePolicy: v.ResponsePolicy,
+		FinalPolicy:    v.FinalPolicy,
+		CacheNamespace: v.CacheNamespace,
+		CacheScope:     v.CacheScope,
+		Version:        v.Version,
+		Priority:       v.Priority,
+		CallbackURL:    v.CallbackURL,
+		Timeout:        v.Timeout,
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = marshalTasktemplateRetryPolicyToRetryPolicyResponseBody(v.RetryPolicy)
+	}
+	if v.AcceptedStatusCodes != nil {
+		res.AcceptedStatusCodes = make([]string, len(v.AcceptedStatusCodes))
+		for i, val := range v.AcceptedStatusCodes {
+			res.AcceptedStatusCodes[i] = val
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,499 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskTemplate HTTP server types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	tasktemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_template"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "taskTemplate" service "Create"
+// endpoint HTTP request body.
+type CreateRequestBody struct {
+	// Task template name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyRequestBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// UpdateRequestBody is the type of the "taskTemplate" service "Update"
+// endpoint HTTP request body.
+type UpdateRequestBody struct {
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyRequestBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// CreateResponseBody is the type of the "taskTemplate" service "Create"
+// endpoint HTTP response body.
+type CreateResponseBody struct {
+	// Task template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyResponseBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// GetResponseBody is the type of the "taskTemplate" service "Get" endpoint
+// HTTP response body.
+type GetResponseBody struct {
+	// Task template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyResponseBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// UpdateResponseBody is the type of the "taskTemplate" service "Update"
+// endpoint HTTP response body.
+type UpdateResponseBody struct {
+	// Task template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyResponseBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListResponseBody is the type of the "taskTemplate" service "List" endpoint
+// HTTP response body.
+type ListResponseBody struct {
+	// Array of task templates.
+	Templates []*TaskTemplateResponseBody `form:"templates" json:"templates" xml:"templates"`
+}
+
+// RetryPolicyResponseBody is used to define fields on response body types.
+type RetryPolicyResponseBody struct {
+	// Maximum number of task executions before the task is moved to the dead
+	// letters.
+	MaxAttempts *int `form:"maxAttempts,omitempty" json:"maxAttempts,omitempty" xml:"maxAttempts,omitempty"`
+	// Delay before the first retry, which is doubled on each following retry.
+	BaseDelay *string `form:"baseDelay,omitempty" json:"baseDelay,omitempty" xml:"baseDelay,omitempty"`
+	// Maximum delay between retries.
+	MaxDelay *string `form:"maxDelay,omitempty" json:"maxDelay,omitempty" xml:"maxDelay,omitempty"`
+	// Reasons of the failures which are retried. All failures are retried if not
+	// set.
+	RetryOn []string `form:"retryOn,omitempty" json:"retryOn,omitempty" xml:"retryOn,omitempty"`
+}
+
+// TaskTemplateResponseBody is used to define fields on response body types.
+type TaskTemplateResponseBody struct {
+	// Task template name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// URL against which the task request will be executed.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// HTTP method of the task request.
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string `form:"requestPolicy,omitempty" json:"requestPolicy,omitempty" xml:"requestPolicy,omitempty"`
+	// Policy to be executed on the task response.
+	ResponsePolicy *string `form:"responsePolicy,omitempty" json:"responsePolicy,omitempty" xml:"responsePolicy,omitempty"`
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string `form:"finalPolicy,omitempty" json:"finalPolicy,omitempty" xml:"finalPolicy,omitempty"`
+	// Default cache key namespace.
+	CacheNamespace *string `form:"cacheNamespace,omitempty" json:"cacheNamespace,omitempty" xml:"cacheNamespace,omitempty"`
+	// Default cache key scope.
+	CacheScope *string `form:"cacheScope,omitempty" json:"cacheScope,omitempty" xml:"cacheScope,omitempty"`
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string `form:"callbackURL,omitempty" json:"callbackURL,omitempty" xml:"callbackURL,omitempty"`
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicyResponseBody `form:"retryPolicy,omitempty" json:"retryPolicy,omitempty" xml:"retryPolicy,omitempty"`
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string `form:"acceptedStatusCodes,omitempty" json:"acceptedStatusCodes,omitempty" xml:"acceptedStatusCodes,omitempty"`
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RetryPolicyRequestBody is used to define fields on request body types.
+type RetryPolicyRequestBody struct {
+	// Maximum number of task executions before the task is moved to the dead
+	// letters.
+	MaxAttempts *int `form:"maxAttempts,omitempty" json:"maxAttempts,omitempty" xml:"maxAttempts,omitempty"`
+	// Delay before the first retry, which is doubled on each following retry.
+	BaseDelay *string `form:"baseDelay,omitempty" json:"baseDelay,omitempty" xml:"baseDelay,omitempty"`
+	// Maximum delay between retries.
+	MaxDelay *string `form:"maxDelay,omitempty" json:"maxDelay,omitempty" xml:"maxDelay,omitempty"`
+	// Reasons of the failures which are retried. All failures are retried if not
+	// set.
+	RetryOn []string `form:"retryOn,omitempty" json:"retryOn,omitempty" xml:"retryOn,omitempty"`
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "Create" endpoint of the "taskTemplate" service.
+func NewCreateResponseBody(res *tasktemplate.TaskTemplate) *CreateResponseBody {
+	body := &CreateResponseBody{
+		Name:           res.Name,
+		URL:            res.URL,
+		Method:         res.Method,
+		RequestPolicy:  res.RequestPolicy,
+		ResponsePolicy: res.ResponsePolicy,
+		FinalPolicy:    res.FinalPolicy,
+		CacheNamespace: res.CacheNamespace,
+		CacheScope:     res.CacheScope,
+		Version:        res.Version,
+		Priority:       res.Priority,
+		CallbackURL:    res.CallbackURL,
+		Timeout:        res.Timeout,
+	}
+	if res.RetryPolicy != nil {
+		body.RetryPolicy = marshalTasktemplateRetryPolicyToRetryPolicyResponseBody(res.RetryPolicy)
+	}
+	if res.AcceptedStatusCodes != nil {
+		body.AcceptedStatusCodes = make([]string, len(res.AcceptedStatusCodes))
+		for i, val := range res.AcceptedStatusCodes {
+			body.AcceptedStatusCodes[i] = val
+		}
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "Get" endpoint of the "taskTemplate" service.
+func NewGetResponseBody(res *tasktemplate.TaskTemplate) *GetResponseBody {
+	body := &GetResponseBody{
+		Name:           res.Name,
+		URL:            res.URL,
+		Method:         res.Method,
+		RequestPolicy:  res.RequestPolicy,
+		ResponsePolicy: res.ResponsePolicy,
+		FinalPolicy:    res.FinalPolicy,
+		CacheNamespace: res.CacheNamespace,
+		CacheScope:     res.CacheScope,
+		Version:        res.Version,
+		Priority:       res.Priority,
+		CallbackURL:    res.CallbackURL,
+		Timeout:        res.Timeout,
+	}
+	if res.RetryPolicy != nil {
+		body.RetryPolicy = marshalTasktemplateRetryPolicyToRetryPolicyResponseBody(res.RetryPolicy)
+	}
+	if res.AcceptedStatusCodes != nil {
+		body.AcceptedStatusCodes = make([]string, len(res.AcceptedStatusCodes))
+		for i, val := range res.AcceptedStatusCodes {
+			body.AcceptedStatusCodes[i] = val
+		}
+	}
+	return body
+}
+
+// NewUpdateResponseBody builds the HTTP response body from the result of the
+// "Update" endpoint of the "taskTemplate" service.
+func NewUpdateResponseBody(res *tasktemplate.TaskTemplate) *UpdateResponseBody {
+	body := &UpdateResponseBody{
+		Name:           res.Name,
+		URL:            res.URL,
+		Method:         res.Method,
+		RequestPolicy:  res.RequestPolicy,
+		ResponsePolicy: res.ResponsePolicy,
+		FinalPolicy:    res.FinalPolicy,
+		CacheNamespace: res.CacheNamespace,
+		CacheScope:     res.CacheScope,
+		Version:        res.Version,
+		Priority:       res.Priority,
+		CallbackURL:    res.CallbackURL,
+		Timeout:        res.Timeout,
+	}
+	if res.RetryPolicy != nil {
+		body.RetryPolicy = marshalTasktemplateRetryPolicyToRetryPolicyResponseBody(res.RetryPolicy)
+	}
+	if res.AcceptedStatusCodes != nil {
+		body.AcceptedStatusCodes = make([]string, len(res.AcceptedStatusCodes))
+		for i, val := range res.AcceptedStatusCodes {
+			body.AcceptedStatusCodes[i] = val
+		}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "List" endpoint of the "taskTemplate" service.
+func NewListResponseBody(res *tasktemplate.TaskTemplatesResponse) *ListResponseBody {
+	body := &ListResponseBody{}
+	if res.Templates != nil {
+		body.Templates = make([]*TaskTemplateResponseBody, len(res.Templates))
+		for i, val := range res.Templates {
+			body.Templates[i] = marshalTasktemplateTaskTemplateToTaskTemplateResponseBody(val)
+		}
+	} else {
+		body.Templates = []*TaskTemplateResponseBody{}
+	}
+	return body
+}
+
+// NewCreateTaskTemplate builds a taskTemplate service Create endpoint payload.
+func NewCreateTaskTemplate(body *CreateRequestBody) *tasktemplate.TaskTemplate {
+	v := &tasktemplate.TaskTemplate{
+		Name:           *body.Name,
+		URL:            body.URL,
+		Method:         body.Method,
+		RequestPolicy:  body.RequestPolicy,
+		ResponsePolicy: body.ResponsePolicy,
+		FinalPolicy:    body.FinalPolicy,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	if body.RetryPolicy != nil {
+		v.RetryPolicy = unmarshalRetryPolicyRequestBodyToTasktemplateRetryPolicy(body.RetryPolicy)
+	}
+	if body.AcceptedStatusCodes != nil {
+		v.AcceptedStatusCodes = make([]string, len(body.AcceptedStatusCodes))
+		for i, val := range body.AcceptedStatusCodes {
+			v.AcceptedStatusCodes[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewGetTaskTemplateVersionRequest builds a taskTemplate service Get endpoint
+// payload.
+func NewGetTaskTemplateVersionRequest(name string, version *int) *tasktemplate.TaskTemplateVersionRequest {
+	v := &tasktemplate.TaskTemplateVersionRequest{}
+	v.Name = name
+	v.Version = version
+
+	return v
+}
+
+// NewUpdateTaskTemplate builds a taskTemplate service Update endpoint payload.
+func NewUpdateTaskTemplate(body *UpdateRequestBody, name string) *tasktemplate.TaskTemplate {
+	v := &tasktemplate.TaskTemplate{
+		URL:            body.URL,
+		Method:         body.Method,
+		RequestPolicy:  body.RequestPolicy,
+		ResponsePolicy: body.ResponsePolicy,
+		FinalPolicy:    body.FinalPolicy,
+		CacheNamespace: body.CacheNamespace,
+		CacheScope:     body.CacheScope,
+		Version:        body.Version,
+		Priority:       body.Priority,
+		CallbackURL:    body.CallbackURL,
+		Timeout:        body.Timeout,
+	}
+	if body.RetryPolicy != nil {
+		v.RetryPolicy = unmarshalRetryPolicyRequestBodyToTasktemplateRetryPolicy(body.RetryPolicy)
+	}
+	if body.AcceptedStatusCodes != nil {
+		v.AcceptedStatusCodes = make([]string, len(body.AcceptedStatusCodes))
+		for i, val := range body.AcceptedStatusCodes {
+			v.AcceptedStatusCodes[i] = val
+		}
+	}
+	v.Name = name
+
+	return v
+}
+
+// NewDeleteTaskTemplateRequest builds a taskTemplate service Delete endpoint
+// payload.
+func NewDeleteTaskTemplateRequest(name string) *tasktemplate.TaskTemplateRequest {
+	v := &tasktemplate.TaskTemplateRequest{}
+	v.Name = name
+
+	return v
+}
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateRetryPolicyRequestBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateUpdateRequestBody runs the validations defined on UpdateRequestBody
+func ValidateUpdateRequestBody(body *UpdateRequestBody) (err error) {
+	if body.Priority != nil {
+		if *body.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 0, true))
+		}
+	}
+	if body.Priority != nil {
+		if *body.Priority > 9 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.priority", *body.Priority, 9, false))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateRetryPolicyRequestBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateRetryPolicyRequestBody runs the validations defined on
+// RetryPolicyRequestBody
+func ValidateRetryPolicyRequestBody(body *RetryPolicyRequestBody) (err error) {
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maxAttempts", *body.MaxAttempts, 1, true))
+		}
+	}
+	for _, e := range body.RetryOn {
+		if !(e == "http" || e == "policy" || e == "cache" || e == "status" || e == "timeout") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.retryOn[*]", e, []any{"http", "policy", "cache", "status", "timeout"}))
+		}
+	}
+	return
+}
@@ -0,0 +1,25 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// webhook HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	webhook "github.com/eclipse-xfsc/task-sheduler/gen/webhook"
+)
+
+// BuildDeliveriesPayload builds the payload for the webhook Deliveries
+// endpoint from CLI flags.
+func BuildDeliveriesPayload(webhookDeliveriesID string) (*webhook.WebhookDeliveriesRequest, error) {
+	var id string
+	{
+		id = webhookDeliveriesID
+	}
+	v := &webhook.WebhookDeliveriesRequest{}
+	v.ID = id
+
+	return v, nil
+}
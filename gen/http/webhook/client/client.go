@@ -0,0 +1,70 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// webhook client HTTP transport
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the webhook service endpoint HTTP clients.
+type Client struct {
+	// Deliveries Doer is the HTTP client used to make requests to the Deliveries
+	// endpoint.
+	DeliveriesDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the webhook service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		DeliveriesDoer:      doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// Deliveries returns an endpoint that makes HTTP requests to the webhook
+// service Deliveries server.
+func (c *Client) Deliveries() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeliveriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeliveriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeliveriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhook", "Deliveries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,116 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// webhook HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	webhook "github.com/eclipse-xfsc/task-sheduler/gen/webhook"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildDeliveriesRequest instantiates a HTTP request object with method and
+// path set to call the "webhook" service "Deliveries" endpoint
+func (c *Client) BuildDeliveriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*webhook.WebhookDeliveriesRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhook", "Deliveries", "*webhook.WebhookDeliveriesRequest", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeliveriesWebhookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "Deliveries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeliveriesResponse returns a decoder for responses returned by the
+// webhook Deliveries endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeDeliveriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeliveriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "Deliveries", err)
+			}
+			err = ValidateDeliveriesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "Deliveries", err)
+			}
+			res := NewDeliveriesWebhookDeliveriesResponseOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "Deliveries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalWebhookDeliveryResponseBodyToWebhookWebhookDelivery builds a value
+// of type *webhook.WebhookDelivery from a value of type
+// *WebhookDeliveryResponseBody.
+func unmarshalWebhookDeliveryResponseBodyToWebhookWebhookDelivery(v *WebhookDeliveryResponseBody) *webhook.WebhookDelivery {
+	res := &webhook.WebhookDelivery{
+		ID:            *v.ID,
+		URL:           *v.URL,
+		State:         *v.State,
+		NextAttemptAt: v.NextAttemptAt,
+		CreatedAt:     *v.CreatedAt,
+	}
+	res.Attempts = make([]*webhook.WebhookAttempt, len(v.Attempts))
+	for i, val := range v.Attempts {
+		res.Attempts[i] = unmarshalWebhookAttemptResponseBodyToWebhookWebhookAttempt(val)
+	}
+
+	return res
+}
+
+// unmarshalWebhookAttemptResponseBodyToWebhookWebhookAttempt builds a value of
+// type *webhook.WebhookAttempt from a value of type
+// *WebhookAttemptResponseBody.
+func unmarshalWebhookAttemptResponseBodyToWebhookWebhookAttempt(v *WebhookAttemptResponseBody) *webhook.WebhookAttempt {
+	res := &webhook.WebhookAttempt{
+		StartedAt:  *v.StartedAt,
+		StatusCode: v.StatusCode,
+		Error:      v.Error,
+	}
+
+	return res
+}
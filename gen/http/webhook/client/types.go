@@ -0,0 +1,125 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// webhook HTTP client types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package client
+
+import (
+	webhook "github.com/eclipse-xfsc/task-sheduler/gen/webhook"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// DeliveriesResponseBody is the type of the "webhook" service "Deliveries"
+// endpoint HTTP response body.
+type DeliveriesResponseBody struct {
+	// Array of webhook deliveries.
+	Deliveries []*WebhookDeliveryResponseBody `form:"deliveries,omitempty" json:"deliveries,omitempty" xml:"deliveries,omitempty"`
+}
+
+// WebhookDeliveryResponseBody is used to define fields on response body types.
+type WebhookDeliveryResponseBody struct {
+	// Unique webhook delivery identifier.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Callback URL to which the webhook is delivered.
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// State of the webhook delivery.
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Delivery attempts.
+	Attempts []*WebhookAttemptResponseBody `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Time of the next delivery attempt of a pending webhook.
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// Webhook delivery creation time.
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// WebhookAttemptResponseBody is used to define fields on response body types.
+type WebhookAttemptResponseBody struct {
+	// Time of the delivery attempt.
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Status code of the response, if one was received.
+	StatusCode *int `form:"statusCode,omitempty" json:"statusCode,omitempty" xml:"statusCode,omitempty"`
+	// Error of a failed delivery attempt.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// NewDeliveriesWebhookDeliveriesResponseOK builds a "webhook" service
+// "Deliveries" endpoint result from a HTTP "OK" response.
+func NewDeliveriesWebhookDeliveriesResponseOK(body *DeliveriesResponseBody) *webhook.WebhookDeliveriesResponse {
+	v := &webhook.WebhookDeliveriesResponse{}
+	v.Deliveries = make([]*webhook.WebhookDelivery, len(body.Deliveries))
+	for i, val := range body.Deliveries {
+		v.Deliveries[i] = unmarshalWebhookDeliveryResponseBodyToWebhookWebhookDelivery(val)
+	}
+
+	return v
+}
+
+// ValidateDeliveriesResponseBody runs the validations defined on
+// DeliveriesResponseBody
+func ValidateDeliveriesResponseBody(body *DeliveriesResponseBody) (err error) {
+	if body.Deliveries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deliveries", "body"))
+	}
+	for _, e := range body.Deliveries {
+		if e != nil {
+			if err2 := ValidateWebhookDeliveryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateWebhookDeliveryResponseBody runs the validations defined on
+// WebhookDeliveryResponseBody
+func ValidateWebhookDeliveryResponseBody(body *WebhookDeliveryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.State != nil {
+		if !(*body.State == "pending" || *body.State == "delivered" || *body.State == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"pending", "delivered", "failed"}))
+		}
+	}
+	for _, e := range body.Attempts {
+		if e != nil {
+			if err2 := ValidateWebhookAttemptResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextAttemptAt", *body.NextAttemptAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateWebhookAttemptResponseBody runs the validations defined on
+// WebhookAttemptResponseBody
+func ValidateWebhookAttemptResponseBody(body *WebhookAttemptResponseBody) (err error) {
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	return
+}
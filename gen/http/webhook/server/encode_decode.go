@@ -0,0 +1,80 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// webhook HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	webhook "github.com/eclipse-xfsc/task-sheduler/gen/webhook"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// EncodeDeliveriesResponse returns an encoder for responses returned by the
+// webhook Deliveries endpoint.
+func EncodeDeliveriesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*webhook.WebhookDeliveriesResponse)
+		enc := encoder(ctx, w)
+		body := NewDeliveriesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeliveriesRequest returns a decoder for requests sent to the webhook
+// Deliveries endpoint.
+func DecodeDeliveriesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		payload := NewDeliveriesWebhookDeliveriesRequest(id)
+
+		return payload, nil
+	}
+}
+
+// marshalWebhookWebhookDeliveryToWebhookDeliveryResponseBody builds a value of
+// type *WebhookDeliveryResponseBody from a value of type
+// *webhook.WebhookDelivery.
+func marshalWebhookWebhookDeliveryToWebhookDeliveryResponseBody(v *webhook.WebhookDelivery) *WebhookDeliveryResponseBody {
+	res := &WebhookDeliveryResponseBody{
+		ID:            v.ID,
+		URL:           v.URL,
+		State:         v.State,
+		NextAttemptAt: v.NextAttemptAt,
+		CreatedAt:     v.CreatedAt,
+	}
+	if v.Attempts != nil {
+		res.Attempts = make([]*WebhookAttemptResponseBody, len(v.Attempts))
+		for i, val := range v.Attempts {
+			res.Attempts[i] = marshalWebhookWebhookAttemptToWebhookAttemptResponseBody(val)
+		}
+	} else {
+		res.Attempts = []*WebhookAttemptResponseBody{}
+	}
+
+	return res
+}
+
+// marshalWebhookWebhookAttemptToWebhookAttemptResponseBody builds a value of
+// type *WebhookAttemptResponseBody from a value of type
+// *webhook.WebhookAttempt.
+func marshalWebhookWebhookAttemptToWebhookAttemptResponseBody(v *webhook.WebhookAttempt) *WebhookAttemptResponseBody {
+	res := &WebhookAttemptResponseBody{
+		StartedAt:  v.StartedAt,
+		StatusCode: v.StatusCode,
+		Error:      v.Error,
+	}
+
+	return res
+}
@@ -0,0 +1,17 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the webhook service.
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// DeliveriesWebhookPath returns the URL path to the webhook service Deliveries HTTP endpoint.
+func DeliveriesWebhookPath(id string) string {
+	return fmt.Sprintf("/v1/webhooks/%v", id)
+}
@@ -0,0 +1,69 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// webhook HTTP server types
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package server
+
+import (
+	webhook "github.com/eclipse-xfsc/task-sheduler/gen/webhook"
+)
+
+// DeliveriesResponseBody is the type of the "webhook" service "Deliveries"
+// endpoint HTTP response body.
+type DeliveriesResponseBody struct {
+	// Array of webhook deliveries.
+	Deliveries []*WebhookDeliveryResponseBody `form:"deliveries" json:"deliveries" xml:"deliveries"`
+}
+
+// WebhookDeliveryResponseBody is used to define fields on response body types.
+type WebhookDeliveryResponseBody struct {
+	// Unique webhook delivery identifier.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Callback URL to which the webhook is delivered.
+	URL string `form:"url" json:"url" xml:"url"`
+	// State of the webhook delivery.
+	State string `form:"state" json:"state" xml:"state"`
+	// Delivery attempts.
+	Attempts []*WebhookAttemptResponseBody `form:"attempts" json:"attempts" xml:"attempts"`
+	// Time of the next delivery attempt of a pending webhook.
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// Webhook delivery creation time.
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// WebhookAttemptResponseBody is used to define fields on response body types.
+type WebhookAttemptResponseBody struct {
+	// Time of the delivery attempt.
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// Status code of the response, if one was received.
+	StatusCode *int `form:"statusCode,omitempty" json:"statusCode,omitempty" xml:"statusCode,omitempty"`
+	// Error of a failed delivery attempt.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// NewDeliveriesResponseBody builds the HTTP response body from the result of
+// the "Deliveries" endpoint of the "webhook" service.
+func NewDeliveriesResponseBody(res *webhook.WebhookDeliveriesResponse) *DeliveriesResponseBody {
+	body := &DeliveriesResponseBody{}
+	if res.Deliveries != nil {
+		body.Deliveries = make([]*WebhookDeliveryResponseBody, len(res.Deliveries))
+		for i, val := range res.Deliveries {
+			body.Deliveries[i] = marshalWebhookWebhookDeliveryToWebhookDeliveryResponseBody(val)
+		}
+	} else {
+		body.Deliveries = []*WebhookDeliveryResponseBody{}
+	}
+	return body
+}
+
+// NewDeliveriesWebhookDeliveriesRequest builds a webhook service Deliveries
+// endpoint payload.
+func NewDeliveriesWebhookDeliveriesRequest(id string) *webhook.WebhookDeliveriesRequest {
+	v := &webhook.WebhookDeliveriesRequest{}
+	v.ID = id
+
+	return v
+}
@@ -0,0 +1,68 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// schedule client
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package schedule
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "schedule" service client.
+type Client struct {
+	CreateEndpoint goa.Endpoint
+	GetEndpoint    goa.Endpoint
+	DeleteEndpoint goa.Endpoint
+	ListEndpoint   goa.Endpoint
+}
+
+// NewClient initializes a "schedule" service client given the endpoints.
+func NewClient(create, get, delete_, list goa.Endpoint) *Client {
+	return &Client{
+		CreateEndpoint: create,
+		GetEndpoint:    get,
+		DeleteEndpoint: delete_,
+		ListEndpoint:   list,
+	}
+}
+
+// Create calls the "Create" endpoint of the "schedule" service.
+func (c *Client) Create(ctx context.Context, p *CreateScheduleRequest) (res *Schedule, err error) {
+	var ires any
+	ires, err = c.CreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Schedule), nil
+}
+
+// Get calls the "Get" endpoint of the "schedule" service.
+func (c *Client) Get(ctx context.Context, p *ScheduleRequest) (res *Schedule, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Schedule), nil
+}
+
+// Delete calls the "Delete" endpoint of the "schedule" service.
+func (c *Client) Delete(ctx context.Context, p *ScheduleRequest) (err error) {
+	_, err = c.DeleteEndpoint(ctx, p)
+	return
+}
+
+// List calls the "List" endpoint of the "schedule" service.
+func (c *Client) List(ctx context.Context) (res *SchedulesResponse, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*SchedulesResponse), nil
+}
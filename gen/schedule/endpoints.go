@@ -0,0 +1,75 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// schedule endpoints
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package schedule
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "schedule" service endpoints.
+type Endpoints struct {
+	Create goa.Endpoint
+	Get    goa.Endpoint
+	Delete goa.Endpoint
+	List   goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "schedule" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Create: NewCreateEndpoint(s),
+		Get:    NewGetEndpoint(s),
+		Delete: NewDeleteEndpoint(s),
+		List:   NewListEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "schedule" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Create = m(e.Create)
+	e.Get = m(e.Get)
+	e.Delete = m(e.Delete)
+	e.List = m(e.List)
+}
+
+// NewCreateEndpoint returns an endpoint function that calls the method
+// "Create" of service "schedule".
+func NewCreateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateScheduleRequest)
+		return s.Create(ctx, p)
+	}
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "Get" of
+// service "schedule".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ScheduleRequest)
+		return s.Get(ctx, p)
+	}
+}
+
+// NewDeleteEndpoint returns an endpoint function that calls the method
+// "Delete" of service "schedule".
+func NewDeleteEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ScheduleRequest)
+		return nil, s.Delete(ctx, p)
+	}
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "List" of
+// service "schedule".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.List(ctx)
+	}
+}
@@ -0,0 +1,108 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// schedule service
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package schedule
+
+import (
+	"context"
+)
+
+// Schedule service provides endpoints to manage recurring creation of tasks
+// and taskLists.
+type Service interface {
+	// Create a schedule for periodic creation of a task or a taskList.
+	Create(context.Context, *CreateScheduleRequest) (res *Schedule, err error)
+	// Get a schedule by ID.
+	Get(context.Context, *ScheduleRequest) (res *Schedule, err error)
+	// Delete a schedule by ID.
+	Delete(context.Context, *ScheduleRequest) (err error)
+	// List all schedules.
+	List(context.Context) (res *SchedulesResponse, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "task"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "schedule"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"Create", "Get", "Delete", "List"}
+
+// CreateScheduleRequest is the payload type of the schedule service Create
+// method.
+type CreateScheduleRequest struct {
+	// Standard cron expression with five fields (minute, hour, day of month,
+	// month, day of week).
+	Cron string
+	// IANA timezone in which the cron expression is evaluated. Defaults to UTC.
+	Timezone *string
+	// Name of the task template to create tasks from.
+	TaskName *string
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any
+	// Cache key namespace.
+	CacheNamespace *string
+	// Cache key scope.
+	CacheScope *string
+}
+
+// Schedule is the result type of the schedule service Create method.
+type Schedule struct {
+	// Unique schedule identifier.
+	ID string
+	// Standard cron expression.
+	Cron string
+	// Timezone in which the cron expression is evaluated.
+	Timezone string
+	// Name of the task template to create tasks from.
+	TaskName *string
+	// Name of the taskList template to create taskLists from.
+	TaskListName *string
+	// Data contains JSON payload that will be used for task or taskList execution.
+	Data any
+	// Cache key namespace.
+	CacheNamespace *string
+	// Cache key scope.
+	CacheScope *string
+	// Time of the next run.
+	NextRunAt string
+	// Time of the last run.
+	LastRunAt *string
+	// Schedule creation time.
+	CreatedAt string
+	// Most recent runs of the schedule.
+	Runs []*ScheduleRun
+}
+
+// ScheduleRequest is the payload type of the schedule service Get method.
+type ScheduleRequest struct {
+	// Unique schedule identifier.
+	ScheduleID string
+}
+
+type ScheduleRun struct {
+	// Time for which the run was scheduled.
+	ScheduledAt string
+	// Unique identifier of the created task or taskList.
+	ID string
+}
+
+// SchedulesResponse is the result type of the schedule service List method.
+type SchedulesResponse struct {
+	// Array of schedules.
+	Schedules []*Schedule
+}
@@ -17,13 +17,19 @@ import (
 type Client struct {
 	CreateEndpoint     goa.Endpoint
 	TaskResultEndpoint goa.Endpoint
+	TaskStatusEndpoint goa.Endpoint
+	SearchEndpoint     goa.Endpoint
+	CancelEndpoint     goa.Endpoint
 }
 
 // NewClient initializes a "task" service client given the endpoints.
-func NewClient(create, taskResult goa.Endpoint) *Client {
+func NewClient(create, taskResult, taskStatus, search, cancel goa.Endpoint) *Client {
 	return &Client{
 		CreateEndpoint:     create,
 		TaskResultEndpoint: taskResult,
+		TaskStatusEndpoint: taskStatus,
+		SearchEndpoint:     search,
+		CancelEndpoint:     cancel,
 	}
 }
 
@@ -46,3 +52,29 @@ func (c *Client) TaskResult(ctx context.Context, p *TaskResultRequest) (res any,
 	}
 	return ires.(any), nil
 }
+
+// TaskStatus calls the "TaskStatus" endpoint of the "task" service.
+func (c *Client) TaskStatus(ctx context.Context, p *TaskStatusRequest) (res *TaskStatusResponse, err error) {
+	var ires any
+	ires, err = c.TaskStatusEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TaskStatusResponse), nil
+}
+
+// Search calls the "Search" endpoint of the "task" service.
+func (c *Client) Search(ctx context.Context, p *SearchTasksRequest) (res *SearchTasksResponse, err error) {
+	var ires any
+	ires, err = c.SearchEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SearchTasksResponse), nil
+}
+
+// Cancel calls the "Cancel" endpoint of the "task" service.
+func (c *Client) Cancel(ctx context.Context, p *CancelTaskRequest) (err error) {
+	_, err = c.CancelEndpoint(ctx, p)
+	return
+}
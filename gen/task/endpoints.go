@@ -17,6 +17,9 @@ import (
 type Endpoints struct {
 	Create     goa.Endpoint
 	TaskResult goa.Endpoint
+	TaskStatus goa.Endpoint
+	Search     goa.Endpoint
+	Cancel     goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "task" service with endpoints.
@@ -24,6 +27,9 @@ func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
 		Create:     NewCreateEndpoint(s),
 		TaskResult: NewTaskResultEndpoint(s),
+		TaskStatus: NewTaskStatusEndpoint(s),
+		Search:     NewSearchEndpoint(s),
+		Cancel:     NewCancelEndpoint(s),
 	}
 }
 
@@ -31,6 +37,9 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Create = m(e.Create)
 	e.TaskResult = m(e.TaskResult)
+	e.TaskStatus = m(e.TaskStatus)
+	e.Search = m(e.Search)
+	e.Cancel = m(e.Cancel)
 }
 
 // NewCreateEndpoint returns an endpoint function that calls the method
@@ -50,3 +59,30 @@ func NewTaskResultEndpoint(s Service) goa.Endpoint {
 		return s.TaskResult(ctx, p)
 	}
 }
+
+// NewTaskStatusEndpoint returns an endpoint function that calls the method
+// "TaskStatus" of service "task".
+func NewTaskStatusEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TaskStatusRequest)
+		return s.TaskStatus(ctx, p)
+	}
+}
+
+// NewSearchEndpoint returns an endpoint function that calls the method
+// "Search" of service "task".
+func NewSearchEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SearchTasksRequest)
+		return s.Search(ctx, p)
+	}
+}
+
+// NewCancelEndpoint returns an endpoint function that calls the method
+// "Cancel" of service "task".
+func NewCancelEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CancelTaskRequest)
+		return nil, s.Cancel(ctx, p)
+	}
+}
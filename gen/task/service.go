@@ -17,6 +17,14 @@ type Service interface {
 	Create(context.Context, *CreateTaskRequest) (res *CreateTaskResult, err error)
 	// TaskResult retrieves task result from the Cache service.
 	TaskResult(context.Context, *TaskResultRequest) (res any, err error)
+	// TaskStatus retrieves the current state of a task from the queue or the
+	// history of executed tasks.
+	TaskStatus(context.Context, *TaskStatusRequest) (res *TaskStatusResponse, err error)
+	// Search tasks in the queue, the history of executed tasks and the dead
+	// letters filtered by the given criteria.
+	Search(context.Context, *SearchTasksRequest) (res *SearchTasksResponse, err error)
+	// Cancel a task which is waiting in the queue or is currently being executed.
+	Cancel(context.Context, *CancelTaskRequest) (err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -33,7 +41,13 @@ const ServiceName = "task"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"Create", "TaskResult"}
+var MethodNames = [5]string{"Create", "TaskResult", "TaskStatus", "Search", "Cancel"}
+
+// CancelTaskRequest is the payload type of the task service Cancel method.
+type CancelTaskRequest struct {
+	// Unique task identifier.
+	TaskID string
+}
 
 // CreateTaskRequest is the payload type of the task service Create method.
 type CreateTaskRequest struct {
@@ -45,12 +59,68 @@ type CreateTaskRequest struct {
 	CacheNamespace *string
 	// Cache key scope.
 	CacheScope *string
+	// Version of the task template. The latest version is used if not set.
+	TemplateVersion *int
+	// Time at which the task should be executed. The task is executed immediately
+	// if not set.
+	RunAt *string
+	// Delay after which the task should be executed, e.g. 10m. Cannot be used
+	// together with runAt.
+	Delay *string
+	// Priority of the task from 0 (lowest) to 9 (highest). The priority of the
+	// task template is used if not set.
+	Priority *int
+	// Unique key of the request. Repeated requests with the same key return the
+	// originally created task.
+	IdempotencyKey *string
+	// Maximum time to wait for the task to complete, e.g. 5s. The response is
+	// returned right after the task is created if not set.
+	Wait *string
+	// URL which is notified when the task is finished. The callback URL of the
+	// task template is used if not set.
+	CallbackURL *string
 }
 
 // CreateTaskResult is the result type of the task service Create method.
 type CreateTaskResult struct {
 	// Unique task identifier.
 	TaskID string
+	// State of the task at the end of the wait time. It is set only if the request
+	// waited for the task.
+	State *string
+	// Result of the task. It is set only if the task completed within the wait
+	// time.
+	Result any
+}
+
+// SearchTasksRequest is the payload type of the task service Search method.
+type SearchTasksRequest struct {
+	// Filter tasks by name.
+	Name *string
+	// Filter tasks by state.
+	State *string
+	// Filter tasks by cache key namespace.
+	CacheNamespace *string
+	// Filter tasks by cache key scope.
+	CacheScope *string
+	// Filter tasks created at or after the given time.
+	CreatedAfter *string
+	// Filter tasks created before the given time.
+	CreatedBefore *string
+	// Sort order by creation time.
+	Sort string
+	// Maximum number of tasks to return.
+	Limit int
+	// Cursor for retrieving the next page of results.
+	Cursor *string
+}
+
+// SearchTasksResponse is the result type of the task service Search method.
+type SearchTasksResponse struct {
+	// Array of tasks.
+	Tasks []*TaskStatusResponse
+	// Cursor for retrieving the next page of results, if there are more.
+	Next *string
 }
 
 // TaskResultRequest is the payload type of the task service TaskResult method.
@@ -58,3 +128,45 @@ type TaskResultRequest struct {
 	// Unique task identifier.
 	TaskID string
 }
+
+// TaskStatusRequest is the payload type of the task service TaskStatus method.
+type TaskStatusRequest struct {
+	// Unique task identifier.
+	TaskID string
+}
+
+// TaskStatusResponse is the result type of the task service TaskStatus method.
+type TaskStatusResponse struct {
+	// Unique task identifier.
+	ID string
+	// Task name.
+	Name string
+	// Current state of the task.
+	State string
+	// Number of failed attempts to execute the task.
+	Retries int
+	// Task creation time.
+	CreatedAt string
+	// Task execution start time.
+	StartedAt *string
+	// Task completion time.
+	FinishedAt *string
+	// Response code received after the task request is executed.
+	ResponseCode *int
+	// Last error that occurred during task execution.
+	Error *string
+	// Cache key namespace.
+	CacheNamespace *string
+	// Cache key scope.
+	CacheScope *string
+	// Version of the task template from which the task was created.
+	TemplateVersion *int
+	// Time at which the task is scheduled for execution.
+	RunAt *string
+	// Priority of the task.
+	Priority *int
+	// Time of the next execution attempt of a failed task.
+	NextAttemptAt *string
+	// Reason of the last failed execution of the task.
+	FailureReason *string
+}
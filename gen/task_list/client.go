@@ -17,13 +17,15 @@ import (
 type Client struct {
 	CreateEndpoint         goa.Endpoint
 	TaskListStatusEndpoint goa.Endpoint
+	SearchEndpoint         goa.Endpoint
 }
 
 // NewClient initializes a "taskList" service client given the endpoints.
-func NewClient(create, taskListStatus goa.Endpoint) *Client {
+func NewClient(create, taskListStatus, search goa.Endpoint) *Client {
 	return &Client{
 		CreateEndpoint:         create,
 		TaskListStatusEndpoint: taskListStatus,
+		SearchEndpoint:         search,
 	}
 }
 
@@ -46,3 +48,13 @@ func (c *Client) TaskListStatus(ctx context.Context, p *TaskListStatusRequest) (
 	}
 	return ires.(*TaskListStatusResponse), nil
 }
+
+// Search calls the "Search" endpoint of the "taskList" service.
+func (c *Client) Search(ctx context.Context, p *SearchTaskListsRequest) (res *SearchTaskListsResponse, err error) {
+	var ires any
+	ires, err = c.SearchEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SearchTaskListsResponse), nil
+}
@@ -17,6 +17,7 @@ import (
 type Endpoints struct {
 	Create         goa.Endpoint
 	TaskListStatus goa.Endpoint
+	Search         goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "taskList" service with endpoints.
@@ -24,6 +25,7 @@ func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
 		Create:         NewCreateEndpoint(s),
 		TaskListStatus: NewTaskListStatusEndpoint(s),
+		Search:         NewSearchEndpoint(s),
 	}
 }
 
@@ -31,6 +33,7 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Create = m(e.Create)
 	e.TaskListStatus = m(e.TaskListStatus)
+	e.Search = m(e.Search)
 }
 
 // NewCreateEndpoint returns an endpoint function that calls the method
@@ -50,3 +53,12 @@ func NewTaskListStatusEndpoint(s Service) goa.Endpoint {
 		return s.TaskListStatus(ctx, p)
 	}
 }
+
+// NewSearchEndpoint returns an endpoint function that calls the method
+// "Search" of service "taskList".
+func NewSearchEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SearchTaskListsRequest)
+		return s.Search(ctx, p)
+	}
+}
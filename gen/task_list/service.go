@@ -19,6 +19,9 @@ type Service interface {
 	// TaskListStatus retrieves a taskList status containing all tasks' unique IDs
 	// and statuses from the Cache service.
 	TaskListStatus(context.Context, *TaskListStatusRequest) (res *TaskListStatusResponse, err error)
+	// Search taskLists in the queue and the history of executed taskLists filtered
+	// by the given criteria.
+	Search(context.Context, *SearchTaskListsRequest) (res *SearchTaskListsResponse, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -35,7 +38,7 @@ const ServiceName = "taskList"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"Create", "TaskListStatus"}
+var MethodNames = [3]string{"Create", "TaskListStatus", "Search"}
 
 // CreateTaskListRequest is the payload type of the taskList service Create
 // method.
@@ -48,6 +51,26 @@ type CreateTaskListRequest struct {
 	CacheNamespace *string
 	// Cache key scope.
 	CacheScope *string
+	// Version of the taskList template. The latest version is used if not set.
+	TemplateVersion *int
+	// Time at which the taskList should be executed. The taskList is executed
+	// immediately if not set.
+	RunAt *string
+	// Delay after which the taskList should be executed, e.g. 10m. Cannot be used
+	// together with runAt.
+	Delay *string
+	// Priority of the taskList from 0 (lowest) to 9 (highest). The priority of the
+	// taskList template is used if not set.
+	Priority *int
+	// Unique key of the request. Repeated requests with the same key return the
+	// originally created taskList.
+	IdempotencyKey *string
+	// Maximum time to wait for the taskList to complete, e.g. 5s. The response is
+	// returned right after the taskList is created if not set.
+	Wait *string
+	// URL which is notified when the taskList is finished. The callback URL of the
+	// taskList template is used if not set.
+	CallbackURL *string
 }
 
 // CreateTaskListResult is the result type of the taskList service Create
@@ -55,6 +78,12 @@ type CreateTaskListRequest struct {
 type CreateTaskListResult struct {
 	// Unique taskList identifier.
 	TaskListID string
+	// State of the taskList at the end of the wait time. It is set only if the
+	// request waited for the taskList.
+	State *string
+	// Result of the taskList. It is set only if the taskList completed within the
+	// wait time.
+	Result *TaskListStatusResponse
 }
 
 type GroupStatus struct {
@@ -66,6 +95,38 @@ type GroupStatus struct {
 	Tasks []*TaskStatus
 }
 
+// SearchTaskListsRequest is the payload type of the taskList service Search
+// method.
+type SearchTaskListsRequest struct {
+	// Filter taskLists by name.
+	Name *string
+	// Filter taskLists by state.
+	State *string
+	// Filter taskLists by cache key namespace.
+	CacheNamespace *string
+	// Filter taskLists by cache key scope.
+	CacheScope *string
+	// Filter taskLists created at or after the given time.
+	CreatedAfter *string
+	// Filter taskLists created before the given time.
+	CreatedBefore *string
+	// Sort order by creation time.
+	Sort string
+	// Maximum number of taskLists to return.
+	Limit int
+	// Cursor for retrieving the next page of results.
+	Cursor *string
+}
+
+// SearchTaskListsResponse is the result type of the taskList service Search
+// method.
+type SearchTaskListsResponse struct {
+	// Array of taskLists.
+	TaskLists []*TaskListSummary
+	// Cursor for retrieving the next page of results, if there are more.
+	Next *string
+}
+
 // TaskListStatusRequest is the payload type of the taskList service
 // TaskListStatus method.
 type TaskListStatusRequest struct {
@@ -82,6 +143,33 @@ type TaskListStatusResponse struct {
 	Status string
 	// Array of GroupStatus
 	Groups []*GroupStatus
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string
+}
+
+type TaskListSummary struct {
+	// Unique taskList identifier.
+	ID string
+	// TaskList name.
+	Name string
+	// Current state of the taskList.
+	State string
+	// Cache key namespace.
+	CacheNamespace *string
+	// Cache key scope.
+	CacheScope *string
+	// TaskList creation time.
+	CreatedAt string
+	// TaskList execution start time.
+	StartedAt *string
+	// TaskList completion time.
+	FinishedAt *string
+	// Version of the taskList template from which the taskList was created.
+	TemplateVersion *int
+	// Time at which the taskList is scheduled for execution.
+	RunAt *string
+	// Priority of the taskList.
+	Priority *int
 }
 
 type TaskStatus struct {
@@ -0,0 +1,81 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskListTemplate client
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package tasklisttemplate
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "taskListTemplate" service client.
+type Client struct {
+	CreateEndpoint goa.Endpoint
+	GetEndpoint    goa.Endpoint
+	UpdateEndpoint goa.Endpoint
+	DeleteEndpoint goa.Endpoint
+	ListEndpoint   goa.Endpoint
+}
+
+// NewClient initializes a "taskListTemplate" service client given the
+// endpoints.
+func NewClient(create, get, update, delete_, list goa.Endpoint) *Client {
+	return &Client{
+		CreateEndpoint: create,
+		GetEndpoint:    get,
+		UpdateEndpoint: update,
+		DeleteEndpoint: delete_,
+		ListEndpoint:   list,
+	}
+}
+
+// Create calls the "Create" endpoint of the "taskListTemplate" service.
+func (c *Client) Create(ctx context.Context, p *TaskListTemplate) (res *TaskListTemplate, err error) {
+	var ires any
+	ires, err = c.CreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TaskListTemplate), nil
+}
+
+// Get calls the "Get" endpoint of the "taskListTemplate" service.
+func (c *Client) Get(ctx context.Context, p *TaskListTemplateVersionRequest) (res *TaskListTemplate, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TaskListTemplate), nil
+}
+
+// Update calls the "Update" endpoint of the "taskListTemplate" service.
+func (c *Client) Update(ctx context.Context, p *TaskListTemplate) (res *TaskListTemplate, err error) {
+	var ires any
+	ires, err = c.UpdateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TaskListTemplate), nil
+}
+
+// Delete calls the "Delete" endpoint of the "taskListTemplate" service.
+func (c *Client) Delete(ctx context.Context, p *TaskListTemplateRequest) (err error) {
+	_, err = c.DeleteEndpoint(ctx, p)
+	return
+}
+
+// List calls the "List" endpoint of the "taskListTemplate" service.
+func (c *Client) List(ctx context.Context) (res *TaskListTemplatesResponse, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*TaskListTemplatesResponse), nil
+}
@@ -0,0 +1,89 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskListTemplate endpoints
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package tasklisttemplate
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "taskListTemplate" service endpoints.
+type Endpoints struct {
+	Create goa.Endpoint
+	Get    goa.Endpoint
+	Update goa.Endpoint
+	Delete goa.Endpoint
+	List   goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "taskListTemplate" service with
+// endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Create: NewCreateEndpoint(s),
+		Get:    NewGetEndpoint(s),
+		Update: NewUpdateEndpoint(s),
+		Delete: NewDeleteEndpoint(s),
+		List:   NewListEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "taskListTemplate" service
+// endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Create = m(e.Create)
+	e.Get = m(e.Get)
+	e.Update = m(e.Update)
+	e.Delete = m(e.Delete)
+	e.List = m(e.List)
+}
+
+// NewCreateEndpoint returns an endpoint function that calls the method
+// "Create" of service "taskListTemplate".
+func NewCreateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TaskListTemplate)
+		return s.Create(ctx, p)
+	}
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "Get" of
+// service "taskListTemplate".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TaskListTemplateVersionRequest)
+		return s.Get(ctx, p)
+	}
+}
+
+// NewUpdateEndpoint returns an endpoint function that calls the method
+// "Update" of service "taskListTemplate".
+func NewUpdateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TaskListTemplate)
+		return s.Update(ctx, p)
+	}
+}
+
+// NewDeleteEndpoint returns an endpoint function that calls the method
+// "Delete" of service "taskListTemplate".
+func NewDeleteEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TaskListTemplateRequest)
+		return nil, s.Delete(ctx, p)
+	}
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "List" of
+// service "taskListTemplate".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.List(ctx)
+	}
+}
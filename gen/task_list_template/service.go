@@ -0,0 +1,100 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskListTemplate service
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package tasklisttemplate
+
+import (
+	"context"
+)
+
+// TaskListTemplate service provides endpoints to manage taskList templates.
+type Service interface {
+	// Create a taskList template.
+	Create(context.Context, *TaskListTemplate) (res *TaskListTemplate, err error)
+	// Get a taskList template by name and optionally by version.
+	Get(context.Context, *TaskListTemplateVersionRequest) (res *TaskListTemplate, err error)
+	// Update an existing taskList template by creating a new version of it.
+	Update(context.Context, *TaskListTemplate) (res *TaskListTemplate, err error)
+	// Delete a taskList template by name. Its versions are kept for the taskLists
+	// created from them.
+	Delete(context.Context, *TaskListTemplateRequest) (err error)
+	// List all taskList templates.
+	List(context.Context) (res *TaskListTemplatesResponse, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "task"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "taskListTemplate"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [5]string{"Create", "Get", "Update", "Delete", "List"}
+
+type GroupTemplate struct {
+	// Execution mode of the tasks within the group.
+	Execution string
+	// Policy to be executed on the group result.
+	FinalPolicy *string
+	// Names of task templates executed in the group.
+	Tasks []string
+	// Deadline of the group execution, e.g. 5m. Tasks which are still running when
+	// it's exceeded are failed.
+	Timeout *string
+}
+
+// TaskListTemplate is the payload type of the taskListTemplate service Create
+// method.
+type TaskListTemplate struct {
+	// TaskList template name.
+	Name string
+	// Default cache key namespace.
+	CacheNamespace *string
+	// Default cache key scope.
+	CacheScope *string
+	// Groups of tasks executed sequentially one after another.
+	Groups []*GroupTemplate
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int
+	// Default priority of the taskLists from 0 (lowest) to 9 (highest).
+	Priority *int
+	// Default URL which is notified when the taskLists are finished.
+	CallbackURL *string
+	// Deadline of the taskList execution, e.g. 10m. Tasks which are still running
+	// when it's exceeded are failed.
+	Timeout *string
+}
+
+// TaskListTemplateRequest is the payload type of the taskListTemplate service
+// Delete method.
+type TaskListTemplateRequest struct {
+	// TaskList template name.
+	Name string
+}
+
+// TaskListTemplateVersionRequest is the payload type of the taskListTemplate
+// service Get method.
+type TaskListTemplateVersionRequest struct {
+	// TaskList template name.
+	Name string
+	// TaskList template version. The latest version is returned if not set.
+	Version *int
+}
+
+// TaskListTemplatesResponse is the result type of the taskListTemplate service
+// List method.
+type TaskListTemplatesResponse struct {
+	// Array of taskList templates.
+	Templates []*TaskListTemplate
+}
@@ -0,0 +1,87 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskTemplate endpoints
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package tasktemplate
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "taskTemplate" service endpoints.
+type Endpoints struct {
+	Create goa.Endpoint
+	Get    goa.Endpoint
+	Update goa.Endpoint
+	Delete goa.Endpoint
+	List   goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "taskTemplate" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Create: NewCreateEndpoint(s),
+		Get:    NewGetEndpoint(s),
+		Update: NewUpdateEndpoint(s),
+		Delete: NewDeleteEndpoint(s),
+		List:   NewListEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "taskTemplate" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Create = m(e.Create)
+	e.Get = m(e.Get)
+	e.Update = m(e.Update)
+	e.Delete = m(e.Delete)
+	e.List = m(e.List)
+}
+
+// NewCreateEndpoint returns an endpoint function that calls the method
+// "Create" of service "taskTemplate".
+func NewCreateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TaskTemplate)
+		return s.Create(ctx, p)
+	}
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "Get" of
+// service "taskTemplate".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TaskTemplateVersionRequest)
+		return s.Get(ctx, p)
+	}
+}
+
+// NewUpdateEndpoint returns an endpoint function that calls the method
+// "Update" of service "taskTemplate".
+func NewUpdateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TaskTemplate)
+		return s.Update(ctx, p)
+	}
+}
+
+// NewDeleteEndpoint returns an endpoint function that calls the method
+// "Delete" of service "taskTemplate".
+func NewDeleteEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TaskTemplateRequest)
+		return nil, s.Delete(ctx, p)
+	}
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "List" of
+// service "taskTemplate".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.List(ctx)
+	}
+}
@@ -0,0 +1,114 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// taskTemplate service
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package tasktemplate
+
+import (
+	"context"
+)
+
+// TaskTemplate service provides endpoints to manage task templates.
+type Service interface {
+	// Create a task template.
+	Create(context.Context, *TaskTemplate) (res *TaskTemplate, err error)
+	// Get a task template by name and optionally by version.
+	Get(context.Context, *TaskTemplateVersionRequest) (res *TaskTemplate, err error)
+	// Update an existing task template by creating a new version of it.
+	Update(context.Context, *TaskTemplate) (res *TaskTemplate, err error)
+	// Delete a task template by name. Its versions are kept for the taskList
+	// templates using them.
+	Delete(context.Context, *TaskTemplateRequest) (err error)
+	// List all task templates.
+	List(context.Context) (res *TaskTemplatesResponse, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "task"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "taskTemplate"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [5]string{"Create", "Get", "Update", "Delete", "List"}
+
+type RetryPolicy struct {
+	// Maximum number of task executions before the task is moved to the dead
+	// letters.
+	MaxAttempts *int
+	// Delay before the first retry, which is doubled on each following retry.
+	BaseDelay *string
+	// Maximum delay between retries.
+	MaxDelay *string
+	// Reasons of the failures which are retried. All failures are retried if not
+	// set.
+	RetryOn []string
+}
+
+// TaskTemplate is the payload type of the taskTemplate service Create method.
+type TaskTemplate struct {
+	// Task template name.
+	Name string
+	// URL against which the task request will be executed.
+	URL *string
+	// HTTP method of the task request.
+	Method *string
+	// Policy to be executed instead of the task request.
+	RequestPolicy *string
+	// Policy to be executed on the task response.
+	ResponsePolicy *string
+	// Policy to be executed on the task response after the response policy.
+	FinalPolicy *string
+	// Default cache key namespace.
+	CacheNamespace *string
+	// Default cache key scope.
+	CacheScope *string
+	// Template version. It is assigned by the service and is ignored in requests.
+	Version *int
+	// Default priority of the tasks from 0 (lowest) to 9 (highest).
+	Priority *int
+	// Default URL which is notified when the tasks are finished.
+	CallbackURL *string
+	// Retry policy of the tasks. The default retry policy of the service is used
+	// if not set.
+	RetryPolicy *RetryPolicy
+	// Status codes (e.g. 404) and classes of status codes (e.g. 2xx) of successful
+	// responses to the task request. Only 2xx status codes are accepted if not set.
+	AcceptedStatusCodes []string
+	// Maximum duration of a task execution, e.g. 2m. The default task timeout of
+	// the service is used if not set.
+	Timeout *string
+}
+
+// TaskTemplateRequest is the payload type of the taskTemplate service Delete
+// method.
+type TaskTemplateRequest struct {
+	// Task template name.
+	Name string
+}
+
+// TaskTemplateVersionRequest is the payload type of the taskTemplate service
+// Get method.
+type TaskTemplateVersionRequest struct {
+	// Task template name.
+	Name string
+	// Task template version. The latest version is returned if not set.
+	Version *int
+}
+
+// TaskTemplatesResponse is the result type of the taskTemplate service List
+// method.
+type TaskTemplatesResponse struct {
+	// Array of task templates.
+	Templates []*TaskTemplate
+}
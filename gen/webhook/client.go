@@ -0,0 +1,36 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// webhook client
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package webhook
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "webhook" service client.
+type Client struct {
+	DeliveriesEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "webhook" service client given the endpoints.
+func NewClient(deliveries goa.Endpoint) *Client {
+	return &Client{
+		DeliveriesEndpoint: deliveries,
+	}
+}
+
+// Deliveries calls the "Deliveries" endpoint of the "webhook" service.
+func (c *Client) Deliveries(ctx context.Context, p *WebhookDeliveriesRequest) (res *WebhookDeliveriesResponse, err error) {
+	var ires any
+	ires, err = c.DeliveriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookDeliveriesResponse), nil
+}
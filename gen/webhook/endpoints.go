@@ -0,0 +1,40 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// webhook endpoints
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package webhook
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "webhook" service endpoints.
+type Endpoints struct {
+	Deliveries goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "webhook" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Deliveries: NewDeliveriesEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "webhook" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Deliveries = m(e.Deliveries)
+}
+
+// NewDeliveriesEndpoint returns an endpoint function that calls the method
+// "Deliveries" of service "webhook".
+func NewDeliveriesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*WebhookDeliveriesRequest)
+		return s.Deliveries(ctx, p)
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by goa v3.20.1, DO NOT EDIT.
+//
+// webhook service
+//
+// Command:
+// $ goa gen github.com/eclipse-xfsc/task-sheduler/design
+
+package webhook
+
+import (
+	"context"
+)
+
+// Webhook service provides endpoints to inspect the delivery of task and
+// taskList completion webhooks.
+type Service interface {
+	// List the webhook deliveries and their attempts for a task or a taskList.
+	Deliveries(context.Context, *WebhookDeliveriesRequest) (res *WebhookDeliveriesResponse, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "task"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "webhook"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [1]string{"Deliveries"}
+
+type WebhookAttempt struct {
+	// Time of the delivery attempt.
+	StartedAt string
+	// Status code of the response, if one was received.
+	StatusCode *int
+	// Error of a failed delivery attempt.
+	Error *string
+}
+
+// WebhookDeliveriesRequest is the payload type of the webhook service
+// Deliveries method.
+type WebhookDeliveriesRequest struct {
+	// Unique identifier of a task or a taskList.
+	ID string
+}
+
+// WebhookDeliveriesResponse is the result type of the webhook service
+// Deliveries method.
+type WebhookDeliveriesResponse struct {
+	// Array of webhook deliveries.
+	Deliveries []*WebhookDelivery
+}
+
+type WebhookDelivery struct {
+	// Unique webhook delivery identifier.
+	ID string
+	// Callback URL to which the webhook is delivered.
+	URL string
+	// State of the webhook delivery.
+	State string
+	// Delivery attempts.
+	Attempts []*WebhookAttempt
+	// Time of the next delivery attempt of a pending webhook.
+	NextAttemptAt *string
+	// Webhook delivery creation time.
+	CreatedAt string
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/eclipse-xfsc/task-sheduler/internal/service"
 )
 
+type token struct{}
+
 // Policy client.
 type Policy interface {
 	Evaluate(ctx context.Context, policy string, data []byte) ([]byte, error)
@@ -23,13 +25,28 @@ type Cache interface {
 }
 
 type Executor struct {
-	queue          service.Queue
-	policy         Policy
-	storage        service.Storage
-	cache          Cache
-	workers        int
-	pollInterval   time.Duration
-	maxTaskRetries int
+	queue        service.Queue
+	policy       Policy
+	storage      service.Storage
+	cache        Cache
+	workers      int
+	pollInterval time.Duration
+
+	// retryPolicy is the default retry policy of the tasks,
+	// which can be overridden by task templates.
+	retryPolicy service.RetryPolicy
+
+	// taskTimeout is the default timeout of a task execution,
+	// which can be overridden by task templates.
+	taskTimeout time.Duration
+
+	// owner identifies the instance of the service in the leases of the
+	// polled tasks, which are renewed by the workers for lease duration.
+	owner string
+	lease time.Duration
+
+	// watcher if set, wakes up the executor when tasks are added to the queue.
+	watcher service.QueueWatcher
 
 	httpClient *http.Client
 	logger     *zap.Logger
@@ -42,51 +59,92 @@ func New(
 	cache Cache,
 	workers int,
 	pollInterval time.Duration,
-	maxTaskRetries int,
+	retryPolicy service.RetryPolicy,
+	taskTimeout time.Duration,
+	owner string,
+	lease time.Duration,
+	watcher service.QueueWatcher,
 	httpClient *http.Client,
 	logger *zap.Logger,
 ) *Executor {
 	return &Executor{
-		queue:          queue,
-		policy:         policy,
-		storage:        storage,
-		cache:          cache,
-		workers:        workers,
-		pollInterval:   pollInterval,
-		maxTaskRetries: maxTaskRetries,
-		httpClient:     httpClient,
-		logger:         logger,
+		queue:        queue,
+		policy:       policy,
+		storage:      storage,
+		cache:        cache,
+		workers:      workers,
+		pollInterval: pollInterval,
+		retryPolicy:  retryPolicy,
+		taskTimeout:  taskTimeout,
+		owner:        owner,
+		lease:        lease,
+		watcher:      watcher,
+		httpClient:   httpClient,
+		logger:       logger,
 	}
 }
 
+// Start polls the queue for as many tasks as there are idle workers and
+// gives the tasks to the workers for execution. As long as tasks are found,
+// the queue is polled again as soon as a worker becomes idle, and only when
+// the queue is empty the executor waits for the poll interval, or until the
+// queue watcher notifies that tasks were added to the queue.
 func (e *Executor) Start(ctx context.Context) error {
 	defer e.logger.Info("task executor stopped")
 
+	// Every idle worker puts a token in the idle channel. The tasks channel
+	// can hold a task for each worker, so sending polled tasks never blocks.
+	tasks := make(chan *service.Task, e.workers)
+	idle := make(chan token, e.workers)
+
 	var wg sync.WaitGroup
-	tasks := make(chan *service.Task)
 	for i := 0; i < e.workers; i++ {
 		wg.Add(1)
+		idle <- token{}
 		go func() {
 			defer wg.Done()
-			worker := newWorker(tasks, e.queue, e.policy, e.storage, e.cache, e.maxTaskRetries, e.httpClient, e.logger)
+			worker := newWorker(tasks, idle, e.queue, e.policy, e.storage, e.cache, e.retryPolicy, e.taskTimeout, e.lease, e.pollInterval, e.httpClient, e.logger)
 			worker.Start(ctx)
 		}()
 	}
 
+	wakeup := e.watch(ctx)
+
 loop:
 	for {
+		// wait for at least one idle worker and take the tokens of the others
 		select {
 		case <-ctx.Done():
 			break loop
-		case <-time.After(e.pollInterval):
-			t, err := e.queue.Poll(ctx)
-			if err != nil {
-				if !errors.Is(errors.NotFound, err) {
-					e.logger.Error("error getting task from queue", zap.Error(err))
+		case <-idle:
+		}
+		n := 1 + drain(idle)
+
+		polled, err := e.queue.PollBatch(ctx, e.owner, e.lease, n)
+		if err != nil && !errors.Is(errors.NotFound, err) {
+			e.logger.Error("error getting tasks from queue", zap.Error(err))
+		}
+
+		for _, t := range polled {
+			tasks <- t // send task to the workers for execution
+		}
+
+		// return the tokens of the workers which didn't get a task
+		for i := len(polled); i < n; i++ {
+			idle <- token{}
+		}
+
+		if len(polled) == 0 {
+			select {
+			case <-ctx.Done():
+				break loop
+			case <-time.After(e.pollInterval):
+			case _, ok := <-wakeup:
+				if !ok {
+					e.logger.Warn("task queue watch stopped, falling back to polling")
+					wakeup = nil
 				}
-				continue
 			}
-			tasks <- t // send task to the workers for execution
 		}
 	}
 
@@ -94,3 +152,32 @@ loop:
 
 	return ctx.Err()
 }
+
+// watch returns the notifications of the queue watcher, or nil if the
+// watcher is not set or the queue can't be watched, so that the executor
+// only polls the queue.
+func (e *Executor) watch(ctx context.Context) <-chan struct{} {
+	if e.watcher == nil {
+		return nil
+	}
+
+	wakeup, err := e.watcher.WatchTasks(ctx)
+	if err != nil {
+		e.logger.Warn("task queue cannot be watched, falling back to polling", zap.Error(err))
+		return nil
+	}
+
+	return wakeup
+}
+
+// drain takes all tokens which are available in the channel
+// without waiting and returns their number.
+func drain(c chan token) int {
+	for n := 0; ; n++ {
+		select {
+		case <-c:
+		default:
+			return n
+		}
+	}
+}
@@ -13,36 +13,52 @@ import (
 	"github.com/eclipse-xfsc/task-sheduler/internal/service"
 )
 
+// errTaskCancelled is the cause of execution context cancellation
+// when the task is cancelled by a client.
+var errTaskCancelled = errors.New("task is cancelled")
+
 type Worker struct {
-	tasks          chan *service.Task
-	queue          service.Queue
-	policy         Policy
-	storage        service.Storage
-	cache          Cache
-	maxTaskRetries int
-	httpClient     *http.Client
-	logger         *zap.Logger
+	tasks               chan *service.Task
+	idle                chan token
+	queue               service.Queue
+	policy              Policy
+	storage             service.Storage
+	cache               Cache
+	retryPolicy         service.RetryPolicy
+	taskTimeout         time.Duration
+	lease               time.Duration
+	cancelCheckInterval time.Duration
+	httpClient          *http.Client
+	logger              *zap.Logger
 }
 
 func newWorker(
 	tasks chan *service.Task,
+	idle chan token,
 	queue service.Queue,
 	policy Policy,
 	storage service.Storage,
 	cache Cache,
-	maxTaskRetries int,
+	retryPolicy service.RetryPolicy,
+	taskTimeout time.Duration,
+	lease time.Duration,
+	cancelCheckInterval time.Duration,
 	httpClient *http.Client,
 	logger *zap.Logger,
 ) *Worker {
 	return &Worker{
-		tasks:          tasks,
-		queue:          queue,
-		policy:         policy,
-		storage:        storage,
-		cache:          cache,
-		maxTaskRetries: maxTaskRetries,
-		httpClient:     httpClient,
-		logger:         logger,
+		tasks:               tasks,
+		idle:                idle,
+		queue:               queue,
+		policy:              policy,
+		storage:             storage,
+		cache:               cache,
+		retryPolicy:         retryPolicy,
+		taskTimeout:         taskTimeout,
+		lease:               lease,
+		cancelCheckInterval: cancelCheckInterval,
+		httpClient:          httpClient,
+		logger:              logger,
 	}
 }
 
@@ -54,59 +70,241 @@ func (w *Worker) Start(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case t := <-w.tasks:
-			logger := w.logger.With(
-				zap.String("taskID", t.ID),
-				zap.String("taskName", t.Name),
-			)
-
-			if t.Retries >= w.maxTaskRetries {
-				if err := w.queue.Ack(ctx, t); err != nil {
-					logger.Error("failed to ack task in queue", zap.Error(err))
-				} else {
-					logger.Error("task removed from queue due to too many failed executions")
-				}
-				continue
-			}
+			w.handle(ctx, t)
+			w.idle <- token{} // the worker is ready for the next task
+		}
+	}
+}
+
+// handle executes a task and removes it from the queue when the execution
+// is completed, or returns the task to the queue if the execution failed.
+func (w *Worker) handle(ctx context.Context, t *service.Task) {
+	logger := w.logger.With(
+		zap.String("taskID", t.ID),
+		zap.String("taskName", t.Name),
+	)
+
+	if t.Retries >= t.RetryPolicy.WithDefaults(w.retryPolicy).MaxAttempts {
+		w.deadLetter(ctx, t, logger)
+		return
+	}
+
+	execCtx, cancel := context.WithCancelCause(ctx)
+	go w.watchCancellation(execCtx, t.ID, cancel)
+	go w.heartbeat(execCtx, t, logger)
+	timeoutCtx, cancelTimeout := withTimeout(execCtx, t.ExecutionTimeout(w.taskTimeout))
+	executed, err := w.Execute(timeoutCtx, t)
+	cancelled := context.Cause(execCtx) == errTaskCancelled
+	timedOut := timeoutCtx.Err() == context.DeadlineExceeded
+	cancelTimeout()
+	cancel(nil)
+	if err != nil {
+		if cancelled {
+			w.cancelled(ctx, t, logger)
+			return
+		}
+		if timedOut {
+			err = service.TimeoutError(err)
+		}
+		logger.Error("error executing task", zap.Error(err))
+		w.failed(ctx, t, err, logger)
+		return
+	}
+	logger.Debug("task execution completed successfully")
+
+	if err := w.cache.Set(
+		ctx,
+		executed.ID,
+		executed.CacheNamespace,
+		executed.CacheScope,
+		executed.Response,
+	); err != nil {
+		logger.Error("error storing task result in cache", zap.Error(err))
+		w.failed(ctx, t, &service.ExecutionError{Reason: service.ReasonCache, Err: err}, logger)
+		return
+	}
+	logger.Debug("task results are stored in cache")
+
+	if err := w.storage.SaveTaskHistory(ctx, executed); err != nil {
+		logger.Error("error saving task history", zap.Error(err))
+		return
+	}
+	logger.Debug("task history is saved")
+
+	// remove task from queue
+	if err := w.queue.Ack(ctx, executed); err != nil {
+		logger.Error("failed to ack task in queue", zap.Error(err))
+		return
+	}
 
-			executed, err := w.Execute(ctx, t)
+	w.notify(ctx, executed, logger)
+}
+
+// failed returns a task which execution failed to the queue, so that it's
+// retried after a backoff delay, or later if the failed response asked for it.
+// If the failure is permanent or not retryable according to the retry policy
+// of the task, or the task has failed too many times, the task is moved to
+// the dead letters instead.
+func (w *Worker) failed(ctx context.Context, t *service.Task, err error, logger *zap.Logger) {
+	policy := t.RetryPolicy.WithDefaults(w.retryPolicy)
+
+	var reason string
+	var permanent bool
+	var retryAfter time.Duration
+	if e, ok := err.(*service.ExecutionError); ok {
+		reason, permanent, retryAfter = e.Reason, e.Permanent, e.RetryAfter
+	}
+
+	t.Error = err.Error()
+	t.FailureReason = reason
+	if permanent || !policy.Retryable(reason) || t.Retries+1 >= policy.MaxAttempts {
+		t.Retries++
+		t.Errors = service.AppendError(t.Errors, t.Error)
+		w.deadLetter(ctx, t, logger)
+		return
+	}
+
+	delay := policy.Backoff(t.Retries + 1)
+	if retryAfter > delay {
+		delay = retryAfter
+	}
+	t.NextAttemptAt = time.Now().Add(delay)
+	if w.isCancelled(ctx, t) {
+		w.cancelled(ctx, t, logger)
+		return
+	}
+	if err := w.queue.Unack(ctx, t); err != nil {
+		logger.Error("failed to unack task in queue", zap.Error(err))
+	}
+}
+
+// deadLetter moves a task which failed too many times from the queue to
+// the dead letters, where it can be inspected and requeued by a client.
+func (w *Worker) deadLetter(ctx context.Context, t *service.Task, logger *zap.Logger) {
+	if w.isCancelled(ctx, t) {
+		w.cancelled(ctx, t, logger)
+		return
+	}
+
+	t.State = service.Failed
+	t.FinishedAt = time.Now()
+
+	letter := &service.DeadLetter{ID: t.ID, Task: t, DeadLetteredAt: t.FinishedAt}
+	if err := w.storage.AddDeadLetter(ctx, letter); err != nil {
+		logger.Error("error adding task to dead letters", zap.Error(err))
+		// return the task to the queue, so that it's dead-lettered on the next poll
+		if err := w.queue.Unack(ctx, t); err != nil {
+			logger.Error("failed to unack task in queue", zap.Error(err))
+		}
+		return
+	}
+
+	if err := w.queue.Ack(ctx, t); err != nil {
+		logger.Error("failed to ack task in queue", zap.Error(err))
+		return
+	}
+	logger.Error("task moved to dead letters due to too many failed executions")
+
+	w.notify(ctx, t, logger)
+}
+
+// notify saves a webhook delivery for a finished task which has a callback URL.
+// The webhook is delivered asynchronously by the webhook dispatcher.
+func (w *Worker) notify(ctx context.Context, t *service.Task, logger *zap.Logger) {
+	if t.CallbackURL == "" {
+		return
+	}
+
+	delivery, err := service.NewWebhookDelivery(t.CallbackURL, service.TaskNotification(t))
+	if err != nil {
+		logger.Error("error creating webhook delivery", zap.Error(err))
+		return
+	}
+
+	if err := w.storage.AddWebhookDelivery(ctx, delivery); err != nil {
+		logger.Error("error adding webhook delivery", zap.Error(err))
+	}
+}
+
+// watchCancellation periodically checks the state of the task in storage
+// and cancels the execution context if the task has been cancelled.
+func (w *Worker) watchCancellation(ctx context.Context, taskID string, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(w.cancelCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t, err := w.storage.Task(ctx, taskID)
 			if err != nil {
-				logger.Error("error executing task", zap.Error(err))
-				if err := w.queue.Unack(ctx, t); err != nil {
-					logger.Error("failed to unack task in queue", zap.Error(err))
-				}
 				continue
 			}
-			logger.Debug("task execution completed successfully")
-
-			if err := w.cache.Set(
-				ctx,
-				executed.ID,
-				executed.CacheNamespace,
-				executed.CacheScope,
-				executed.Response,
-			); err != nil {
-				logger.Error("error storing task result in cache", zap.Error(err))
-				if err := w.queue.Unack(ctx, t); err != nil {
-					logger.Error("failed to unack task in queue", zap.Error(err))
-				}
-				continue
+			if t.State == service.Cancelled {
+				cancel(errTaskCancelled)
+				return
 			}
-			logger.Debug("task results are stored in cache")
+		}
+	}
+}
 
-			if err := w.storage.SaveTaskHistory(ctx, executed); err != nil {
-				logger.Error("error saving task history", zap.Error(err))
-				continue
-			}
-			logger.Debug("task history is saved")
+// isCancelled reports whether the task has been cancelled by a client
+// after it was polled from the queue. A cancelled task must not be
+// returned to the queue, because it won't be polled again.
+func (w *Worker) isCancelled(ctx context.Context, t *service.Task) bool {
+	stored, err := w.storage.Task(ctx, t.ID)
+	if err != nil {
+		return false
+	}
+	return stored.State == service.Cancelled
+}
+
+// heartbeat periodically extends the lease of the task during its execution,
+// so that the task isn't returned to the queue while it's being executed.
+func (w *Worker) heartbeat(ctx context.Context, t *service.Task, logger *zap.Logger) {
+	ticker := time.NewTicker(service.HeartbeatInterval(w.lease))
+	defer ticker.Stop()
 
-			// remove task from queue
-			if err := w.queue.Ack(ctx, executed); err != nil {
-				logger.Error("failed to ack task in queue", zap.Error(err))
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.queue.Heartbeat(ctx, t, w.lease); err != nil && ctx.Err() == nil {
+				logger.Warn("error extending task lease", zap.Error(err))
 			}
 		}
 	}
 }
 
+// withTimeout returns a copy of the context which is cancelled when the
+// timeout elapses, or a cancellable copy of the context if timeout is zero.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// cancelled saves a task which was cancelled during its execution
+// in the history collection and removes it from the queue.
+func (w *Worker) cancelled(ctx context.Context, t *service.Task, logger *zap.Logger) {
+	t.State = service.Cancelled
+	t.FinishedAt = time.Now()
+
+	if err := w.storage.SaveTaskHistory(ctx, t); err != nil {
+		logger.Error("error saving task history", zap.Error(err))
+		return
+	}
+
+	if err := w.queue.Ack(ctx, t); err != nil {
+		logger.Error("failed to ack task in queue", zap.Error(err))
+		return
+	}
+	logger.Debug("task execution is cancelled")
+}
+
 func (w *Worker) Execute(ctx context.Context, task *service.Task) (*service.Task, error) {
 	task.StartedAt = time.Now()
 
@@ -117,14 +315,20 @@ func (w *Worker) Execute(ctx context.Context, task *service.Task) (*service.Task
 	if task.RequestPolicy != "" {
 		response, err = w.policy.Evaluate(ctx, task.RequestPolicy, task.Request)
 		if err != nil {
-			return nil, errors.New("error evaluating request policy", err)
+			return nil, &service.ExecutionError{Reason: service.ReasonPolicy, Err: errors.New("error evaluating request policy", err)}
 		}
 		task.ResponseCode = http.StatusOK
 	} else if task.URL != "" && task.Method != "" {
 		var status int
 		status, response, err = w.doHTTPTask(ctx, task)
 		if err != nil {
-			return nil, err
+			// keep the response of a failed request for diagnosis
+			task.ResponseCode = status
+			task.Response = response
+			if e, ok := err.(*service.ExecutionError); ok {
+				return nil, e
+			}
+			return nil, &service.ExecutionError{Reason: service.ReasonHTTP, Err: err}
 		}
 		task.ResponseCode = status
 	} else {
@@ -137,7 +341,7 @@ func (w *Worker) Execute(ctx context.Context, task *service.Task) (*service.Task
 	if task.ResponsePolicy != "" {
 		resp, err := w.policy.Evaluate(ctx, task.ResponsePolicy, task.Response)
 		if err != nil {
-			return nil, errors.New("error evaluating response policy", err)
+			return nil, &service.ExecutionError{Reason: service.ReasonPolicy, Err: errors.New("error evaluating response policy", err)}
 		}
 		// overwrite task response with the one returned from the policy
 		task.Response = resp
@@ -147,7 +351,7 @@ func (w *Worker) Execute(ctx context.Context, task *service.Task) (*service.Task
 	if task.FinalPolicy != "" {
 		resp, err := w.policy.Evaluate(ctx, task.FinalPolicy, task.Response)
 		if err != nil {
-			return nil, errors.New("error evaluating final policy", err)
+			return nil, &service.ExecutionError{Reason: service.ReasonPolicy, Err: errors.New("error evaluating final policy", err)}
 		}
 		// overwrite task response with the one returned from the policy
 		task.Response = resp
@@ -175,5 +379,12 @@ func (w *Worker) doHTTPTask(ctx context.Context, task *service.Task) (status int
 		return 0, nil, errors.New("error reading response body", err)
 	}
 
+	if !service.StatusAccepted(task.AcceptedStatusCodes, resp.StatusCode) {
+		if len(response) > service.MaxFailedResponseSize {
+			response = response[:service.MaxFailedResponseSize]
+		}
+		return resp.StatusCode, response, service.StatusError(resp.StatusCode, resp.Header)
+	}
+
 	return resp.StatusCode, response, nil
 }
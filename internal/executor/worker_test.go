@@ -0,0 +1,196 @@
+package executor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/memory"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// newTestWorker returns a worker which uses the storage as queue.
+func newTestWorker(storage *memory.Storage, retryPolicy service.RetryPolicy, taskTimeout time.Duration) *Worker {
+	return newWorker(nil, nil, storage, nil, storage, nil, retryPolicy, taskTimeout, time.Minute, time.Second, http.DefaultClient, zap.NewNop())
+}
+
+// claim adds the task to the storage and claims it as the worker would poll it.
+func claim(t *testing.T, storage *memory.Storage, task *service.Task) *service.Task {
+	ctx := context.Background()
+	require.NoError(t, storage.Add(ctx, task))
+	claimed, err := storage.ClaimTask(ctx, task.ID, "owner", time.Minute)
+	require.NoError(t, err)
+	return claimed
+}
+
+func TestWorker_Failed(t *testing.T) {
+	defaults := service.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+
+	tests := []struct {
+		name        string
+		status      int
+		retries     int
+		retryPolicy *service.RetryPolicy
+
+		deadLettered bool
+		executed     bool
+	}{
+		{
+			name:     "first failure is retried",
+			status:   http.StatusServiceUnavailable,
+			executed: true,
+		},
+		{
+			name:     "failure before the last attempt is retried",
+			status:   http.StatusServiceUnavailable,
+			retries:  1,
+			executed: true,
+		},
+		{
+			name:         "failure of the last attempt moves the task to dead letters",
+			status:       http.StatusServiceUnavailable,
+			retries:      2,
+			deadLettered: true,
+			executed:     true,
+		},
+		{
+			name:         "maxAttempts of the task overrides the default",
+			status:       http.StatusServiceUnavailable,
+			retryPolicy:  &service.RetryPolicy{MaxAttempts: 1},
+			deadLettered: true,
+			executed:     true,
+		},
+		{
+			name:         "permanent failure moves the task to dead letters",
+			status:       http.StatusBadRequest,
+			deadLettered: true,
+			executed:     true,
+		},
+		{
+			name:         "failure which is not in retryOn moves the task to dead letters",
+			status:       http.StatusServiceUnavailable,
+			retryPolicy:  &service.RetryPolicy{RetryOn: []string{service.ReasonTimeout}},
+			deadLettered: true,
+			executed:     true,
+		},
+		{
+			name:         "task without remaining attempts is moved to dead letters without execution",
+			status:       http.StatusServiceUnavailable,
+			retries:      3,
+			deadLettered: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(test.status)
+			}))
+			defer srv.Close()
+
+			ctx := context.Background()
+			storage := memory.New(time.Minute)
+			task := claim(t, storage, &service.Task{
+				ID:          "123",
+				Name:        "task",
+				State:       service.Created,
+				URL:         srv.URL,
+				Method:      http.MethodGet,
+				Retries:     test.retries,
+				RetryPolicy: test.retryPolicy,
+				CreatedAt:   time.Now(),
+			})
+
+			newTestWorker(storage, defaults, 0).handle(ctx, task)
+			assert.Equal(t, test.executed, requests.Load() == 1)
+
+			if test.deadLettered {
+				letter, err := storage.DeadLetter(ctx, "123")
+				require.NoError(t, err)
+				assert.Equal(t, service.State(service.Failed), letter.Task.State)
+				_, err = storage.Task(ctx, "123")
+				assert.Error(t, err)
+				return
+			}
+
+			// the task is returned to the queue for its next attempt
+			requeued, err := storage.Task(ctx, "123")
+			require.NoError(t, err)
+			assert.Equal(t, service.State(service.Created), requeued.State)
+			assert.Equal(t, test.retries+1, requeued.Retries)
+			assert.Contains(t, requeued.Error, "503")
+			assert.Equal(t, service.ReasonStatus, requeued.FailureReason)
+			assert.True(t, requeued.NextAttemptAt.After(time.Now()))
+			_, err = storage.DeadLetter(ctx, "123")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestWorker_Timeout(t *testing.T) {
+	// the server responds after the timeouts or when the request is cancelled
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name           string
+		taskTimeout    time.Duration
+		defaultTimeout time.Duration
+	}{
+		{
+			name:        "timeout of the task",
+			taskTimeout: 50 * time.Millisecond,
+		},
+		{
+			name:           "default timeout of the executor",
+			defaultTimeout: 50 * time.Millisecond,
+		},
+		{
+			name:           "timeout of the task overrides the default",
+			taskTimeout:    50 * time.Millisecond,
+			defaultTimeout: 10 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			storage := memory.New(time.Minute)
+			task := claim(t, storage, &service.Task{
+				ID:        "123",
+				Name:      "task",
+				State:     service.Created,
+				URL:       srv.URL,
+				Method:    http.MethodGet,
+				Timeout:   test.taskTimeout,
+				CreatedAt: time.Now(),
+			})
+
+			start := time.Now()
+			newTestWorker(storage, service.RetryPolicy{MaxAttempts: 3}, test.defaultTimeout).handle(ctx, task)
+			assert.Less(t, time.Since(start), 5*time.Second)
+
+			// the timed out task is retried with the timeout as failure reason
+			requeued, err := storage.Task(ctx, "123")
+			require.NoError(t, err)
+			assert.Equal(t, service.State(service.Created), requeued.State)
+			assert.Equal(t, 1, requeued.Retries)
+			assert.Equal(t, service.ReasonTimeout, requeued.FailureReason)
+			assert.Contains(t, requeued.Error, "task execution timed out")
+		})
+	}
+}
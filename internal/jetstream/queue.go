@@ -0,0 +1,409 @@
+package jetstream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+const (
+	tasksSubject     = "tasks"
+	taskListsSubject = "taskLists"
+
+	// runAtHeader holds the time before which the task or taskList
+	// of a message must not be executed.
+	runAtHeader = "Run-At"
+)
+
+// Queue dispatches tasks and taskLists to the executors through a NATS
+// JetStream work-queue stream, so that the executors don't poll the database.
+// A message carries the ID of a task or taskList which is kept in the store.
+//
+// Polled messages are acknowledged explicitly when the task or taskList is
+// acked, and are redelivered by JetStream if they're not acknowledged within
+// the lease duration (the ack wait of the consumers), e.g. because the instance
+// executing them stopped. Heartbeats extend the ack wait of the messages.
+// Unacked tasks are negatively acknowledged with the delay until their next
+// attempt, and redeliveries of interrupted executions are counted in the
+// retries of the tasks, so the retry policies of the tasks limit the deliveries.
+type Queue struct {
+	store     service.Store
+	js        nats.JetStreamContext
+	subject   string
+	tasks     *nats.Subscription
+	lists     *nats.Subscription
+	fetchWait time.Duration
+
+	mu       sync.Mutex
+	taskMsgs map[string]*nats.Msg // taskMsgs are the messages of polled tasks by task ID
+	listMsgs map[string]*nats.Msg // listMsgs are the messages of polled taskLists by taskList ID
+}
+
+// New creates the stream with the given name if it doesn't exist and
+// the durable pull consumers of tasks and taskLists. The subjects of
+// the stream are "<subject>.tasks" and "<subject>.taskLists".
+func New(
+	conn *nats.Conn,
+	store service.Store,
+	stream string,
+	subject string,
+	lease time.Duration,
+	fetchWait time.Duration,
+) (*Queue, error) {
+	js, err := conn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := js.StreamInfo(stream); err != nil {
+		if err != nats.ErrStreamNotFound {
+			return nil, errors.New("error getting stream info", err)
+		}
+		_, err = js.AddStream(&nats.StreamConfig{
+			Name:      stream,
+			Subjects:  []string{subject + "." + tasksSubject, subject + "." + taskListsSubject},
+			Retention: nats.WorkQueuePolicy,
+			Storage:   nats.FileStorage,
+		})
+		if err != nil {
+			return nil, errors.New("error creating stream", err)
+		}
+	}
+
+	subscribe := func(name string) (*nats.Subscription, error) {
+		return js.PullSubscribe(
+			subject+"."+name,
+			name,
+			nats.BindStream(stream),
+			nats.AckExplicit(),
+			nats.AckWait(lease),
+			nats.MaxDeliver(-1),
+		)
+	}
+
+	tasks, err := subscribe(tasksSubject)
+	if err != nil {
+		return nil, errors.New("error subscribing for tasks", err)
+	}
+
+	lists, err := subscribe(taskListsSubject)
+	if err != nil {
+		return nil, errors.New("error subscribing for taskLists", err)
+	}
+
+	return &Queue{
+		store:     store,
+		js:        js,
+		subject:   subject,
+		tasks:     tasks,
+		lists:     lists,
+		fetchWait: fetchWait,
+		taskMsgs:  make(map[string]*nats.Msg),
+		listMsgs:  make(map[string]*nats.Msg),
+	}, nil
+}
+
+// Add adds the task to the store and publishes its message. The task
+// is removed from the store if the message can't be published.
+func (q *Queue) Add(ctx context.Context, task *service.Task) error {
+	if err := q.store.Add(ctx, task); err != nil {
+		return err
+	}
+
+	if err := q.publish(tasksSubject, task.ID, task.RunAt); err != nil {
+		if err := q.store.Ack(ctx, task); err != nil {
+			return errors.New("failed to remove task from store", err)
+		}
+		return errors.New("error publishing task", err)
+	}
+
+	return nil
+}
+
+// Poll retrieves one task from the stream, see PollBatch.
+func (q *Queue) Poll(ctx context.Context, owner string, lease time.Duration) (*service.Task, error) {
+	tasks, err := q.PollBatch(ctx, owner, lease, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tasks[0], nil
+}
+
+// PollBatch fetches up to limit messages from the stream, waiting up to the
+// fetch wait for them, and claims their tasks in the store. Messages of tasks
+// which were removed or cancelled are terminated, and messages of tasks
+// scheduled for execution in the future are redelivered when their time comes,
+// so fewer tasks than messages may be returned. If a task can't be claimed,
+// the messages which are not claimed yet are redelivered and the tasks which
+// are already claimed are returned. If there are no tasks to execute, it
+// returns a NotFound error.
+func (q *Queue) PollBatch(ctx context.Context, owner string, lease time.Duration, limit int) ([]*service.Task, error) {
+	msgs, err := q.fetch(ctx, q.tasks, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []*service.Task
+	for i, msg := range msgs {
+		if !due(msg) {
+			continue
+		}
+
+		task, err := q.store.ClaimTask(ctx, string(msg.Data), owner, lease)
+		if err != nil {
+			if errors.Is(errors.NotFound, err) {
+				_ = msg.Term()
+				continue
+			}
+			for _, msg := range msgs[i:] {
+				_ = msg.Nak()
+			}
+			if len(tasks) > 0 {
+				return tasks, nil
+			}
+			return nil, err
+		}
+
+		q.mu.Lock()
+		q.taskMsgs[task.ID] = msg
+		q.mu.Unlock()
+
+		tasks = append(tasks, task)
+	}
+
+	if len(tasks) == 0 {
+		return nil, errors.New(errors.NotFound, "task not found")
+	}
+
+	return tasks, nil
+}
+
+// Heartbeat extends the lease of the task in the store and resets the
+// ack wait of its message, so that it's not redelivered.
+func (q *Queue) Heartbeat(ctx context.Context, task *service.Task, lease time.Duration) error {
+	if err := q.store.Heartbeat(ctx, task, lease); err != nil {
+		return err
+	}
+
+	msg, ok := q.message(q.taskMsgs, task.ID, false)
+	if !ok {
+		return errors.New(errors.NotFound, "task lease not found")
+	}
+	return msg.InProgress()
+}
+
+// Ack removes the task from the store and acknowledges its message,
+// so that it's removed from the stream.
+func (q *Queue) Ack(ctx context.Context, task *service.Task) error {
+	if err := q.store.Ack(ctx, task); err != nil {
+		return err
+	}
+
+	if msg, ok := q.message(q.taskMsgs, task.ID, true); ok {
+		return msg.Ack()
+	}
+	return nil
+}
+
+// Unack returns the task to the queue in the store and negatively
+// acknowledges its message, so that it's redelivered at the next
+// attempt time of the task.
+func (q *Queue) Unack(ctx context.Context, task *service.Task) error {
+	if err := q.store.Unack(ctx, task); err != nil {
+		return err
+	}
+
+	msg, ok := q.message(q.taskMsgs, task.ID, true)
+	if !ok {
+		return nil
+	}
+	if delay := time.Until(task.NextAttemptAt); delay > 0 {
+		return msg.NakWithDelay(delay)
+	}
+	return msg.Nak()
+}
+
+// Cancel cancels the task in the store. The message of a cancelled
+// task is terminated when it's delivered.
+func (q *Queue) Cancel(ctx context.Context, taskID string) (*service.Task, error) {
+	return q.store.Cancel(ctx, taskID)
+}
+
+// AddTaskList adds the taskList and its tasks to the store and publishes
+// the message of the taskList. The taskList and its tasks are removed from
+// the store if the message can't be published.
+func (q *Queue) AddTaskList(ctx context.Context, taskList *service.TaskList, tasks []*service.Task) error {
+	if err := q.store.AddTaskList(ctx, taskList, tasks); err != nil {
+		return err
+	}
+
+	if err := q.publish(taskListsSubject, taskList.ID, taskList.RunAt); err != nil {
+		for i := range taskList.Groups {
+			if err := q.store.AckGroupTasks(ctx, &taskList.Groups[i]); err != nil {
+				return errors.New("failed to remove group tasks from store", err)
+			}
+		}
+		if err := q.store.AckList(ctx, taskList); err != nil {
+			return errors.New("failed to remove taskList from store", err)
+		}
+		return errors.New("error publishing taskList", err)
+	}
+
+	return nil
+}
+
+// PollList fetches one message from the stream and claims its taskList in
+// the store in the same way as PollBatch does for tasks.
+func (q *Queue) PollList(ctx context.Context, owner string, lease time.Duration) (*service.TaskList, error) {
+	msgs, err := q.fetch(ctx, q.lists, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := msgs[0]
+	if !due(msg) {
+		return nil, errors.New(errors.NotFound, "taskList not found")
+	}
+
+	list, err := q.store.ClaimTaskList(ctx, string(msg.Data), owner, lease)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			_ = msg.Term()
+			return nil, err
+		}
+		_ = msg.Nak()
+		return nil, err
+	}
+
+	q.mu.Lock()
+	q.listMsgs[list.ID] = msg
+	q.mu.Unlock()
+
+	return list, nil
+}
+
+// HeartbeatList extends the lease of the taskList in the store and resets
+// the ack wait of its message, so that it's not redelivered.
+func (q *Queue) HeartbeatList(ctx context.Context, taskList *service.TaskList, lease time.Duration) error {
+	if err := q.store.HeartbeatList(ctx, taskList, lease); err != nil {
+		return err
+	}
+
+	msg, ok := q.message(q.listMsgs, taskList.ID, false)
+	if !ok {
+		return errors.New(errors.NotFound, "taskList lease not found")
+	}
+	return msg.InProgress()
+}
+
+// AckList removes the taskList from the store and acknowledges its message.
+func (q *Queue) AckList(ctx context.Context, taskList *service.TaskList) error {
+	if err := q.store.AckList(ctx, taskList); err != nil {
+		return err
+	}
+
+	if msg, ok := q.message(q.listMsgs, taskList.ID, true); ok {
+		return msg.Ack()
+	}
+	return nil
+}
+
+// AckGroupTasks removes the tasks of a group from the store. The tasks
+// of the groups are executed by the taskList executor and have no messages.
+func (q *Queue) AckGroupTasks(ctx context.Context, group *service.Group) error {
+	return q.store.AckGroupTasks(ctx, group)
+}
+
+// RequeueExpired doesn't requeue any tasks, because JetStream redelivers
+// the messages which are not acknowledged within the lease duration.
+func (q *Queue) RequeueExpired(_ context.Context, _ time.Time) (int, error) {
+	return 0, nil
+}
+
+// RequeueExpiredLists doesn't requeue any taskLists, see RequeueExpired.
+func (q *Queue) RequeueExpiredLists(_ context.Context, _ time.Time) (int, error) {
+	return 0, nil
+}
+
+// ArchiveCancelled moves the cancelled tasks which lease has expired
+// from the store to the history. The entries of the tasks are dropped
+// when they're polled, because the tasks can't be claimed.
+func (q *Queue) ArchiveCancelled(ctx context.Context, now time.Time) (int, error) {
+	return q.store.ArchiveCancelled(ctx, now)
+}
+
+// publish publishes a message with the ID of a task or taskList. The ID is
+// also the message ID, so that JetStream discards duplicate messages. As
+// a discarded message would never be delivered, publishing a message which
+// is discarded as a duplicate fails, e.g. when a task is added again with
+// the same ID within the duplicate window of the stream.
+func (q *Queue) publish(name, id string, runAt time.Time) error {
+	msg := nats.NewMsg(q.subject + "." + name)
+	msg.Data = []byte(id)
+	if !runAt.IsZero() {
+		msg.Header.Set(runAtHeader, runAt.Format(time.RFC3339Nano))
+	}
+
+	ack, err := q.js.PublishMsg(msg, nats.MsgId(id))
+	if err != nil {
+		return err
+	}
+	if ack.Duplicate {
+		return errors.New(errors.Exist, "message is discarded as a duplicate")
+	}
+	return nil
+}
+
+// fetch fetches up to limit messages, waiting up to the fetch wait for them.
+// It returns a NotFound error if there are no messages.
+func (q *Queue) fetch(ctx context.Context, sub *nats.Subscription, limit int) ([]*nats.Msg, error) {
+	fetchCtx, cancel := context.WithTimeout(ctx, q.fetchWait)
+	defer cancel()
+
+	msgs, err := sub.Fetch(limit, nats.Context(fetchCtx))
+	if err != nil {
+		if ctx.Err() == nil && (err == nats.ErrTimeout || err == context.DeadlineExceeded) {
+			return nil, errors.New(errors.NotFound, "no messages in stream")
+		}
+		return nil, err
+	}
+
+	if len(msgs) == 0 {
+		return nil, errors.New(errors.NotFound, "no messages in stream")
+	}
+
+	return msgs, nil
+}
+
+// message returns the message of a polled task or taskList and
+// removes it from the polled messages if remove is true.
+func (q *Queue) message(msgs map[string]*nats.Msg, id string, remove bool) (*nats.Msg, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	msg, ok := msgs[id]
+	if ok && remove {
+		delete(msgs, id)
+	}
+	return msg, ok
+}
+
+// due reports whether the task or taskList of a message may be executed.
+// Messages which are not due are redelivered when their time comes.
+func due(msg *nats.Msg) bool {
+	runAt, err := time.Parse(time.RFC3339Nano, msg.Header.Get(runAtHeader))
+	if err != nil {
+		return true
+	}
+
+	if delay := time.Until(runAt); delay > 0 {
+		_ = msg.NakWithDelay(delay)
+		return false
+	}
+	return true
+}
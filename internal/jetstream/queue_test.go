@@ -0,0 +1,189 @@
+package jetstream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/jetstream"
+	"github.com/eclipse-xfsc/task-sheduler/internal/memory"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// failingStore fails to claim the tasks with the given ID.
+type failingStore struct {
+	*memory.Storage
+	failID string
+}
+
+func (s *failingStore) ClaimTask(ctx context.Context, taskID, owner string, lease time.Duration) (*service.Task, error) {
+	if taskID == s.failID {
+		return nil, errors.New("some error")
+	}
+	return s.Storage.ClaimTask(ctx, taskID, owner, lease)
+}
+
+func newQueue(t *testing.T, store service.Store) *jetstream.Queue {
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	require.NoError(t, err)
+
+	go srv.Start()
+	t.Cleanup(srv.Shutdown)
+	require.True(t, srv.ReadyForConnections(5*time.Second))
+
+	conn, err := nats.Connect(srv.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(conn.Close)
+
+	q, err := jetstream.New(conn, store, "test", "test", time.Minute, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	// creating the queue again uses the existing stream and consumers
+	_, err = jetstream.New(conn, store, "test", "test", time.Minute, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	return q
+}
+
+func TestQueue_Implements(t *testing.T) {
+	q := newQueue(t, memory.New(time.Minute))
+	assert.Implements(t, (*service.Queue)(nil), q)
+}
+
+func TestQueue_Tasks(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New(time.Minute)
+	q := newQueue(t, store)
+
+	_, err := q.Poll(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	assert.NoError(t, q.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+
+	task, err := q.Poll(ctx, "owner", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "123", task.ID)
+	assert.Equal(t, service.State(service.Pending), task.State)
+	assert.Equal(t, "owner", task.LeaseOwner)
+	assert.NoError(t, q.Heartbeat(ctx, task, time.Hour))
+
+	// the heartbeat extends the lease in the store
+	stored, err := store.Task(ctx, "123")
+	require.NoError(t, err)
+	assert.True(t, stored.LeaseExpiresAt.After(time.Now().Add(time.Minute)))
+
+	// the lease can't be extended by another owner
+	err = q.Heartbeat(ctx, &service.Task{ID: "123", LeaseOwner: "other"}, time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	// the message is not acknowledged and not delivered again
+	_, err = q.Poll(ctx, "other", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	// a task returned to the queue is redelivered at its next attempt
+	task.Error = "some error"
+	task.NextAttemptAt = time.Now().Add(200 * time.Millisecond)
+	assert.NoError(t, q.Unack(ctx, task))
+	_, err = q.Poll(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	time.Sleep(time.Until(task.NextAttemptAt))
+	task, err = q.Poll(ctx, "owner", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "123", task.ID)
+	assert.Equal(t, 1, task.Retries)
+
+	assert.NoError(t, q.Ack(ctx, task))
+	_, err = store.Task(ctx, "123")
+	assert.True(t, errors.Is(errors.NotFound, err))
+	_, err = q.Poll(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
+
+func TestQueue_DuplicateTask(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New(time.Minute)
+	q := newQueue(t, store)
+
+	assert.NoError(t, q.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+	task, err := q.Poll(ctx, "owner", time.Minute)
+	require.NoError(t, err)
+	assert.NoError(t, q.Ack(ctx, task))
+
+	// the message of a task added again within the duplicate window would be
+	// discarded, so the task is not added
+	err = q.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()})
+	assert.True(t, errors.Is(errors.Exist, err))
+	_, err = store.Task(ctx, "123")
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
+
+func TestQueue_PollBatchClaimError(t *testing.T) {
+	ctx := context.Background()
+	store := &failingStore{Storage: memory.New(time.Minute), failID: "456"}
+	q := newQueue(t, store)
+
+	for _, id := range []string{"123", "456", "789"} {
+		assert.NoError(t, q.Add(ctx, &service.Task{ID: id, State: service.Created, CreatedAt: time.Now()}))
+	}
+
+	// the tasks claimed before the error are returned
+	tasks, err := q.PollBatch(ctx, "owner", time.Minute, 3)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "123", tasks[0].ID)
+
+	// the messages which were not claimed are redelivered
+	store.failID = ""
+	tasks, err = q.PollBatch(ctx, "owner", time.Minute, 3)
+	require.NoError(t, err)
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	assert.ElementsMatch(t, []string{"456", "789"}, ids)
+}
+
+func TestQueue_CancelledTask(t *testing.T) {
+	ctx := context.Background()
+	q := newQueue(t, memory.New(time.Minute))
+
+	assert.NoError(t, q.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+	_, err := q.Cancel(ctx, "123")
+	require.NoError(t, err)
+
+	_, err = q.Poll(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
+
+func TestQueue_ScheduledTaskList(t *testing.T) {
+	ctx := context.Background()
+	q := newQueue(t, memory.New(time.Minute))
+
+	runAt := time.Now().Add(200 * time.Millisecond)
+	list := &service.TaskList{ID: "123", State: service.Created, CreatedAt: time.Now(), RunAt: runAt}
+	assert.NoError(t, q.AddTaskList(ctx, list, nil))
+
+	_, err := q.PollList(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	time.Sleep(time.Until(runAt))
+	list, err = q.PollList(ctx, "owner", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "123", list.ID)
+	assert.NoError(t, q.HeartbeatList(ctx, list, time.Minute))
+	assert.NoError(t, q.AckList(ctx, list))
+}
@@ -19,11 +19,6 @@ import (
 
 type token struct{}
 
-const (
-	sequential = "sequential"
-	parallel   = "parallel"
-)
-
 // Policy client.
 type Policy interface {
 	Evaluate(ctx context.Context, policy string, data []byte) ([]byte, error)
@@ -42,6 +37,22 @@ type ListExecutor struct {
 	workers      int
 	pollInterval time.Duration
 
+	// taskTimeout is the default timeout of a task execution,
+	// which can be overridden by task templates.
+	taskTimeout time.Duration
+
+	// maxRetries is the number of times an interrupted taskList is executed
+	// again, after which it's failed without being executed.
+	maxRetries int
+
+	// owner identifies the instance of the service in the leases of the
+	// polled taskLists, which are renewed during execution for lease duration.
+	owner string
+	lease time.Duration
+
+	// watcher if set, wakes up the executor when taskLists are added to the queue.
+	watcher service.QueueWatcher
+
 	httpClient *http.Client
 	logger     *zap.Logger
 }
@@ -53,6 +64,11 @@ func New(
 	cache Cache,
 	workers int,
 	pollInterval time.Duration,
+	taskTimeout time.Duration,
+	maxRetries int,
+	owner string,
+	lease time.Duration,
+	watcher service.QueueWatcher,
 	httpClient *http.Client,
 	logger *zap.Logger,
 ) *ListExecutor {
@@ -63,39 +79,60 @@ func New(
 		cache:        cache,
 		workers:      workers,
 		pollInterval: pollInterval,
+		taskTimeout:  taskTimeout,
+		maxRetries:   maxRetries,
+		owner:        owner,
+		lease:        lease,
+		watcher:      watcher,
 		httpClient:   httpClient,
 		logger:       logger,
 	}
 }
 
+// Start polls the queue for taskLists and executes them concurrently up to
+// the number of workers. After a taskList is found the queue is polled again
+// immediately, and only when the queue is empty the executor waits for the
+// poll interval, or until the queue watcher notifies that taskLists were added.
 func (l *ListExecutor) Start(ctx context.Context) error {
 	defer l.logger.Info("taskList executor stopped")
 
 	// buffered channel used as a semaphore to limit concurrent executions
 	sem := make(chan token, l.workers)
 
+	wakeup := l.watch(ctx)
+
 loop:
 	for {
 		select {
 		case <-ctx.Done():
 			break loop
-		case <-time.After(l.pollInterval):
-			sem <- token{} // acquire a semaphore
+		case sem <- token{}: // acquire a semaphore
+		}
 
-			taskList, err := l.queue.PollList(ctx)
-			if err != nil {
-				if !errors.Is(errors.NotFound, err) {
-					l.logger.Error("error getting taskList from queue", zap.Error(err))
+		taskList, err := l.queue.PollList(ctx, l.owner, l.lease)
+		if err != nil {
+			if !errors.Is(errors.NotFound, err) {
+				l.logger.Error("error getting taskList from queue", zap.Error(err))
+			}
+			<-sem // release the semaphore
+
+			select {
+			case <-ctx.Done():
+				break loop
+			case <-time.After(l.pollInterval):
+			case _, ok := <-wakeup:
+				if !ok {
+					l.logger.Warn("taskList queue watch stopped, falling back to polling")
+					wakeup = nil
 				}
-				<-sem // release the semaphore
-				continue
 			}
-
-			go func(list *service.TaskList) {
-				l.Execute(ctx, list)
-				<-sem // release the semaphore
-			}(taskList)
+			continue
 		}
+
+		go func(list *service.TaskList) {
+			l.Execute(ctx, list)
+			<-sem // release the semaphore
+		}(taskList)
 	}
 
 	// wait for completion
@@ -106,19 +143,51 @@ loop:
 	return ctx.Err()
 }
 
+// watch returns the notifications of the queue watcher, or nil if the
+// watcher is not set or the queue can't be watched, so that the executor
+// only polls the queue.
+func (l *ListExecutor) watch(ctx context.Context) <-chan struct{} {
+	if l.watcher == nil {
+		return nil
+	}
+
+	wakeup, err := l.watcher.WatchTaskLists(ctx)
+	if err != nil {
+		l.logger.Warn("taskList queue cannot be watched, falling back to polling", zap.Error(err))
+		return nil
+	}
+
+	return wakeup
+}
+
 func (l *ListExecutor) Execute(ctx context.Context, list *service.TaskList) {
 	logger := l.logger.With(
 		zap.String("taskListID", list.ID),
 		zap.String("taskListName", list.Name),
 	)
+
+	if list.Retries > l.maxRetries {
+		l.fail(ctx, list, logger)
+		return
+	}
+
 	list.State = service.Pending
 	list.StartedAt = time.Now()
 
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	go l.heartbeat(heartbeatCtx, list.ID, list.LeaseOwner, logger)
+
 	var state goatasklist.TaskListStatusResponse
 
+	// tasks which are still running at the deadline of the taskList are failed
+	var deadline time.Time
+	if list.Timeout > 0 {
+		deadline = list.StartedAt.Add(list.Timeout)
+	}
+
 	// execute groups sequentially
 	for i := range list.Groups {
-		groupState, err := l.executeGroup(ctx, &list.Groups[i])
+		groupState, err := l.executeGroup(ctx, &list.Groups[i], deadline)
 		if err != nil {
 			logger.Error("error executing group", zap.Error(err))
 			list.Groups[i].State = service.Failed
@@ -132,11 +201,58 @@ func (l *ListExecutor) Execute(ctx context.Context, list *service.TaskList) {
 		}
 	}
 
+	stopHeartbeat()
+
 	if list.State != service.Failed {
 		list.State = service.Done
 	}
 	list.FinishedAt = time.Now()
 
+	l.finish(ctx, list, &state, logger)
+}
+
+// fail finishes a taskList which execution was interrupted too many times
+// as failed, without executing it again. The tasks of the groups which were
+// not completed before the interruptions are failed and moved to the history.
+func (l *ListExecutor) fail(ctx context.Context, list *service.TaskList, logger *zap.Logger) {
+	logger.Error("taskList failed due to too many interrupted executions", zap.Int("retries", list.Retries))
+
+	var state goatasklist.TaskListStatusResponse
+	for i := range list.Groups {
+		group := &list.Groups[i]
+		tasks, err := l.storage.GetGroupTasks(ctx, group)
+		if err != nil {
+			logger.Error("error getting group tasks", zap.String("groupID", group.ID), zap.Error(err))
+		}
+
+		// the tasks of completed groups are already removed from the queue
+		group.State = service.Done
+		if err != nil || len(tasks) > 0 {
+			group.State = service.Failed
+		}
+		groupState := &goatasklist.GroupStatus{ID: &group.ID, Status: ptr.String(string(group.State))}
+
+		for _, task := range tasks {
+			task.State = service.Failed
+			task.Error = "taskList execution was interrupted too many times"
+			groupState.Tasks = append(groupState.Tasks, &goatasklist.TaskStatus{ID: &task.ID, Status: ptr.String(service.Failed)})
+			l.saveFailedTask(ctx, task, logger)
+		}
+
+		if err := l.queue.AckGroupTasks(ctx, group); err != nil {
+			logger.Error("failed to ack group tasks in queue", zap.String("groupID", group.ID), zap.Error(err))
+		}
+		state.Groups = append(state.Groups, groupState)
+	}
+
+	list.State = service.Failed
+	list.FinishedAt = time.Now()
+	l.finish(ctx, list, &state, logger)
+}
+
+// finish stores the state of a finished taskList in the cache, moves the
+// taskList to the history and notifies its callback URL.
+func (l *ListExecutor) finish(ctx context.Context, list *service.TaskList, state *goatasklist.TaskListStatusResponse, logger *zap.Logger) {
 	state.ID = list.ID
 	state.Status = string(list.State)
 
@@ -159,21 +275,68 @@ func (l *ListExecutor) Execute(ctx context.Context, list *service.TaskList) {
 
 	if err := l.queue.AckList(ctx, list); err != nil {
 		logger.Error("failed to ack taskList in queue", zap.Error(err))
+		return
+	}
+
+	l.notify(ctx, list, value, logger)
+}
+
+// heartbeat periodically extends the lease of the taskList during its execution,
+// so that the taskList isn't returned to the queue while it's being executed.
+func (l *ListExecutor) heartbeat(ctx context.Context, taskListID, owner string, logger *zap.Logger) {
+	ticker := time.NewTicker(service.HeartbeatInterval(l.lease))
+	defer ticker.Stop()
+
+	// the lease is extended with a copy, as the executed taskList is modified concurrently
+	list := &service.TaskList{ID: taskListID, LeaseOwner: owner}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.queue.HeartbeatList(ctx, list, l.lease); err != nil && ctx.Err() == nil {
+				logger.Warn("error extending taskList lease", zap.Error(err))
+			}
+		}
+	}
+}
+
+// notify saves a webhook delivery for a finished taskList which has a callback URL.
+// The webhook is delivered asynchronously by the webhook dispatcher.
+func (l *ListExecutor) notify(ctx context.Context, list *service.TaskList, result []byte, logger *zap.Logger) {
+	if list.CallbackURL == "" {
+		return
+	}
+
+	delivery, err := service.NewWebhookDelivery(list.CallbackURL, service.TaskListNotification(list, result))
+	if err != nil {
+		logger.Error("error creating webhook delivery", zap.Error(err))
+		return
+	}
+
+	if err := l.storage.AddWebhookDelivery(ctx, delivery); err != nil {
+		logger.Error("error adding webhook delivery", zap.Error(err))
 	}
 }
 
-func (l *ListExecutor) executeGroup(ctx context.Context, group *service.Group) (*goatasklist.GroupStatus, error) {
+// executeGroup executes the tasks of a group. The tasks must finish before the
+// given deadline of the taskList and the deadline of the group, if they are set.
+func (l *ListExecutor) executeGroup(ctx context.Context, group *service.Group, deadline time.Time) (*goatasklist.GroupStatus, error) {
+	if group.Timeout > 0 {
+		deadline = earliest(deadline, time.Now().Add(group.Timeout))
+	}
+
 	switch exec := group.Execution; exec {
-	case sequential:
-		return l.executeSequential(ctx, group)
-	case parallel:
-		return l.executeParallel(ctx, group)
+	case service.Sequential:
+		return l.executeSequential(ctx, group, deadline)
+	case service.Parallel:
+		return l.executeParallel(ctx, group, deadline)
 	}
 
 	return nil, errors.New("unknown type of group execution")
 }
 
-func (l *ListExecutor) executeSequential(ctx context.Context, group *service.Group) (*goatasklist.GroupStatus, error) {
+func (l *ListExecutor) executeSequential(ctx context.Context, group *service.Group, deadline time.Time) (*goatasklist.GroupStatus, error) {
 	group.State = service.Pending
 	var state goatasklist.GroupStatus
 
@@ -201,13 +364,16 @@ func (l *ListExecutor) executeSequential(ctx context.Context, group *service.Gro
 		}
 
 		task.Request = req
-		err := l.executeTask(ctx, task)
+		err := l.executeTask(ctx, task, deadline)
 		if err != nil {
 			task.State = service.Failed
+			task.Error = err.Error()
+			task.FailureReason = service.FailureReason(err)
 			taskState.Status = ptr.String(service.Failed)
 			state.Tasks = append(state.Tasks, &taskState)
 			group.State = service.Failed
 			logger.Error("error executing task", zap.Error(err))
+			l.saveFailedTask(ctx, task, logger)
 			continue
 		}
 		logger.Debug("task execution completed successfully")
@@ -252,7 +418,7 @@ func (l *ListExecutor) executeSequential(ctx context.Context, group *service.Gro
 	return &state, nil
 }
 
-func (l *ListExecutor) executeParallel(ctx context.Context, group *service.Group) (*goatasklist.GroupStatus, error) {
+func (l *ListExecutor) executeParallel(ctx context.Context, group *service.Group, deadline time.Time) (*goatasklist.GroupStatus, error) {
 	group.State = service.Pending
 	var state goatasklist.GroupStatus
 
@@ -277,12 +443,15 @@ func (l *ListExecutor) executeParallel(ctx context.Context, group *service.Group
 			// pass group request to each task
 			t.Request = group.Request
 
-			if err := l.executeTask(ctx, t); err != nil {
+			if err := l.executeTask(ctx, t, deadline); err != nil {
 				t.State = service.Failed
+				t.Error = err.Error()
+				t.FailureReason = service.FailureReason(err)
 				taskState.Status = ptr.String(service.Failed)
 				state.Tasks = append(state.Tasks, &taskState)
 				group.State = service.Failed
 				logger.Error("error executing task", zap.Error(err))
+				l.saveFailedTask(ctx, t, logger)
 				return
 			}
 			logger.Debug("task execution completed successfully")
@@ -328,9 +497,40 @@ func (l *ListExecutor) executeParallel(ctx context.Context, group *service.Group
 	return &state, nil
 }
 
-func (l *ListExecutor) executeTask(ctx context.Context, task *service.Task) error {
+// saveFailedTask saves a task which execution failed in the history collection
+// together with its error and the response received, so that it can be inspected.
+func (l *ListExecutor) saveFailedTask(ctx context.Context, task *service.Task, logger *zap.Logger) {
+	task.FinishedAt = time.Now()
+	if err := l.storage.SaveTaskHistory(ctx, task); err != nil {
+		logger.Error("error saving task history", zap.Error(err))
+	}
+}
+
+// executeTask executes a task within its timeout and before the given deadline.
+// The returned error has a timeout reason if the execution didn't finish in time.
+func (l *ListExecutor) executeTask(ctx context.Context, task *service.Task, deadline time.Time) error {
 	task.StartedAt = time.Now()
 
+	if timeout := task.ExecutionTimeout(l.taskTimeout); timeout > 0 {
+		deadline = earliest(deadline, task.StartedAt.Add(timeout))
+	}
+
+	execCtx, cancel := context.WithCancel(ctx)
+	if !deadline.IsZero() {
+		execCtx, cancel = context.WithDeadline(ctx, deadline)
+	}
+	defer cancel()
+
+	if err := l.runTask(execCtx, task); err != nil {
+		if execCtx.Err() == context.DeadlineExceeded {
+			return service.TimeoutError(err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (l *ListExecutor) runTask(ctx context.Context, task *service.Task) error {
 	var response []byte
 	var err error
 
@@ -345,6 +545,9 @@ func (l *ListExecutor) executeTask(ctx context.Context, task *service.Task) erro
 		var status int
 		status, response, err = l.doHTTPTask(ctx, task)
 		if err != nil {
+			// keep the response of a failed request for diagnosis
+			task.ResponseCode = status
+			task.Response = response
 			return err
 		}
 		task.ResponseCode = status
@@ -379,6 +582,14 @@ func (l *ListExecutor) executeTask(ctx context.Context, task *service.Task) erro
 	return nil
 }
 
+// earliest returns the earlier of two deadlines, where a zero time means no deadline.
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
 func (l *ListExecutor) doHTTPTask(ctx context.Context, task *service.Task) (status int, response []byte, err error) {
 	reqBody := task.Request
 	if task.Method == http.MethodGet {
@@ -400,5 +611,12 @@ func (l *ListExecutor) doHTTPTask(ctx context.Context, task *service.Task) (stat
 		return 0, nil, errors.New("error reading response body", err)
 	}
 
+	if !service.StatusAccepted(task.AcceptedStatusCodes, resp.StatusCode) {
+		if len(response) > service.MaxFailedResponseSize {
+			response = response[:service.MaxFailedResponseSize]
+		}
+		return resp.StatusCode, response, service.StatusError(resp.StatusCode, resp.Header)
+	}
+
 	return resp.StatusCode, response, nil
 }
@@ -0,0 +1,180 @@
+package listexecutor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/memory"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// cache discards the stored results.
+type cache struct{}
+
+func (cache) Set(context.Context, string, string, string, []byte) error { return nil }
+
+func (cache) Get(context.Context, string, string, string) ([]byte, error) { return nil, nil }
+
+func TestEarliest(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		a, b time.Time
+
+		result time.Time
+	}{
+		{
+			name: "no deadlines",
+		},
+		{
+			name:   "only the first deadline",
+			a:      now,
+			result: now,
+		},
+		{
+			name:   "only the second deadline",
+			b:      now,
+			result: now,
+		},
+		{
+			name:   "first deadline is earlier",
+			a:      now,
+			b:      now.Add(time.Second),
+			result: now,
+		},
+		{
+			name:   "second deadline is earlier",
+			a:      now.Add(time.Second),
+			b:      now,
+			result: now,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.result, earliest(test.a, test.b))
+		})
+	}
+}
+
+func TestListExecutor_Deadlines(t *testing.T) {
+	// the server responds after the delay or when the request is cancelled
+	delay := make(chan time.Duration, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(<-delay):
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name           string
+		delay          time.Duration
+		listTimeout    time.Duration
+		groupTimeout   time.Duration
+		taskTimeout    time.Duration
+		defaultTimeout time.Duration
+
+		state service.State
+	}{
+		{
+			name:        "deadline of the taskList",
+			delay:       10 * time.Second,
+			listTimeout: 50 * time.Millisecond,
+			state:       service.Failed,
+		},
+		{
+			name:         "deadline of the group is earlier than the taskList deadline",
+			delay:        10 * time.Second,
+			listTimeout:  10 * time.Second,
+			groupTimeout: 50 * time.Millisecond,
+			state:        service.Failed,
+		},
+		{
+			name:         "timeout of the task is earlier than the group and taskList deadlines",
+			delay:        10 * time.Second,
+			listTimeout:  10 * time.Second,
+			groupTimeout: 10 * time.Second,
+			taskTimeout:  50 * time.Millisecond,
+			state:        service.Failed,
+		},
+		{
+			name:           "default timeout of the executor",
+			delay:          10 * time.Second,
+			defaultTimeout: 50 * time.Millisecond,
+			state:          service.Failed,
+		},
+		{
+			name:         "taskList deadline is earlier than the task timeout",
+			delay:        10 * time.Second,
+			listTimeout:  50 * time.Millisecond,
+			groupTimeout: 10 * time.Second,
+			taskTimeout:  10 * time.Second,
+			state:        service.Failed,
+		},
+		{
+			name:         "task completes before the deadlines",
+			listTimeout:  10 * time.Second,
+			groupTimeout: 10 * time.Second,
+			taskTimeout:  10 * time.Second,
+			state:        service.Done,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			storage := memory.New(time.Minute)
+
+			list := &service.TaskList{
+				ID:        "list",
+				Name:      "list",
+				State:     service.Created,
+				CreatedAt: time.Now(),
+				Timeout:   test.listTimeout,
+				Groups:    []service.Group{{ID: "group", Execution: service.Sequential, Tasks: []string{"task"}, Timeout: test.groupTimeout}},
+			}
+			task := &service.Task{
+				ID:        "task",
+				GroupID:   "group",
+				Name:      "task",
+				State:     service.Created,
+				URL:       srv.URL,
+				Method:    http.MethodGet,
+				Timeout:   test.taskTimeout,
+				CreatedAt: time.Now(),
+			}
+			require.NoError(t, storage.AddTaskList(ctx, list, []*service.Task{task}))
+			list, err := storage.PollList(ctx, "owner", time.Minute)
+			require.NoError(t, err)
+
+			executor := New(storage, nil, storage, cache{}, 1, time.Second, test.defaultTimeout, 3, "owner", time.Minute, nil, http.DefaultClient, zap.NewNop())
+
+			delay <- test.delay
+			start := time.Now()
+			executor.Execute(ctx, list)
+			assert.Less(t, time.Since(start), 5*time.Second)
+
+			finished, err := storage.TaskListHistory(ctx, "list")
+			require.NoError(t, err)
+			assert.Equal(t, test.state, finished.State)
+
+			executed, err := storage.TaskHistory(ctx, "task")
+			require.NoError(t, err)
+			assert.Equal(t, test.state, executed.State)
+			if test.state == service.Failed {
+				assert.Equal(t, service.ReasonTimeout, executed.FailureReason)
+				assert.Contains(t, executed.Error, "task execution timed out")
+			}
+		})
+	}
+}
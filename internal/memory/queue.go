@@ -0,0 +1,377 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+func (s *Storage) Add(_ context.Context, task *service.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task.Rank = service.Rank(task.CreatedAt, task.RunAt, task.Priority, s.priorityAging)
+	s.tasks = append(s.tasks, clone(task))
+	return nil
+}
+
+// Poll retrieves one task with empty groupID from the queue in the same
+// order as the MongoDB storage and leases it to the given owner, see PollBatch.
+func (s *Storage) Poll(ctx context.Context, owner string, lease time.Duration) (*service.Task, error) {
+	tasks, err := s.PollBatch(ctx, owner, lease, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tasks[0], nil
+}
+
+// PollBatch retrieves up to limit tasks ordered by their rank which can be
+// executed now, updates their state to "pending" and leases them to the
+// given owner for the lease duration. If there are no tasks to execute, it
+// returns a NotFound error.
+func (s *Storage) PollBatch(_ context.Context, owner string, lease time.Duration, limit int) ([]*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var claimable []*service.Task
+	for _, task := range s.tasks {
+		if task.State == service.Created &&
+			task.GroupID == "" &&
+			!task.RunAt.After(now) &&
+			!task.NextAttemptAt.After(now) {
+			claimable = append(claimable, task)
+		}
+	}
+
+	if len(claimable) == 0 {
+		return nil, errors.New(errors.NotFound, "task not found")
+	}
+
+	sort.SliceStable(claimable, func(i, j int) bool {
+		return queuedBefore(claimable[i].Rank, claimable[i].CreatedAt, claimable[j].Rank, claimable[j].CreatedAt)
+	})
+	if len(claimable) > limit {
+		claimable = claimable[:limit]
+	}
+
+	var tasks []*service.Task
+	for _, task := range claimable {
+		task.State = service.Pending
+		task.StartedAt = now
+		task.LeaseOwner = owner
+		task.LeaseExpiresAt = now.Add(lease)
+		tasks = append(tasks, clone(task))
+	}
+
+	return tasks, nil
+}
+
+// ClaimTask updates the state of a "created" or "pending" task with empty
+// groupID to "pending" and leases it to the given owner. Claiming a "pending"
+// task counts as a failed execution attempt, see storage.ClaimTask.
+func (s *Storage) ClaimTask(_ context.Context, taskID, owner string, lease time.Duration) (*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.taskIndex(taskID)
+	if i < 0 || s.tasks[i].GroupID != "" || (s.tasks[i].State != service.Created && s.tasks[i].State != service.Pending) {
+		return nil, errors.New(errors.NotFound, "task not found")
+	}
+
+	task := s.tasks[i]
+	if task.State == service.Pending {
+		task.Retries++
+		task.Error = service.LeaseExpiredError
+		task.Errors = service.AppendError(task.Errors, service.LeaseExpiredError)
+	}
+
+	now := time.Now()
+	task.State = service.Pending
+	task.StartedAt = now
+	task.LeaseOwner = owner
+	task.LeaseExpiresAt = now.Add(lease)
+	return clone(task), nil
+}
+
+// Heartbeat extends the lease of a pending task held by the lease owner of
+// the task. It returns a NotFound error if the task is no longer held by
+// the owner.
+func (s *Storage) Heartbeat(_ context.Context, task *service.Task, lease time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.taskIndex(task.ID)
+	if i < 0 || s.tasks[i].State != service.Pending || s.tasks[i].LeaseOwner != task.LeaseOwner {
+		return errors.New(errors.NotFound, "task lease not found")
+	}
+
+	s.tasks[i].LeaseExpiresAt = time.Now().Add(lease)
+	return nil
+}
+
+// RequeueExpired returns pending tasks with empty groupID which lease has
+// expired to the queue and counts it as a failed execution attempt.
+// It returns the number of requeued tasks.
+func (s *Storage) RequeueExpired(_ context.Context, now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requeued int
+	for _, task := range s.tasks {
+		if task.State != service.Pending || task.GroupID != "" || task.LeaseExpiresAt.After(now) {
+			continue
+		}
+		task.State = service.Created
+		task.Error = service.LeaseExpiredError
+		task.LeaseOwner = ""
+		task.Retries++
+		task.Errors = service.AppendError(task.Errors, service.LeaseExpiredError)
+		requeued++
+	}
+
+	return requeued, nil
+}
+
+// Ack removes a task from the queue, unless the task was
+// leased to another owner in the meantime.
+func (s *Storage) Ack(_ context.Context, task *service.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.taskIndex(task.ID); i >= 0 && s.tasks[i].LeaseOwner == task.LeaseOwner {
+		s.tasks = append(s.tasks[:i], s.tasks[i+1:]...)
+	}
+	return nil
+}
+
+// Unack changes the "pending" state of a task to "created", so that
+// it can be retrieved for processing again after its next attempt
+// time. Cancelled tasks and tasks which were leased to another
+// owner in the meantime are not changed.
+func (s *Storage) Unack(_ context.Context, t *service.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.taskIndex(t.ID)
+	if i < 0 || s.tasks[i].LeaseOwner != t.LeaseOwner || s.tasks[i].State == service.Cancelled {
+		return nil
+	}
+
+	task := s.tasks[i]
+	task.State = service.Created
+	task.Retries = t.Retries + 1
+	task.Error = t.Error
+	task.FailureReason = t.FailureReason
+	task.NextAttemptAt = t.NextAttemptAt
+	task.ResponseCode = t.ResponseCode
+	task.Response = t.Response
+	task.Errors = service.AppendError(task.Errors, t.Error)
+	return nil
+}
+
+// Cancel changes the state of a "created" or "pending" task with
+// empty groupID to "cancelled" and returns the task as it was before
+// the cancellation. A "created" task is moved to the history immediately,
+// see storage.Cancel.
+func (s *Storage) Cancel(_ context.Context, taskID string) (*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.taskIndex(taskID)
+	if i < 0 || s.tasks[i].GroupID != "" || (s.tasks[i].State != service.Created && s.tasks[i].State != service.Pending) {
+		return nil, errors.New(errors.NotFound, "task not found")
+	}
+
+	before := clone(s.tasks[i])
+	s.tasks[i].State = service.Cancelled
+	if before.State == service.Created {
+		s.archiveCancelled(i)
+	}
+	return before, nil
+}
+
+// ArchiveCancelled moves cancelled tasks with empty groupID which are not
+// leased, or which lease has expired, to the history. It returns the
+// number of moved tasks.
+func (s *Storage) ArchiveCancelled(_ context.Context, now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var archived int
+	for i := 0; i < len(s.tasks); {
+		task := s.tasks[i]
+		if task.State != service.Cancelled || task.GroupID != "" || task.LeaseExpiresAt.After(now) {
+			i++
+			continue
+		}
+		s.archiveCancelled(i)
+		archived++
+	}
+
+	return archived, nil
+}
+
+// archiveCancelled moves the cancelled task at index i of the queue to the history.
+func (s *Storage) archiveCancelled(i int) {
+	task := s.tasks[i]
+	task.FinishedAt = time.Now()
+	s.tasksHistory[task.ID] = task
+	s.tasks = append(s.tasks[:i], s.tasks[i+1:]...)
+}
+
+// AddTaskList adds a taskList and the tasks of its groups to the queue.
+func (s *Storage) AddTaskList(_ context.Context, taskList *service.TaskList, tasks []*service.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskList.Rank = service.Rank(taskList.CreatedAt, taskList.RunAt, taskList.Priority, s.priorityAging)
+	s.taskLists = append(s.taskLists, clone(taskList))
+	for _, task := range tasks {
+		s.tasks = append(s.tasks, clone(task))
+	}
+	return nil
+}
+
+// AckList removes a taskList from the queue.
+func (s *Storage) AckList(_ context.Context, taskList *service.TaskList) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.taskListIndex(taskList.ID); i >= 0 {
+		s.taskLists = append(s.taskLists[:i], s.taskLists[i+1:]...)
+	}
+	return nil
+}
+
+// PollList retrieves one taskList ordered by their rank which can be
+// executed now, updates its state to "pending" and leases it to the
+// given owner for the lease duration.
+func (s *Storage) PollList(_ context.Context, owner string, lease time.Duration) (*service.TaskList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var next *service.TaskList
+	for _, list := range s.taskLists {
+		if list.State != service.Created || list.RunAt.After(now) {
+			continue
+		}
+		if next == nil || queuedBefore(list.Rank, list.CreatedAt, next.Rank, next.CreatedAt) {
+			next = list
+		}
+	}
+
+	if next == nil {
+		return nil, errors.New(errors.NotFound, "taskList not found")
+	}
+
+	next.State = service.Pending
+	next.LeaseOwner = owner
+	next.LeaseExpiresAt = now.Add(lease)
+	return clone(next), nil
+}
+
+// ClaimTaskList updates the state of a "created" or "pending" taskList to
+// "pending" and leases it to the given owner. The retries of a "pending"
+// taskList are incremented.
+func (s *Storage) ClaimTaskList(_ context.Context, taskListID, owner string, lease time.Duration) (*service.TaskList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.taskListIndex(taskListID)
+	if i < 0 || (s.taskLists[i].State != service.Created && s.taskLists[i].State != service.Pending) {
+		return nil, errors.New(errors.NotFound, "taskList not found")
+	}
+
+	list := s.taskLists[i]
+	if list.State == service.Pending {
+		list.Retries++
+	}
+	list.State = service.Pending
+	list.LeaseOwner = owner
+	list.LeaseExpiresAt = time.Now().Add(lease)
+	return clone(list), nil
+}
+
+// HeartbeatList extends the lease of a pending taskList held by the lease
+// owner of the taskList. It returns a NotFound error if the taskList is no
+// longer held by the owner.
+func (s *Storage) HeartbeatList(_ context.Context, taskList *service.TaskList, lease time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.taskListIndex(taskList.ID)
+	if i < 0 || s.taskLists[i].State != service.Pending || s.taskLists[i].LeaseOwner != taskList.LeaseOwner {
+		return errors.New(errors.NotFound, "taskList lease not found")
+	}
+
+	s.taskLists[i].LeaseExpiresAt = time.Now().Add(lease)
+	return nil
+}
+
+// RequeueExpiredLists returns pending taskLists which lease has expired to
+// the queue and increments their retries. It returns the number of
+// requeued taskLists.
+func (s *Storage) RequeueExpiredLists(_ context.Context, now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requeued int
+	for _, list := range s.taskLists {
+		if list.State != service.Pending || list.LeaseExpiresAt.After(now) {
+			continue
+		}
+		list.State = service.Created
+		list.LeaseOwner = ""
+		list.Retries++
+		requeued++
+	}
+
+	return requeued, nil
+}
+
+// AckGroupTasks removes the tasks of a group from the queue.
+func (s *Storage) AckGroupTasks(_ context.Context, group *service.Group) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tasks := s.tasks[:0]
+	for _, task := range s.tasks {
+		if task.GroupID != group.ID {
+			tasks = append(tasks, task)
+		}
+	}
+	s.tasks = tasks
+	return nil
+}
+
+func (s *Storage) taskIndex(taskID string) int {
+	for i, task := range s.tasks {
+		if task.ID == taskID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Storage) taskListIndex(taskListID string) int {
+	for i, list := range s.taskLists {
+		if list.ID == taskListID {
+			return i
+		}
+	}
+	return -1
+}
+
+// queuedBefore reports whether an item with rank r1 and creation time t1
+// is taken from the queue before an item with rank r2 and creation time t2.
+func queuedBefore(r1, t1, r2, t2 time.Time) bool {
+	if r1.Equal(r2) {
+		return t1.Before(t2)
+	}
+	return r1.Before(r2)
+}
@@ -0,0 +1,856 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// Storage keeps templates, queued and finished tasks and taskLists and
+// all other data of the service in memory, so that the service can run
+// without a database, e.g. during development and in integration tests.
+// The data is lost when the service stops, and it isn't shared between
+// instances of the service, so it must not be used by multiple instances.
+//
+// It implements service.Storage and service.Queue with the same behaviour
+// as the MongoDB storage. Stored values are copied when they are saved and
+// retrieved, so that callers can't modify them without saving them.
+type Storage struct {
+	mu sync.Mutex
+
+	taskTemplates            map[string]*service.Task
+	taskTemplateVersions     map[string][]*service.Task
+	taskListTemplates        map[string]*service.Template
+	taskListTemplateVersions map[string][]*service.Template
+	tasks                    []*service.Task // tasks is the queue of tasks in insertion order.
+	tasksHistory             map[string]*service.Task
+	taskLists                []*service.TaskList // taskLists is the queue of taskLists in insertion order.
+	taskListHistory          map[string]*service.TaskList
+	eventTasks               map[eventTaskKey]*service.EventTask
+	schedules                map[string]*service.Schedule
+	idempotencyKeys          map[string]*service.IdempotencyKey
+	webhookDeliveries        map[string]*service.WebhookDelivery
+	deadLetters              map[string]*service.DeadLetter
+
+	// priorityAging is the time waited in the queue which
+	// equals one level of task and taskList priority.
+	priorityAging time.Duration
+}
+
+type eventTaskKey struct {
+	key, namespace, scope string
+}
+
+func New(priorityAging time.Duration) *Storage {
+	return &Storage{
+		priorityAging:            priorityAging,
+		taskTemplates:            make(map[string]*service.Task),
+		taskTemplateVersions:     make(map[string][]*service.Task),
+		taskListTemplates:        make(map[string]*service.Template),
+		taskListTemplateVersions: make(map[string][]*service.Template),
+		tasksHistory:             make(map[string]*service.Task),
+		taskListHistory:          make(map[string]*service.TaskList),
+		eventTasks:               make(map[eventTaskKey]*service.EventTask),
+		schedules:                make(map[string]*service.Schedule),
+		idempotencyKeys:          make(map[string]*service.IdempotencyKey),
+		webhookDeliveries:        make(map[string]*service.WebhookDelivery),
+		deadLetters:              make(map[string]*service.DeadLetter),
+	}
+}
+
+func (s *Storage) TaskTemplate(_ context.Context, taskName string) (*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, ok := s.taskTemplates[taskName]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "task template not found")
+	}
+	return clone(template), nil
+}
+
+// TaskTemplateVersion retrieves a specific version of a task template.
+func (s *Storage) TaskTemplateVersion(_ context.Context, taskName string, version int) (*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, template := range s.taskTemplateVersions[taskName] {
+		if template.TemplateVersion == version {
+			return clone(template), nil
+		}
+	}
+	return nil, errors.New(errors.NotFound, "task template version not found")
+}
+
+// AddTaskTemplate saves the first version of a new task template. If a
+// template with the same name was deleted, its kept versions are continued.
+func (s *Storage) AddTaskTemplate(_ context.Context, template *service.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.taskTemplates[template.Name]; ok {
+		return errors.New(errors.Exist, "task template already exists")
+	}
+
+	versions := s.taskTemplateVersions[template.Name]
+	template.TemplateVersion = len(versions) + 1
+	s.taskTemplates[template.Name] = clone(template)
+	s.taskTemplateVersions[template.Name] = append(versions, clone(template))
+	return nil
+}
+
+// UpdateTaskTemplate saves the template as a new version of an existing
+// task template and makes it the latest version.
+func (s *Storage) UpdateTaskTemplate(_ context.Context, template *service.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latest, ok := s.taskTemplates[template.Name]
+	if !ok {
+		return errors.New(errors.NotFound, "task template not found")
+	}
+
+	template.TemplateVersion = latest.TemplateVersion + 1
+	s.taskTemplates[template.Name] = clone(template)
+	s.taskTemplateVersions[template.Name] = append(s.taskTemplateVersions[template.Name], clone(template))
+	return nil
+}
+
+// DeleteTaskTemplate removes a task template. Its versions are kept,
+// because they can be used by pinned versions of taskList templates.
+func (s *Storage) DeleteTaskTemplate(_ context.Context, taskName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.taskTemplates[taskName]; !ok {
+		return errors.New(errors.NotFound, "task template not found")
+	}
+
+	delete(s.taskTemplates, taskName)
+	return nil
+}
+
+// ListTaskTemplates retrieves all task templates sorted by name.
+func (s *Storage) ListTaskTemplates(_ context.Context) ([]*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var templates []*service.Task
+	for _, template := range s.taskTemplates {
+		templates = append(templates, clone(template))
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates, nil
+}
+
+// TaskTemplates retrieves task templates by names.
+//
+// The result is a map where 'key' is the task name and 'value' is the task definition
+func (s *Storage) TaskTemplates(_ context.Context, names []string) (map[string]*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make(map[string]*service.Task)
+	for _, name := range names {
+		if template, ok := s.taskTemplates[name]; ok {
+			res[name] = clone(template)
+		}
+	}
+	return res, nil
+}
+
+// SaveTaskHistory saves a finished task in the history.
+func (s *Storage) SaveTaskHistory(_ context.Context, task *service.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tasksHistory[task.ID] = clone(task)
+	return nil
+}
+
+func (s *Storage) Task(_ context.Context, taskID string) (*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.taskIndex(taskID); i >= 0 {
+		return clone(s.tasks[i]), nil
+	}
+	return nil, errors.New(errors.NotFound, "task not found")
+}
+
+func (s *Storage) TaskHistory(_ context.Context, taskID string) (*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, ok := s.tasksHistory[taskID]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "task not found")
+	}
+	return clone(task), nil
+}
+
+// Tasks retrieves queued, finished and dead-lettered tasks matching the
+// filter sorted by creation time. A task which is queued and finished or
+// dead-lettered is returned only once, as it is found in the history or
+// the dead letters.
+func (s *Storage) Tasks(_ context.Context, f *service.Filter) ([]*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var tasks []*service.Task
+	for _, task := range s.tasksHistory {
+		if matches(f, task.Name, string(task.State), task.CacheNamespace, task.CacheScope, task.CreatedAt, task.ID) {
+			tasks = append(tasks, clone(task))
+		}
+	}
+	for _, letter := range s.deadLetters {
+		if _, ok := s.tasksHistory[letter.ID]; ok {
+			continue
+		}
+		task := letter.Task
+		if matches(f, task.Name, string(task.State), task.CacheNamespace, task.CacheScope, task.CreatedAt, task.ID) {
+			tasks = append(tasks, clone(task))
+		}
+	}
+	for _, task := range s.tasks {
+		if _, ok := s.tasksHistory[task.ID]; ok {
+			continue
+		}
+		if _, ok := s.deadLetters[task.ID]; ok {
+			continue
+		}
+		if matches(f, task.Name, string(task.State), task.CacheNamespace, task.CacheScope, task.CreatedAt, task.ID) {
+			tasks = append(tasks, clone(task))
+		}
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return sortedBefore(tasks[i].CreatedAt, tasks[i].ID, tasks[j].CreatedAt, tasks[j].ID, f.Descending)
+	})
+	if len(tasks) > f.Limit {
+		tasks = tasks[:f.Limit]
+	}
+
+	return tasks, nil
+}
+
+// TaskListTemplate retrieves one taskList template by name.
+func (s *Storage) TaskListTemplate(_ context.Context, taskListName string) (*service.Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, ok := s.taskListTemplates[taskListName]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "taskList template not found")
+	}
+	return clone(template), nil
+}
+
+// TaskListTemplateVersion retrieves a specific version of a taskList template.
+func (s *Storage) TaskListTemplateVersion(_ context.Context, taskListName string, version int) (*service.Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, template := range s.taskListTemplateVersions[taskListName] {
+		if template.TemplateVersion == version {
+			return clone(template), nil
+		}
+	}
+	return nil, errors.New(errors.NotFound, "taskList template version not found")
+}
+
+// AddTaskListTemplate saves the first version of a new taskList template. If a
+// template with the same name was deleted, its kept versions are continued.
+func (s *Storage) AddTaskListTemplate(_ context.Context, template *service.Template) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.taskListTemplates[template.Name]; ok {
+		return errors.New(errors.Exist, "taskList template already exists")
+	}
+
+	versions := s.taskListTemplateVersions[template.Name]
+	template.TemplateVersion = len(versions) + 1
+	s.taskListTemplates[template.Name] = clone(template)
+	s.taskListTemplateVersions[template.Name] = append(versions, clone(template))
+	return nil
+}
+
+// UpdateTaskListTemplate saves the template as a new version of an existing
+// taskList template and makes it the latest version.
+func (s *Storage) UpdateTaskListTemplate(_ context.Context, template *service.Template) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latest, ok := s.taskListTemplates[template.Name]
+	if !ok {
+		return errors.New(errors.NotFound, "taskList template not found")
+	}
+
+	template.TemplateVersion = latest.TemplateVersion + 1
+	s.taskListTemplates[template.Name] = clone(template)
+	s.taskListTemplateVersions[template.Name] = append(s.taskListTemplateVersions[template.Name], clone(template))
+	return nil
+}
+
+// DeleteTaskListTemplate removes a taskList template. Its versions are kept,
+// because they can be used by taskLists created from them.
+func (s *Storage) DeleteTaskListTemplate(_ context.Context, taskListName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.taskListTemplates[taskListName]; !ok {
+		return errors.New(errors.NotFound, "taskList template not found")
+	}
+
+	delete(s.taskListTemplates, taskListName)
+	return nil
+}
+
+// ListTaskListTemplates retrieves all taskList templates sorted by name.
+func (s *Storage) ListTaskListTemplates(_ context.Context) ([]*service.Template, error) {
+	return s.findTaskListTemplates(func(*service.Template) bool { return true }), nil
+}
+
+// TaskListTemplatesByTask retrieves the taskList templates containing
+// a task with the given name in any of their groups.
+func (s *Storage) TaskListTemplatesByTask(_ context.Context, taskName string) ([]*service.Template, error) {
+	return s.findTaskListTemplates(func(template *service.Template) bool {
+		for _, group := range template.Groups {
+			for _, name := range group.Tasks {
+				if name == taskName {
+					return true
+				}
+			}
+		}
+		return false
+	}), nil
+}
+
+func (s *Storage) findTaskListTemplates(match func(*service.Template) bool) []*service.Template {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var templates []*service.Template
+	for _, template := range s.taskListTemplates {
+		if match(template) {
+			templates = append(templates, clone(template))
+		}
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates
+}
+
+// GetGroupTasks fetches all tasks of a group sorted by their position in the group.
+func (s *Storage) GetGroupTasks(_ context.Context, group *service.Group) ([]*service.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var tasks []*service.Task
+	for _, task := range s.tasks {
+		if task.GroupID == group.ID {
+			tasks = append(tasks, clone(task))
+		}
+	}
+	sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].Position < tasks[j].Position })
+	return tasks, nil
+}
+
+// SaveTaskListHistory saves a finished taskList in the history.
+func (s *Storage) SaveTaskListHistory(_ context.Context, taskList *service.TaskList) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.taskListHistory[taskList.ID] = clone(taskList)
+	return nil
+}
+
+// TaskList retrieves a queued taskList by ID.
+func (s *Storage) TaskList(_ context.Context, taskListID string) (*service.TaskList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.taskListIndex(taskListID); i >= 0 {
+		return clone(s.taskLists[i]), nil
+	}
+	return nil, errors.New(errors.NotFound, "taskList not found")
+}
+
+// TaskListHistory retrieves a finished taskList by ID.
+func (s *Storage) TaskListHistory(_ context.Context, taskListID string) (*service.TaskList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list, ok := s.taskListHistory[taskListID]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "taskList not found")
+	}
+	return clone(list), nil
+}
+
+// TaskLists retrieves queued and finished taskLists matching the filter
+// sorted by creation time in the same way as Tasks.
+func (s *Storage) TaskLists(_ context.Context, f *service.Filter) ([]*service.TaskList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var lists []*service.TaskList
+	for _, list := range s.taskListHistory {
+		if matches(f, list.Name, string(list.State), list.CacheNamespace, list.CacheScope, list.CreatedAt, list.ID) {
+			lists = append(lists, clone(list))
+		}
+	}
+	for _, list := range s.taskLists {
+		if _, ok := s.taskListHistory[list.ID]; ok {
+			continue
+		}
+		if matches(f, list.Name, string(list.State), list.CacheNamespace, list.CacheScope, list.CreatedAt, list.ID) {
+			lists = append(lists, clone(list))
+		}
+	}
+
+	sort.Slice(lists, func(i, j int) bool {
+		return sortedBefore(lists[i].CreatedAt, lists[i].ID, lists[j].CreatedAt, lists[j].ID, f.Descending)
+	})
+	if len(lists) > f.Limit {
+		lists = lists[:f.Limit]
+	}
+
+	return lists, nil
+}
+
+func (s *Storage) EventTask(_ context.Context, key, namespace, scope string) (*service.EventTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	eventTask, ok := s.eventTasks[eventTaskKey{key, namespace, scope}]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "eventTask not found")
+	}
+	return clone(eventTask), nil
+}
+
+// AddEventTask saves a new event task binding.
+func (s *Storage) AddEventTask(_ context.Context, eventTask *service.EventTask) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := eventTaskKey{eventTask.Key, eventTask.Namespace, eventTask.Scope}
+	if _, ok := s.eventTasks[k]; ok {
+		return errors.New(errors.Exist, "eventTask already exists")
+	}
+	s.eventTasks[k] = clone(eventTask)
+	return nil
+}
+
+// DeleteEventTask removes the event task binding for the given
+// cache key, namespace and scope.
+func (s *Storage) DeleteEventTask(_ context.Context, key, namespace, scope string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := eventTaskKey{key, namespace, scope}
+	if _, ok := s.eventTasks[k]; !ok {
+		return errors.New(errors.NotFound, "eventTask not found")
+	}
+	delete(s.eventTasks, k)
+	return nil
+}
+
+// ListEventTasks retrieves all event task bindings sorted by cache key,
+// namespace and scope.
+func (s *Storage) ListEventTasks(_ context.Context) ([]*service.EventTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var eventTasks []*service.EventTask
+	for _, eventTask := range s.eventTasks {
+		eventTasks = append(eventTasks, clone(eventTask))
+	}
+	sort.Slice(eventTasks, func(i, j int) bool {
+		a, b := eventTasks[i], eventTasks[j]
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Scope < b.Scope
+	})
+	return eventTasks, nil
+}
+
+// AddSchedule saves a new schedule.
+func (s *Storage) AddSchedule(_ context.Context, schedule *service.Schedule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.schedules[schedule.ID] = clone(schedule)
+	return nil
+}
+
+func (s *Storage) Schedule(_ context.Context, scheduleID string) (*service.Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, ok := s.schedules[scheduleID]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "schedule not found")
+	}
+	return clone(schedule), nil
+}
+
+// DeleteSchedule removes a schedule.
+func (s *Storage) DeleteSchedule(_ context.Context, scheduleID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.schedules[scheduleID]; !ok {
+		return errors.New(errors.NotFound, "schedule not found")
+	}
+	delete(s.schedules, scheduleID)
+	return nil
+}
+
+// ListSchedules retrieves all schedules sorted by creation time.
+func (s *Storage) ListSchedules(_ context.Context) ([]*service.Schedule, error) {
+	schedules := s.findSchedules(func(*service.Schedule) bool { return true })
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].CreatedAt.Before(schedules[j].CreatedAt) })
+	return schedules, nil
+}
+
+// DueSchedules retrieves the schedules which next run time has come or
+// which have a pending run, sorted by the next run time.
+func (s *Storage) DueSchedules(_ context.Context, now time.Time) ([]*service.Schedule, error) {
+	schedules := s.findSchedules(func(schedule *service.Schedule) bool {
+		return !schedule.NextRunAt.After(now) || schedule.PendingRun != nil
+	})
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].NextRunAt.Before(schedules[j].NextRunAt) })
+	return schedules, nil
+}
+
+// ClaimSchedule moves the next run time of a schedule from the scheduled
+// time of the run to nextRunAt and records the run as pending. It returns
+// a NotFound error if the next run time of the schedule isn't the scheduled
+// time of the run or the schedule has a pending run, because the run was
+// already claimed.
+func (s *Storage) ClaimSchedule(_ context.Context, scheduleID string, nextRunAt time.Time, run *service.ScheduleRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, ok := s.schedules[scheduleID]
+	if !ok || !schedule.NextRunAt.Equal(run.ScheduledAt) || schedule.PendingRun != nil {
+		return errors.New(errors.NotFound, "schedule run is already claimed")
+	}
+	schedule.NextRunAt = nextRunAt
+	schedule.PendingRun = clone(run)
+	return nil
+}
+
+// ClaimPendingRun changes the claim time of a pending run of a schedule.
+// It returns a NotFound error if the run was claimed again or completed.
+func (s *Storage) ClaimPendingRun(_ context.Context, scheduleID string, run *service.ScheduleRun, claimedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, ok := s.schedules[scheduleID]
+	if !ok || !pendingRun(schedule, run.ID) || !schedule.PendingRun.ClaimedAt.Equal(run.ClaimedAt) {
+		return errors.New(errors.NotFound, "schedule run is already claimed")
+	}
+	schedule.PendingRun.ClaimedAt = claimedAt
+	return nil
+}
+
+// CompleteScheduleRun records a pending run of a schedule keeping only the
+// most recent runs, and removes the pending run.
+func (s *Storage) CompleteScheduleRun(_ context.Context, scheduleID string, run *service.ScheduleRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, ok := s.schedules[scheduleID]
+	if !ok || !pendingRun(schedule, run.ID) {
+		return nil
+	}
+
+	schedule.PendingRun = nil
+	schedule.LastRunAt = run.ScheduledAt
+	schedule.Runs = append(schedule.Runs, *run)
+	if len(schedule.Runs) > service.MaxScheduleRuns {
+		schedule.Runs = schedule.Runs[len(schedule.Runs)-service.MaxScheduleRuns:]
+	}
+	return nil
+}
+
+// DiscardScheduleRun removes a pending run of a schedule without recording it.
+func (s *Storage) DiscardScheduleRun(_ context.Context, scheduleID string, runID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if schedule, ok := s.schedules[scheduleID]; ok && pendingRun(schedule, runID) {
+		schedule.PendingRun = nil
+	}
+	return nil
+}
+
+// pendingRun reports whether the run with the given ID is pending.
+func pendingRun(schedule *service.Schedule, runID string) bool {
+	return schedule.PendingRun != nil && schedule.PendingRun.ID == runID
+}
+
+func (s *Storage) findSchedules(match func(*service.Schedule) bool) []*service.Schedule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var schedules []*service.Schedule
+	for _, schedule := range s.schedules {
+		if match(schedule) {
+			schedules = append(schedules, clone(schedule))
+		}
+	}
+	return schedules
+}
+
+// AddIdempotencyKey saves an idempotency key. An expired key with the same
+// value is replaced. If the key exists and is not expired, an Exist error
+// is returned.
+func (s *Storage) AddIdempotencyKey(_ context.Context, key *service.IdempotencyKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.idempotencyKeys[key.Key]; ok && existing.ExpiresAt.After(time.Now()) {
+		return errors.New(errors.Exist, "idempotency key already exists")
+	}
+	s.idempotencyKeys[key.Key] = clone(key)
+	return nil
+}
+
+// IdempotencyKey retrieves an idempotency key. Expired keys are removed
+// when they are retrieved.
+func (s *Storage) IdempotencyKey(_ context.Context, key string) (*service.IdempotencyKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idempotencyKey, ok := s.idempotencyKeys[key]
+	if ok && !idempotencyKey.ExpiresAt.After(time.Now()) {
+		delete(s.idempotencyKeys, key)
+		ok = false
+	}
+	if !ok {
+		return nil, errors.New(errors.NotFound, "idempotency key not found")
+	}
+	return clone(idempotencyKey), nil
+}
+
+// DeleteIdempotencyKey removes an idempotency key, so that it can be used again.
+func (s *Storage) DeleteIdempotencyKey(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.idempotencyKeys, key)
+	return nil
+}
+
+// AddWebhookDelivery saves a webhook delivery.
+func (s *Storage) AddWebhookDelivery(_ context.Context, delivery *service.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.webhookDeliveries[delivery.ID] = clone(delivery)
+	return nil
+}
+
+// PollWebhookDelivery retrieves one pending webhook delivery which next attempt
+// time has come. The next attempt time is moved forward by the lease duration,
+// so that the delivery is not retrieved again while it's being delivered.
+func (s *Storage) PollWebhookDelivery(_ context.Context, lease time.Duration) (*service.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var next *service.WebhookDelivery
+	for _, delivery := range s.webhookDeliveries {
+		if delivery.State != service.WebhookPending || delivery.NextAttemptAt.After(now) {
+			continue
+		}
+		if next == nil || delivery.NextAttemptAt.Before(next.NextAttemptAt) {
+			next = delivery
+		}
+	}
+
+	if next == nil {
+		return nil, errors.New(errors.NotFound, "webhook delivery not found")
+	}
+
+	next.NextAttemptAt = now.Add(lease)
+	return clone(next), nil
+}
+
+// UpdateWebhookDelivery saves the state and the attempts of a webhook delivery.
+func (s *Storage) UpdateWebhookDelivery(_ context.Context, delivery *service.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.webhookDeliveries[delivery.ID]; ok {
+		s.webhookDeliveries[delivery.ID] = clone(delivery)
+	}
+	return nil
+}
+
+// WebhookDeliveries retrieves the webhook deliveries of a task
+// or taskList sorted by creation time.
+func (s *Storage) WebhookDeliveries(_ context.Context, resourceID string) ([]*service.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deliveries []*service.WebhookDelivery
+	for _, delivery := range s.webhookDeliveries {
+		if delivery.ResourceID == resourceID {
+			deliveries = append(deliveries, clone(delivery))
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt) })
+	return deliveries, nil
+}
+
+// AddDeadLetter saves a task removed from the queue in the dead letters.
+// Adding the same task again replaces the previous dead letter.
+func (s *Storage) AddDeadLetter(_ context.Context, deadLetter *service.DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deadLetters[deadLetter.ID] = clone(deadLetter)
+	return nil
+}
+
+// DeadLetters retrieves dead letters from the newest to the oldest. If
+// taskName is not empty, only dead letters of tasks with this name are retrieved.
+func (s *Storage) DeadLetters(_ context.Context, taskName string, limit int) ([]*service.DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var letters []*service.DeadLetter
+	for _, letter := range s.deadLetters {
+		if taskName == "" || letter.Task.Name == taskName {
+			letters = append(letters, clone(letter))
+		}
+	}
+	sort.Slice(letters, func(i, j int) bool {
+		if letters[i].DeadLetteredAt.Equal(letters[j].DeadLetteredAt) {
+			return letters[i].ID < letters[j].ID
+		}
+		return letters[i].DeadLetteredAt.After(letters[j].DeadLetteredAt)
+	})
+	if limit > 0 && len(letters) > limit {
+		letters = letters[:limit]
+	}
+	return letters, nil
+}
+
+// DeadLetter retrieves the dead letter of a task.
+func (s *Storage) DeadLetter(_ context.Context, taskID string) (*service.DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	letter, ok := s.deadLetters[taskID]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "dead letter not found")
+	}
+	return clone(letter), nil
+}
+
+// DeleteDeadLetter removes the dead letter of a task and returns it.
+func (s *Storage) DeleteDeadLetter(_ context.Context, taskID string) (*service.DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	letter, ok := s.deadLetters[taskID]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "dead letter not found")
+	}
+	delete(s.deadLetters, taskID)
+	return letter, nil
+}
+
+// PurgeDeadLetters removes dead letters and returns their number. If taskName is not
+// empty, only dead letters of tasks with this name are removed. If before is not zero,
+// only the tasks which were removed from the queue before the given time are removed.
+func (s *Storage) PurgeDeadLetters(_ context.Context, taskName string, before time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var purged int
+	for id, letter := range s.deadLetters {
+		if taskName != "" && letter.Task.Name != taskName {
+			continue
+		}
+		if !before.IsZero() && !letter.DeadLetteredAt.Before(before) {
+			continue
+		}
+		delete(s.deadLetters, id)
+		purged++
+	}
+	return purged, nil
+}
+
+// matches reports whether an item with the given fields matches the search
+// criteria. When a cursor is given, only items positioned after it in the
+// sort order are matched.
+func matches(f *service.Filter, name, state, namespace, scope string, createdAt time.Time, id string) bool {
+	if f.Name != "" && name != f.Name {
+		return false
+	}
+	if f.State != "" && state != f.State {
+		return false
+	}
+	if f.CacheNamespace != "" && namespace != f.CacheNamespace {
+		return false
+	}
+	if f.CacheScope != "" && scope != f.CacheScope {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && createdAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !createdAt.Before(f.CreatedBefore) {
+		return false
+	}
+	if f.After != nil && !sortedBefore(f.After.CreatedAt, f.After.ID, createdAt, id, f.Descending) {
+		return false
+	}
+	return true
+}
+
+// sortedBefore reports whether an item with creation time t1 and id1 is
+// positioned before an item with creation time t2 and id2 in the sort order.
+func sortedBefore(t1 time.Time, id1 string, t2 time.Time, id2 string, descending bool) bool {
+	if t1.Equal(t2) {
+		if descending {
+			return id1 > id2
+		}
+		return id1 < id2
+	}
+	if descending {
+		return t1.After(t2)
+	}
+	return t1.Before(t2)
+}
+
+// clone returns a deep copy of a stored value. The stored
+// values are plain data, so encoding them can't fail.
+func clone[T any](v *T) *T {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	var c T
+	if err := json.Unmarshal(data, &c); err != nil {
+		panic(err)
+	}
+	return &c
+}
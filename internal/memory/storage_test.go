@@ -0,0 +1,310 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/memory"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+func TestStorage_Implements(t *testing.T) {
+	s := memory.New(time.Minute)
+	assert.Implements(t, (*service.Storage)(nil), s)
+	assert.Implements(t, (*service.Queue)(nil), s)
+}
+
+func TestStorage_PollBatch(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		tasks []*service.Task
+		limit int
+
+		polled  []string
+		errkind errors.Kind
+	}{
+		{
+			name:    "empty queue",
+			limit:   5,
+			errkind: errors.NotFound,
+		},
+		{
+			name: "tasks which can't be executed now are not polled",
+			tasks: []*service.Task{
+				{ID: "scheduled", State: service.Created, CreatedAt: now, RunAt: now.Add(time.Hour)},
+				{ID: "backoff", State: service.Created, CreatedAt: now, NextAttemptAt: now.Add(time.Hour)},
+				{ID: "pending", State: service.Pending, CreatedAt: now},
+				{ID: "group", GroupID: "123", State: service.Created, CreatedAt: now},
+			},
+			limit:   5,
+			errkind: errors.NotFound,
+		},
+		{
+			name: "tasks are polled by priority and creation time up to the limit",
+			tasks: []*service.Task{
+				{ID: "old", State: service.Created, CreatedAt: now.Add(-time.Second)},
+				{ID: "new", State: service.Created, CreatedAt: now},
+				{ID: "high", State: service.Created, CreatedAt: now, Priority: 5},
+			},
+			limit:  2,
+			polled: []string{"high", "old"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := memory.New(time.Minute)
+			for _, task := range test.tasks {
+				assert.NoError(t, s.Add(ctx, task))
+			}
+
+			tasks, err := s.PollBatch(ctx, "owner", time.Minute, test.limit)
+			if test.errkind != 0 {
+				assert.True(t, errors.Is(test.errkind, err))
+				return
+			}
+			assert.NoError(t, err)
+
+			var polled []string
+			for _, task := range tasks {
+				assert.Equal(t, service.State(service.Pending), task.State)
+				assert.Equal(t, "owner", task.LeaseOwner)
+				polled = append(polled, task.ID)
+			}
+			assert.Equal(t, test.polled, polled)
+		})
+	}
+}
+
+func TestStorage_Queue(t *testing.T) {
+	ctx := context.Background()
+	s := memory.New(time.Minute)
+
+	assert.NoError(t, s.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+
+	task, err := s.Poll(ctx, "owner", time.Minute)
+	assert.NoError(t, err)
+
+	// the returned task is a copy
+	task.Error = "some error"
+	stored, err := s.Task(ctx, "123")
+	assert.NoError(t, err)
+	assert.Empty(t, stored.Error)
+
+	// the task is returned to the queue after a failed execution
+	assert.NoError(t, s.Unack(ctx, task))
+	task, err = s.Poll(ctx, "owner", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, task.Retries)
+	assert.Equal(t, []string{"some error"}, task.Errors)
+
+	// the task is returned to the queue when its lease expires
+	requeued, err := s.RequeueExpired(ctx, time.Now().Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requeued)
+	task, err = s.Poll(ctx, "other", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, task.Retries)
+	assert.Equal(t, service.LeaseExpiredError, task.Error)
+
+	// the lease can't be extended by the previous owner
+	err = s.Heartbeat(ctx, &service.Task{ID: "123", LeaseOwner: "owner"}, time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+	assert.NoError(t, s.Heartbeat(ctx, task, time.Minute))
+
+	// the task can't be returned to the queue or removed by the previous owner
+	assert.NoError(t, s.Unack(ctx, &service.Task{ID: "123", LeaseOwner: "owner"}))
+	assert.NoError(t, s.Ack(ctx, &service.Task{ID: "123", LeaseOwner: "owner"}))
+	stored, err = s.Task(ctx, "123")
+	assert.NoError(t, err)
+	assert.Equal(t, service.State(service.Pending), stored.State)
+	assert.Equal(t, "other", stored.LeaseOwner)
+
+	assert.NoError(t, s.Ack(ctx, task))
+	_, err = s.Task(ctx, "123")
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
+
+func TestStorage_Tasks(t *testing.T) {
+	ctx := context.Background()
+	s := memory.New(time.Minute)
+
+	created := time.Now()
+	assert.NoError(t, s.SaveTaskHistory(ctx, &service.Task{ID: "1", Name: "task", State: service.Done, CreatedAt: created}))
+	assert.NoError(t, s.Add(ctx, &service.Task{ID: "2", Name: "task", State: service.Created, CreatedAt: created.Add(time.Second)}))
+
+	// a dead-lettered task which is not acknowledged yet is returned once
+	dead := &service.Task{ID: "3", Name: "task", State: service.Failed, CreatedAt: created.Add(2 * time.Second)}
+	assert.NoError(t, s.Add(ctx, &service.Task{ID: "3", Name: "task", State: service.Created, CreatedAt: dead.CreatedAt}))
+	assert.NoError(t, s.AddDeadLetter(ctx, &service.DeadLetter{ID: dead.ID, Task: dead, DeadLetteredAt: time.Now()}))
+
+	tests := []struct {
+		name   string
+		filter service.Filter
+
+		ids []string
+	}{
+		{
+			name:   "all tasks",
+			filter: service.Filter{Limit: 10},
+			ids:    []string{"1", "2", "3"},
+		},
+		{
+			name:   "failed tasks",
+			filter: service.Filter{State: service.Failed, Limit: 10},
+			ids:    []string{"3"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tasks, err := s.Tasks(ctx, &test.filter)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, task := range tasks {
+				ids = append(ids, task.ID)
+			}
+			assert.Equal(t, test.ids, ids)
+		})
+	}
+}
+
+func TestStorage_Cancel(t *testing.T) {
+	ctx := context.Background()
+	s := memory.New(time.Minute)
+
+	assert.NoError(t, s.Add(ctx, &service.Task{ID: "created", State: service.Created, CreatedAt: time.Now()}))
+	assert.NoError(t, s.Add(ctx, &service.Task{ID: "pending", State: service.Created, CreatedAt: time.Now()}))
+	_, err := s.ClaimTask(ctx, "pending", "owner", time.Minute)
+	assert.NoError(t, err)
+
+	// a created task is moved to the history immediately
+	before, err := s.Cancel(ctx, "created")
+	assert.NoError(t, err)
+	assert.Equal(t, service.State(service.Created), before.State)
+	_, err = s.Task(ctx, "created")
+	assert.True(t, errors.Is(errors.NotFound, err))
+	task, err := s.TaskHistory(ctx, "created")
+	assert.NoError(t, err)
+	assert.Equal(t, service.State(service.Cancelled), task.State)
+	assert.False(t, task.FinishedAt.IsZero())
+
+	// a pending task stays in the queue until its lease expires
+	before, err = s.Cancel(ctx, "pending")
+	assert.NoError(t, err)
+	assert.Equal(t, service.State(service.Pending), before.State)
+	archived, err := s.ArchiveCancelled(ctx, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, archived)
+
+	archived, err = s.ArchiveCancelled(ctx, time.Now().Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, archived)
+	_, err = s.Task(ctx, "pending")
+	assert.True(t, errors.Is(errors.NotFound, err))
+	task, err = s.TaskHistory(ctx, "pending")
+	assert.NoError(t, err)
+	assert.Equal(t, service.State(service.Cancelled), task.State)
+}
+
+func TestStorage_GetGroupTasks(t *testing.T) {
+	ctx := context.Background()
+	s := memory.New(time.Minute)
+
+	created := time.Now()
+	list := &service.TaskList{
+		ID:        "list",
+		State:     service.Created,
+		CreatedAt: created,
+		Groups:    []service.Group{{ID: "group", Execution: "sequential", Tasks: []string{"first", "second", "third"}}},
+	}
+	templates := map[string]*service.Task{"first": {Name: "first"}, "second": {Name: "second"}, "third": {Name: "third"}}
+	tasks, err := service.NewGroupTasks(list, templates)
+	require.NoError(t, err)
+
+	// the tasks are added in another order and created at the same time
+	for _, task := range tasks {
+		task.CreatedAt = created
+	}
+	tasks[0], tasks[2] = tasks[2], tasks[0]
+	require.NoError(t, s.AddTaskList(ctx, list, tasks))
+
+	stored, err := s.GetGroupTasks(ctx, &list.Groups[0])
+	require.NoError(t, err)
+	require.Len(t, stored, 3)
+	for i, name := range list.Groups[0].Tasks {
+		assert.Equal(t, name, stored[i].Name)
+		assert.Equal(t, i, stored[i].Position)
+	}
+}
+
+func TestStorage_TaskTemplateVersions(t *testing.T) {
+	ctx := context.Background()
+	s := memory.New(time.Minute)
+
+	assert.NoError(t, s.AddTaskTemplate(ctx, &service.Task{Name: "task", URL: "https://example.com/v1"}))
+	err := s.AddTaskTemplate(ctx, &service.Task{Name: "task"})
+	assert.True(t, errors.Is(errors.Exist, err))
+
+	assert.NoError(t, s.UpdateTaskTemplate(ctx, &service.Task{Name: "task", URL: "https://example.com/v2"}))
+
+	latest, err := s.TaskTemplate(ctx, "task")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, latest.TemplateVersion)
+	assert.Equal(t, "https://example.com/v2", latest.URL)
+
+	first, err := s.TaskTemplateVersion(ctx, "task", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/v1", first.URL)
+
+	// the versions are kept when the template is deleted
+	assert.NoError(t, s.DeleteTaskTemplate(ctx, "task"))
+	_, err = s.TaskTemplate(ctx, "task")
+	assert.True(t, errors.Is(errors.NotFound, err))
+	first, err = s.TaskTemplateVersion(ctx, "task", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/v1", first.URL)
+
+	// a new template with the same name continues the versions
+	template := &service.Task{Name: "task", URL: "https://example.com/v3"}
+	assert.NoError(t, s.AddTaskTemplate(ctx, template))
+	assert.Equal(t, 3, template.TemplateVersion)
+}
+
+func TestStorage_TaskListTemplateVersions(t *testing.T) {
+	ctx := context.Background()
+	s := memory.New(time.Minute)
+
+	assert.NoError(t, s.AddTaskListTemplate(ctx, &service.Template{Name: "list", CallbackURL: "https://example.com/v1"}))
+	err := s.AddTaskListTemplate(ctx, &service.Template{Name: "list"})
+	assert.True(t, errors.Is(errors.Exist, err))
+
+	assert.NoError(t, s.UpdateTaskListTemplate(ctx, &service.Template{Name: "list", CallbackURL: "https://example.com/v2"}))
+
+	latest, err := s.TaskListTemplate(ctx, "list")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, latest.TemplateVersion)
+	assert.Equal(t, "https://example.com/v2", latest.CallbackURL)
+
+	// the versions are kept when the template is deleted
+	assert.NoError(t, s.DeleteTaskListTemplate(ctx, "list"))
+	_, err = s.TaskListTemplate(ctx, "list")
+	assert.True(t, errors.Is(errors.NotFound, err))
+	first, err := s.TaskListTemplateVersion(ctx, "list", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/v1", first.CallbackURL)
+
+	// a new template with the same name continues the versions
+	template := &service.Template{Name: "list", CallbackURL: "https://example.com/v3"}
+	assert.NoError(t, s.AddTaskListTemplate(ctx, template))
+	assert.Equal(t, 3, template.TemplateVersion)
+}
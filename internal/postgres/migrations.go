@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// migrations contains the schema migrations in the order in which they are
+// applied. The version of a migration is its position in the list starting
+// from 1. Applied migrations must not be changed, changes of the schema are
+// made by appending new migrations.
+//
+// Every table keeps the JSON of the stored values in its `data` column and
+// copies of the fields which are used in queries in the other columns.
+var migrations = []string{
+	// 1: initial schema
+	`
+CREATE TABLE task_templates (
+	name TEXT PRIMARY KEY,
+	data JSONB NOT NULL
+);
+
+CREATE TABLE task_template_versions (
+	name    TEXT NOT NULL,
+	version INTEGER NOT NULL,
+	data    JSONB NOT NULL,
+	PRIMARY KEY (name, version)
+);
+
+CREATE TABLE task_list_templates (
+	name TEXT PRIMARY KEY,
+	data JSONB NOT NULL
+);
+
+CREATE TABLE task_list_template_versions (
+	name    TEXT NOT NULL,
+	version INTEGER NOT NULL,
+	data    JSONB NOT NULL,
+	PRIMARY KEY (name, version)
+);
+
+CREATE TABLE tasks (
+	id               TEXT PRIMARY KEY,
+	seq              BIGSERIAL NOT NULL,
+	group_id         TEXT NOT NULL,
+	name             TEXT NOT NULL,
+	state            TEXT NOT NULL,
+	cache_namespace  TEXT NOT NULL,
+	cache_scope      TEXT NOT NULL,
+	rank             TIMESTAMPTZ NOT NULL,
+	created_at       TIMESTAMPTZ NOT NULL,
+	run_at           TIMESTAMPTZ NOT NULL,
+	next_attempt_at  TIMESTAMPTZ NOT NULL,
+	lease_owner      TEXT NOT NULL,
+	lease_expires_at TIMESTAMPTZ NOT NULL,
+	data             JSONB NOT NULL
+);
+
+CREATE INDEX tasks_queue ON tasks (state, rank, created_at) WHERE group_id = '';
+CREATE INDEX tasks_lease ON tasks (state, lease_expires_at);
+CREATE INDEX tasks_group ON tasks (group_id, seq);
+CREATE INDEX tasks_search ON tasks (created_at, id);
+
+CREATE TABLE tasks_history (
+	id              TEXT PRIMARY KEY,
+	name            TEXT NOT NULL,
+	state           TEXT NOT NULL,
+	cache_namespace TEXT NOT NULL,
+	cache_scope     TEXT NOT NULL,
+	created_at      TIMESTAMPTZ NOT NULL,
+	data            JSONB NOT NULL
+);
+
+CREATE INDEX tasks_history_search ON tasks_history (created_at, id);
+
+CREATE TABLE task_lists (
+	id               TEXT PRIMARY KEY,
+	name             TEXT NOT NULL,
+	state            TEXT NOT NULL,
+	cache_namespace  TEXT NOT NULL,
+	cache_scope      TEXT NOT NULL,
+	rank             TIMESTAMPTZ NOT NULL,
+	created_at       TIMESTAMPTZ NOT NULL,
+	run_at           TIMESTAMPTZ NOT NULL,
+	lease_owner      TEXT NOT NULL,
+	lease_expires_at TIMESTAMPTZ NOT NULL,
+	data             JSONB NOT NULL
+);
+
+CREATE INDEX task_lists_queue ON task_lists (state, rank, created_at);
+CREATE INDEX task_lists_lease ON task_lists (state, lease_expires_at);
+CREATE INDEX task_lists_search ON task_lists (created_at, id);
+
+CREATE TABLE task_list_history (
+	id              TEXT PRIMARY KEY,
+	name            TEXT NOT NULL,
+	state           TEXT NOT NULL,
+	cache_namespace TEXT NOT NULL,
+	cache_scope     TEXT NOT NULL,
+	created_at      TIMESTAMPTZ NOT NULL,
+	data            JSONB NOT NULL
+);
+
+CREATE INDEX task_list_history_search ON task_list_history (created_at, id);
+
+CREATE TABLE event_tasks (
+	key       TEXT NOT NULL,
+	namespace TEXT NOT NULL,
+	scope     TEXT NOT NULL,
+	data      JSONB NOT NULL,
+	PRIMARY KEY (key, namespace, scope)
+);
+
+CREATE TABLE schedules (
+	id          TEXT PRIMARY KEY,
+	next_run_at TIMESTAMPTZ NOT NULL,
+	created_at  TIMESTAMPTZ NOT NULL,
+	data        JSONB NOT NULL
+);
+
+CREATE INDEX schedules_next_run ON schedules (next_run_at);
+
+CREATE TABLE idempotency_keys (
+	key        TEXT PRIMARY KEY,
+	expires_at TIMESTAMPTZ NOT NULL,
+	data       JSONB NOT NULL
+);
+
+CREATE TABLE webhook_deliveries (
+	id              TEXT PRIMARY KEY,
+	resource_id     TEXT NOT NULL,
+	state           TEXT NOT NULL,
+	next_attempt_at TIMESTAMPTZ NOT NULL,
+	created_at      TIMESTAMPTZ NOT NULL,
+	data            JSONB NOT NULL
+);
+
+CREATE INDEX webhook_deliveries_due ON webhook_deliveries (state, next_attempt_at);
+CREATE INDEX webhook_deliveries_resource ON webhook_deliveries (resource_id);
+
+CREATE TABLE dead_letters (
+	id               TEXT PRIMARY KEY,
+	task_name        TEXT NOT NULL,
+	dead_lettered_at TIMESTAMPTZ NOT NULL,
+	data             JSONB NOT NULL
+);
+
+CREATE INDEX dead_letters_name ON dead_letters (task_name, dead_lettered_at DESC);
+CREATE INDEX dead_letters_time ON dead_letters (dead_lettered_at DESC);
+`,
+}
+
+// Migrate applies the schema migrations which are not applied yet and
+// records their versions in the `schema_migrations` table. Each migration
+// is applied in its own transaction, and concurrent migrations by several
+// instances of the service are serialized with a lock on the table. It
+// returns an error if the schema was migrated by a newer version of the
+// service, which has migrations unknown to this version.
+func (s *Storage) Migrate(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    INTEGER PRIMARY KEY,
+	applied_at TIMESTAMPTZ NOT NULL
+)`)
+	// the table may be created concurrently by another instance
+	// which makes the statement fail despite IF NOT EXISTS
+	if err != nil && !uniqueViolation(err) && !duplicateTable(err) {
+		return errors.New("error creating schema migrations table", err)
+	}
+
+	for {
+		applied, err := s.migrate(ctx)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return nil
+		}
+	}
+}
+
+// migrate applies the next migration and reports whether one was applied.
+func (s *Storage) migrate(ctx context.Context) (applied bool, err error) {
+	err = s.tx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `LOCK TABLE schema_migrations IN EXCLUSIVE MODE`); err != nil {
+			return err
+		}
+
+		var version int
+		row := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`)
+		if err := row.Scan(&version); err != nil {
+			return err
+		}
+
+		if version > len(migrations) {
+			return errors.New(fmt.Sprintf("database schema version %d is newer than the latest known version %d", version, len(migrations)))
+		}
+		if version == len(migrations) {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, migrations[version]); err != nil {
+			return errors.New(fmt.Sprintf("error applying migration %d", version+1), err)
+		}
+
+		_, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, applied_at) VALUES ($1, $2)`, version+1, time.Now())
+		if err != nil {
+			return err
+		}
+
+		applied = true
+		return nil
+	})
+	return applied, err
+}
@@ -0,0 +1,51 @@
+package postgres_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/postgres"
+)
+
+func TestStorage_Migrate(t *testing.T) {
+	ctx := context.Background()
+	db := connect(t)
+
+	// concurrent migrations apply every migration once
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = postgres.New(db, time.Minute).Migrate(ctx)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	var count, version int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*), MAX(version) FROM schema_migrations`).Scan(&count, &version))
+	assert.Greater(t, version, 0)
+	assert.Equal(t, version, count)
+
+	// migrating a migrated schema does nothing
+	require.NoError(t, postgres.New(db, time.Minute).Migrate(ctx))
+	var again int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations`).Scan(&again))
+	assert.Equal(t, count, again)
+
+	// a schema migrated by a newer version is not migrated
+	_, err := db.ExecContext(ctx, `INSERT INTO schema_migrations (version, applied_at) VALUES ($1, $2)`, version+1, time.Now())
+	require.NoError(t, err)
+	err = postgres.New(db, time.Minute).Migrate(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is newer than the latest known version")
+}
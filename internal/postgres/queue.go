@@ -0,0 +1,429 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// Add inserts a task in the queue. The creation time is truncated to the
+// precision of PostgreSQL timestamps, so that the stored JSON and the
+// column used by search cursors have the same value.
+func (s *Storage) Add(ctx context.Context, task *service.Task) error {
+	task.CreatedAt = task.CreatedAt.Truncate(time.Microsecond)
+	task.Rank = service.Rank(task.CreatedAt, task.RunAt, task.Priority, s.priorityAging)
+	return saveTask(ctx, s.db, task)
+}
+
+// Poll retrieves one task with empty groupID from the queue and leases
+// it to the given owner, see PollBatch.
+func (s *Storage) Poll(ctx context.Context, owner string, lease time.Duration) (*service.Task, error) {
+	tasks, err := s.PollBatch(ctx, owner, lease, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tasks[0], nil
+}
+
+// PollBatch retrieves up to limit tasks ordered by their rank which can be
+// executed now, updates their state to "pending" and leases them to the
+// given owner for the lease duration. Rows locked by other transactions are
+// skipped, so concurrent polls never retrieve the same task. If there are no
+// tasks to execute, it returns a NotFound error.
+func (s *Storage) PollBatch(ctx context.Context, owner string, lease time.Duration, limit int) ([]*service.Task, error) {
+	var tasks []*service.Task
+	err := s.tx(ctx, func(tx *sql.Tx) error {
+		now := time.Now()
+		var err error
+		tasks, err = list[service.Task](ctx, tx, `
+SELECT data FROM tasks
+WHERE state = $1 AND group_id = '' AND run_at <= $2 AND next_attempt_at <= $2
+ORDER BY rank, created_at
+LIMIT $3
+FOR UPDATE SKIP LOCKED`, service.Created, now, limit)
+		if err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			return errors.New(errors.NotFound, "task not found")
+		}
+
+		for _, task := range tasks {
+			task.State = service.Pending
+			task.StartedAt = now
+			task.LeaseOwner = owner
+			task.LeaseExpiresAt = now.Add(lease)
+			if err := saveTask(ctx, tx, task); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// ClaimTask updates the state of a "created" or "pending" task with empty
+// groupID to "pending" and leases it to the given owner. It's used by queues
+// which deliver task IDs and keep the tasks in the storage. Claiming a
+// "pending" task means that its previous owner failed to finish it, so it
+// counts as a failed execution attempt.
+func (s *Storage) ClaimTask(ctx context.Context, taskID, owner string, lease time.Duration) (*service.Task, error) {
+	var task service.Task
+	err := s.tx(ctx, func(tx *sql.Tx) error {
+		err := get(ctx, tx, &task, "task not found", `
+SELECT data FROM tasks WHERE id = $1 AND group_id = '' AND state IN ($2, $3) FOR UPDATE`,
+			taskID, service.Created, service.Pending)
+		if err != nil {
+			return err
+		}
+
+		if task.State == service.Pending {
+			task.Retries++
+			task.Error = service.LeaseExpiredError
+			task.Errors = service.AppendError(task.Errors, service.LeaseExpiredError)
+		}
+
+		now := time.Now()
+		task.State = service.Pending
+		task.StartedAt = now
+		task.LeaseOwner = owner
+		task.LeaseExpiresAt = now.Add(lease)
+		return saveTask(ctx, tx, &task)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// Heartbeat extends the lease of a pending task held by the lease owner of
+// the task. It returns a NotFound error if the task is no longer held by
+// the owner.
+func (s *Storage) Heartbeat(ctx context.Context, task *service.Task, lease time.Duration) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		var stored service.Task
+		err := get(ctx, tx, &stored, "task lease not found", `
+SELECT data FROM tasks WHERE id = $1 AND state = $2 AND lease_owner = $3 FOR UPDATE`,
+			task.ID, service.Pending, task.LeaseOwner)
+		if err != nil {
+			return err
+		}
+
+		stored.LeaseExpiresAt = time.Now().Add(lease)
+		return saveTask(ctx, tx, &stored)
+	})
+}
+
+// RequeueExpired returns pending tasks with empty groupID which lease has
+// expired to the queue and counts it as a failed execution attempt.
+// It returns the number of requeued tasks.
+func (s *Storage) RequeueExpired(ctx context.Context, now time.Time) (int, error) {
+	var requeued int
+	err := s.tx(ctx, func(tx *sql.Tx) error {
+		tasks, err := list[service.Task](ctx, tx, `
+SELECT data FROM tasks WHERE state = $1 AND group_id = '' AND lease_expires_at <= $2 FOR UPDATE SKIP LOCKED`,
+			service.Pending, now)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			task.State = service.Created
+			task.Error = service.LeaseExpiredError
+			task.LeaseOwner = ""
+			task.Retries++
+			task.Errors = service.AppendError(task.Errors, service.LeaseExpiredError)
+			if err := saveTask(ctx, tx, task); err != nil {
+				return err
+			}
+		}
+
+		requeued = len(tasks)
+		return nil
+	})
+	return requeued, err
+}
+
+// Ack removes a task from the queue, unless the task was
+// leased to another owner in the meantime.
+func (s *Storage) Ack(ctx context.Context, task *service.Task) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM tasks WHERE id = $1 AND lease_owner = $2`, task.ID, task.LeaseOwner)
+	return err
+}
+
+// Unack changes the "pending" state of a task to "created", so that
+// it can be retrieved for processing again after its next attempt
+// time. Cancelled tasks and tasks which were leased to another
+// owner in the meantime are not changed.
+func (s *Storage) Unack(ctx context.Context, t *service.Task) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		var task service.Task
+		err := get(ctx, tx, &task, "task not found", `SELECT data FROM tasks WHERE id = $1 AND lease_owner = $2 AND state <> $3 FOR UPDATE`, t.ID, t.LeaseOwner, service.Cancelled)
+		if errors.Is(errors.NotFound, err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		task.State = service.Created
+		task.Retries = t.Retries + 1
+		task.Error = t.Error
+		task.FailureReason = t.FailureReason
+		task.NextAttemptAt = t.NextAttemptAt
+		task.ResponseCode = t.ResponseCode
+		task.Response = t.Response
+		task.Errors = service.AppendError(task.Errors, t.Error)
+		return saveTask(ctx, tx, &task)
+	})
+}
+
+// Cancel changes the state of a "created" or "pending" task with
+// empty groupID to "cancelled" and returns the task as it was before
+// the cancellation. A "created" task is moved to the history in the
+// same transaction, see storage.Cancel.
+func (s *Storage) Cancel(ctx context.Context, taskID string) (*service.Task, error) {
+	var before service.Task
+	err := s.tx(ctx, func(tx *sql.Tx) error {
+		err := get(ctx, tx, &before, "task not found", `
+SELECT data FROM tasks WHERE id = $1 AND group_id = '' AND state IN ($2, $3) FOR UPDATE`,
+			taskID, service.Created, service.Pending)
+		if err != nil {
+			return err
+		}
+
+		task := before
+		task.State = service.Cancelled
+		if before.State == service.Created {
+			return archiveCancelled(ctx, tx, &task)
+		}
+		return saveTask(ctx, tx, &task)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &before, nil
+}
+
+// ArchiveCancelled moves cancelled tasks with empty groupID which are not
+// leased, or which lease has expired, to the `tasks_history` table. It
+// returns the number of moved tasks.
+func (s *Storage) ArchiveCancelled(ctx context.Context, now time.Time) (int, error) {
+	var archived int
+	err := s.tx(ctx, func(tx *sql.Tx) error {
+		tasks, err := list[service.Task](ctx, tx, `
+SELECT data FROM tasks WHERE state = $1 AND group_id = '' AND lease_expires_at <= $2 FOR UPDATE SKIP LOCKED`,
+			service.Cancelled, now)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			if err := archiveCancelled(ctx, tx, task); err != nil {
+				return err
+			}
+		}
+
+		archived = len(tasks)
+		return nil
+	})
+	return archived, err
+}
+
+// archiveCancelled saves a cancelled task in the `tasks_history` table
+// and removes it from the `tasks` table.
+func archiveCancelled(ctx context.Context, tx *sql.Tx, task *service.Task) error {
+	task.FinishedAt = time.Now()
+	if err := saveTaskHistory(ctx, tx, task); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `DELETE FROM tasks WHERE id = $1`, task.ID)
+	return err
+}
+
+// AddTaskList adds a taskList and the tasks of its groups to the queue
+// in a single transaction, so that a taskList is never queued without
+// its tasks.
+func (s *Storage) AddTaskList(ctx context.Context, taskList *service.TaskList, tasks []*service.Task) error {
+	taskList.CreatedAt = taskList.CreatedAt.Truncate(time.Microsecond)
+	taskList.Rank = service.Rank(taskList.CreatedAt, taskList.RunAt, taskList.Priority, s.priorityAging)
+
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		if err := saveTaskList(ctx, tx, taskList); err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			task.CreatedAt = task.CreatedAt.Truncate(time.Microsecond)
+			if err := saveTask(ctx, tx, task); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// AckList removes a taskList from the queue.
+func (s *Storage) AckList(ctx context.Context, taskList *service.TaskList) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM task_lists WHERE id = $1`, taskList.ID)
+	return err
+}
+
+// PollList retrieves one taskList ordered by their rank which can be
+// executed now, updates its state to "pending" and leases it to the
+// given owner for the lease duration. Rows locked by other transactions
+// are skipped.
+func (s *Storage) PollList(ctx context.Context, owner string, lease time.Duration) (*service.TaskList, error) {
+	var taskList service.TaskList
+	err := s.tx(ctx, func(tx *sql.Tx) error {
+		now := time.Now()
+		err := get(ctx, tx, &taskList, "taskList not found", `
+SELECT data FROM task_lists
+WHERE state = $1 AND run_at <= $2
+ORDER BY rank, created_at
+LIMIT 1
+FOR UPDATE SKIP LOCKED`, service.Created, now)
+		if err != nil {
+			return err
+		}
+
+		taskList.State = service.Pending
+		taskList.LeaseOwner = owner
+		taskList.LeaseExpiresAt = now.Add(lease)
+		return saveTaskList(ctx, tx, &taskList)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &taskList, nil
+}
+
+// ClaimTaskList updates the state of a "created" or "pending" taskList to
+// "pending" and leases it to the given owner. The retries of a "pending"
+// taskList are incremented.
+func (s *Storage) ClaimTaskList(ctx context.Context, taskListID, owner string, lease time.Duration) (*service.TaskList, error) {
+	var taskList service.TaskList
+	err := s.tx(ctx, func(tx *sql.Tx) error {
+		err := get(ctx, tx, &taskList, "taskList not found",
+			`SELECT data FROM task_lists WHERE id = $1 AND state IN ($2, $3) FOR UPDATE`,
+			taskListID, service.Created, service.Pending)
+		if err != nil {
+			return err
+		}
+
+		if taskList.State == service.Pending {
+			taskList.Retries++
+		}
+		taskList.State = service.Pending
+		taskList.LeaseOwner = owner
+		taskList.LeaseExpiresAt = time.Now().Add(lease)
+		return saveTaskList(ctx, tx, &taskList)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &taskList, nil
+}
+
+// HeartbeatList extends the lease of a pending taskList held by the lease
+// owner of the taskList. It returns a NotFound error if the taskList is no
+// longer held by the owner.
+func (s *Storage) HeartbeatList(ctx context.Context, taskList *service.TaskList, lease time.Duration) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		var stored service.TaskList
+		err := get(ctx, tx, &stored, "taskList lease not found", `
+SELECT data FROM task_lists WHERE id = $1 AND state = $2 AND lease_owner = $3 FOR UPDATE`,
+			taskList.ID, service.Pending, taskList.LeaseOwner)
+		if err != nil {
+			return err
+		}
+
+		stored.LeaseExpiresAt = time.Now().Add(lease)
+		return saveTaskList(ctx, tx, &stored)
+	})
+}
+
+// RequeueExpiredLists returns pending taskLists which lease has expired to
+// the queue and increments their retries. It returns the number of
+// requeued taskLists.
+func (s *Storage) RequeueExpiredLists(ctx context.Context, now time.Time) (int, error) {
+	var requeued int
+	err := s.tx(ctx, func(tx *sql.Tx) error {
+		lists, err := list[service.TaskList](ctx, tx, `
+SELECT data FROM task_lists WHERE state = $1 AND lease_expires_at <= $2 FOR UPDATE SKIP LOCKED`,
+			service.Pending, now)
+		if err != nil {
+			return err
+		}
+
+		for _, taskList := range lists {
+			taskList.State = service.Created
+			taskList.LeaseOwner = ""
+			taskList.Retries++
+			if err := saveTaskList(ctx, tx, taskList); err != nil {
+				return err
+			}
+		}
+
+		requeued = len(lists)
+		return nil
+	})
+	return requeued, err
+}
+
+// AckGroupTasks removes the tasks of a group from the queue.
+func (s *Storage) AckGroupTasks(ctx context.Context, group *service.Group) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM tasks WHERE group_id = $1`, group.ID)
+	return err
+}
+
+// saveTask inserts a task in the `tasks` table or replaces the stored task.
+// The sequence number of a replaced task is kept, so that group tasks are
+// always returned in the order in which they were added.
+func saveTask(ctx context.Context, q querier, task *service.Task) error {
+	data, err := encode(task)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecContext(ctx, `
+INSERT INTO tasks (id, group_id, name, state, cache_namespace, cache_scope, rank, created_at, run_at, next_attempt_at, lease_owner, lease_expires_at, data)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+ON CONFLICT (id) DO UPDATE SET
+	state = EXCLUDED.state,
+	next_attempt_at = EXCLUDED.next_attempt_at,
+	lease_owner = EXCLUDED.lease_owner,
+	lease_expires_at = EXCLUDED.lease_expires_at,
+	data = EXCLUDED.data`,
+		task.ID, task.GroupID, task.Name, task.State, task.CacheNamespace, task.CacheScope, task.Rank,
+		task.CreatedAt, task.RunAt, task.NextAttemptAt, task.LeaseOwner, task.LeaseExpiresAt, data)
+	return err
+}
+
+// saveTaskList inserts a taskList in the `task_lists` table or replaces
+// the stored taskList.
+func saveTaskList(ctx context.Context, q querier, taskList *service.TaskList) error {
+	data, err := encode(taskList)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecContext(ctx, `
+INSERT INTO task_lists (id, name, state, cache_namespace, cache_scope, rank, created_at, run_at, lease_owner, lease_expires_at, data)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (id) DO UPDATE SET
+	state = EXCLUDED.state,
+	lease_owner = EXCLUDED.lease_owner,
+	lease_expires_at = EXCLUDED.lease_expires_at,
+	data = EXCLUDED.data`,
+		taskList.ID, taskList.Name, taskList.State, taskList.CacheNamespace, taskList.CacheScope, taskList.Rank,
+		taskList.CreatedAt, taskList.RunAt, taskList.LeaseOwner, taskList.LeaseExpiresAt, data)
+	return err
+}
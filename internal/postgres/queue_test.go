@@ -0,0 +1,205 @@
+package postgres_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/postgres"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+func TestStorage_PollBatch(t *testing.T) {
+	ctx := context.Background()
+	db := connect(t)
+	s := postgres.New(db, time.Minute)
+	require.NoError(t, s.Migrate(ctx))
+
+	created := time.Now()
+	for i, id := range []string{"1", "2", "3"} {
+		require.NoError(t, s.Add(ctx, &service.Task{ID: id, State: service.Created, CreatedAt: created.Add(time.Duration(i) * time.Second)}))
+	}
+
+	// a task locked by another transaction is skipped
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback() }()
+	_, err = tx.ExecContext(ctx, `SELECT id FROM tasks WHERE id = '1' FOR UPDATE`)
+	require.NoError(t, err)
+
+	tasks, err := s.PollBatch(ctx, "owner", time.Minute, 3)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "2", tasks[0].ID)
+	assert.Equal(t, "3", tasks[1].ID)
+	for _, task := range tasks {
+		assert.Equal(t, service.State(service.Pending), task.State)
+		assert.Equal(t, "owner", task.LeaseOwner)
+	}
+
+	_, err = s.PollBatch(ctx, "owner", time.Minute, 3)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	require.NoError(t, tx.Rollback())
+	tasks, err = s.PollBatch(ctx, "owner", time.Minute, 3)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "1", tasks[0].ID)
+}
+
+func TestStorage_PollBatchConcurrent(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+
+	for i := 0; i < 20; i++ {
+		require.NoError(t, s.Add(ctx, &service.Task{ID: string(rune('a' + i)), State: service.Created, CreatedAt: time.Now()}))
+	}
+
+	var mu sync.Mutex
+	polled := make(map[string]int)
+	poll := func() {
+		tasks, err := s.PollBatch(ctx, "owner", time.Minute, 3)
+		if errors.Is(errors.NotFound, err) || !assert.NoError(t, err) {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, task := range tasks {
+			polled[task.ID]++
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			poll()
+		}()
+	}
+	wg.Wait()
+
+	// the remaining tasks are polled afterwards
+	for len(polled) < 20 {
+		n := len(polled)
+		poll()
+		require.Greater(t, len(polled), n)
+	}
+
+	// no task is polled twice
+	for id, n := range polled {
+		assert.Equal(t, 1, n, id)
+	}
+}
+
+func TestStorage_AddTaskList(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+
+	list := &service.TaskList{ID: "list1", State: service.Created, CreatedAt: time.Now()}
+	tasks := []*service.Task{
+		{ID: "task1", GroupID: "group1", State: service.Created, CreatedAt: time.Now()},
+		{ID: "task2", GroupID: "group1", State: service.Created, CreatedAt: time.Now()},
+	}
+	require.NoError(t, s.AddTaskList(ctx, list, tasks))
+
+	_, err := s.TaskList(ctx, "list1")
+	assert.NoError(t, err)
+	stored, err := s.GetGroupTasks(ctx, &service.Group{ID: "group1"})
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+	assert.Equal(t, "task1", stored[0].ID)
+	assert.Equal(t, "task2", stored[1].ID)
+
+	// group tasks are not polled as single tasks
+	_, err = s.Poll(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	// nothing is added if a task fails to be saved,
+	// PostgreSQL rejects text with NUL characters
+	list = &service.TaskList{ID: "list2", State: service.Created, CreatedAt: time.Now()}
+	tasks = []*service.Task{
+		{ID: "task3", GroupID: "group2", State: service.Created, CreatedAt: time.Now()},
+		{ID: "task4", GroupID: "group2", Name: "task\x00", State: service.Created, CreatedAt: time.Now()},
+	}
+	require.Error(t, s.AddTaskList(ctx, list, tasks))
+
+	_, err = s.TaskList(ctx, "list2")
+	assert.True(t, errors.Is(errors.NotFound, err))
+	_, err = s.Task(ctx, "task3")
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
+
+func TestStorage_ClaimTask(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+
+	require.NoError(t, s.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+	require.NoError(t, s.AddTaskList(ctx, &service.TaskList{ID: "list", State: service.Created, CreatedAt: time.Now()},
+		[]*service.Task{{ID: "456", GroupID: "group", State: service.Created, CreatedAt: time.Now()}}))
+
+	task, err := s.ClaimTask(ctx, "123", "owner", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, service.State(service.Pending), task.State)
+	assert.Equal(t, "owner", task.LeaseOwner)
+	assert.Equal(t, 0, task.Retries)
+
+	// claiming a pending task counts as a failed attempt of the previous owner
+	task, err = s.ClaimTask(ctx, "123", "other", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "other", task.LeaseOwner)
+	assert.Equal(t, 1, task.Retries)
+	assert.Equal(t, service.LeaseExpiredError, task.Error)
+
+	// the task is no longer acknowledged by the previous owner
+	require.NoError(t, s.Ack(ctx, &service.Task{ID: "123", LeaseOwner: "owner"}))
+	_, err = s.Task(ctx, "123")
+	assert.NoError(t, err)
+
+	_, err = s.ClaimTask(ctx, "456", "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+	_, err = s.ClaimTask(ctx, "789", "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
+
+func TestStorage_RequeueExpired(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+
+	require.NoError(t, s.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+	require.NoError(t, s.Add(ctx, &service.Task{ID: "456", State: service.Created, CreatedAt: time.Now().Add(time.Second)}))
+
+	expired, err := s.Poll(ctx, "owner", -time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "123", expired.ID)
+	leased, err := s.Poll(ctx, "owner", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "456", leased.ID)
+
+	n, err := s.RequeueExpired(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	task, err := s.Task(ctx, "123")
+	require.NoError(t, err)
+	assert.Equal(t, service.State(service.Created), task.State)
+	assert.Equal(t, "", task.LeaseOwner)
+	assert.Equal(t, 1, task.Retries)
+	assert.Equal(t, service.LeaseExpiredError, task.Error)
+
+	task, err = s.Task(ctx, "456")
+	require.NoError(t, err)
+	assert.Equal(t, service.State(service.Pending), task.State)
+
+	// the requeued task is polled again
+	task, err = s.Poll(ctx, "other", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "123", task.ID)
+	assert.Equal(t, "other", task.LeaseOwner)
+}
@@ -0,0 +1,939 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// Storage keeps the data of the service in a PostgreSQL database and
+// implements service.Storage and service.Queue with the same behaviour
+// as the MongoDB storage. The schema is created and updated by Migrate.
+//
+// Tasks and taskLists are taken from the queue with SELECT ... FOR UPDATE
+// SKIP LOCKED, so that several instances of the service can poll the queue
+// concurrently without waiting for each other or taking the same rows.
+type Storage struct {
+	db *sql.DB
+
+	// priorityAging is the time waited in the queue which
+	// equals one level of task and taskList priority.
+	priorityAging time.Duration
+}
+
+// querier is implemented by *sql.DB and *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func New(db *sql.DB, priorityAging time.Duration) *Storage {
+	return &Storage{
+		db:            db,
+		priorityAging: priorityAging,
+	}
+}
+
+func (s *Storage) TaskTemplate(ctx context.Context, taskName string) (*service.Task, error) {
+	var task service.Task
+	err := get(ctx, s.db, &task, "task template not found", `SELECT data FROM task_templates WHERE name = $1`, taskName)
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// TaskTemplateVersion retrieves a specific version of a task template.
+func (s *Storage) TaskTemplateVersion(ctx context.Context, taskName string, version int) (*service.Task, error) {
+	var task service.Task
+	err := get(ctx, s.db, &task, "task template version not found",
+		`SELECT data FROM task_template_versions WHERE name = $1 AND version = $2`, taskName, version)
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// AddTaskTemplate inserts the first version of a new task template
+// in the `task_templates` and `task_template_versions` tables. If a
+// template with the same name was deleted, its kept versions are
+// continued, so that they're not overwritten.
+func (s *Storage) AddTaskTemplate(ctx context.Context, template *service.Task) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		var last int
+		row := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM task_template_versions WHERE name = $1`, template.Name)
+		if err := row.Scan(&last); err != nil {
+			return err
+		}
+
+		template.TemplateVersion = last + 1
+		data, err := encode(template)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO task_templates (name, data) VALUES ($1, $2)`, template.Name, data)
+		if uniqueViolation(err) {
+			return errors.New(errors.Exist, "task template already exists")
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO task_template_versions (name, version, data) VALUES ($1, $2, $3)`,
+			template.Name, template.TemplateVersion, data)
+		return err
+	})
+}
+
+// UpdateTaskTemplate saves the template as a new version of an existing
+// task template and makes it the latest version. Concurrent updates of
+// the same template are rejected by the primary key of template versions.
+func (s *Storage) UpdateTaskTemplate(ctx context.Context, template *service.Task) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		var latest service.Task
+		err := get(ctx, tx, &latest, "task template not found", `SELECT data FROM task_templates WHERE name = $1`, template.Name)
+		if err != nil {
+			return err
+		}
+
+		template.TemplateVersion = latest.TemplateVersion + 1
+		data, err := encode(template)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO task_template_versions (name, version, data) VALUES ($1, $2, $3)`,
+			template.Name, template.TemplateVersion, data)
+		if uniqueViolation(err) {
+			return errors.New(errors.Exist, "task template was modified concurrently, try again")
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE task_templates SET data = $2 WHERE name = $1`, template.Name, data)
+		return err
+	})
+}
+
+// DeleteTaskTemplate removes a task template. Its versions are kept
+// in the `task_template_versions` table, because they can be used
+// by pinned versions of taskList templates.
+func (s *Storage) DeleteTaskTemplate(ctx context.Context, taskName string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM task_templates WHERE name = $1`, taskName)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return notFound(err, "task template not found")
+	}
+	return nil
+}
+
+// ListTaskTemplates retrieves all task templates sorted by name.
+func (s *Storage) ListTaskTemplates(ctx context.Context) ([]*service.Task, error) {
+	return list[service.Task](ctx, s.db, `SELECT data FROM task_templates ORDER BY name`)
+}
+
+// TaskTemplates retrieves task definitions from storage by names.
+//
+// The result is a map where 'key' is the task name and 'value' is the task definition
+func (s *Storage) TaskTemplates(ctx context.Context, names []string) (map[string]*service.Task, error) {
+	tasks, err := list[service.Task](ctx, s.db, `SELECT data FROM task_templates WHERE name = ANY($1)`, pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*service.Task)
+	for _, task := range tasks {
+		res[task.Name] = task
+	}
+	return res, nil
+}
+
+// SaveTaskHistory saves a task to the `tasks_history` table.
+func (s *Storage) SaveTaskHistory(ctx context.Context, task *service.Task) error {
+	return saveTaskHistory(ctx, s.db, task)
+}
+
+func saveTaskHistory(ctx context.Context, q querier, task *service.Task) error {
+	data, err := encode(task)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecContext(ctx, `
+INSERT INTO tasks_history (id, name, state, cache_namespace, cache_scope, created_at, data)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO UPDATE SET state = EXCLUDED.state, data = EXCLUDED.data`,
+		task.ID, task.Name, task.State, task.CacheNamespace, task.CacheScope, task.CreatedAt, data)
+	return err
+}
+
+func (s *Storage) Task(ctx context.Context, taskID string) (*service.Task, error) {
+	var task service.Task
+	if err := get(ctx, s.db, &task, "task not found", `SELECT data FROM tasks WHERE id = $1`, taskID); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (s *Storage) TaskHistory(ctx context.Context, taskID string) (*service.Task, error) {
+	var task service.Task
+	if err := get(ctx, s.db, &task, "task not found", `SELECT data FROM tasks_history WHERE id = $1`, taskID); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// deadLetterTasks selects the tasks of the dead letters with the columns
+// of the `tasks_history` table, so that they can be searched in the same way.
+const deadLetterTasks = `(SELECT
+	id,
+	data->'task'->>'name' AS name,
+	data->'task'->>'state' AS state,
+	data->'task'->>'cacheNamespace' AS cache_namespace,
+	data->'task'->>'cacheScope' AS cache_scope,
+	(data->'task'->>'createdAt')::TIMESTAMPTZ AS created_at,
+	data->'task' AS data
+FROM dead_letters) AS dead_letter_tasks`
+
+// Tasks retrieves tasks matching the filter from the `tasks`, `tasks_history`
+// and `dead_letters` tables sorted by creation time. A task which is present
+// in several tables is returned only once, as it is found in the history or
+// the dead letters.
+func (s *Storage) Tasks(ctx context.Context, f *service.Filter) ([]*service.Task, error) {
+	query, args := search("tasks_history", f)
+	history, err := list[service.Task](ctx, s.db, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args = search(deadLetterTasks, f)
+	deadLettered, err := list[service.Task](ctx, s.db, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args = search("tasks", f)
+	queued, err := list[service.Task](ctx, s.db, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var tasks []*service.Task
+	for _, task := range append(append(history, deadLettered...), queued...) {
+		if seen[task.ID] {
+			continue
+		}
+		seen[task.ID] = true
+		tasks = append(tasks, task)
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return sortedBefore(tasks[i].CreatedAt, tasks[i].ID, tasks[j].CreatedAt, tasks[j].ID, f.Descending)
+	})
+	if len(tasks) > f.Limit {
+		tasks = tasks[:f.Limit]
+	}
+
+	return tasks, nil
+}
+
+// TaskListTemplate retrieves one taskList definition by name from storage
+func (s *Storage) TaskListTemplate(ctx context.Context, taskListName string) (*service.Template, error) {
+	var template service.Template
+	err := get(ctx, s.db, &template, "taskList template not found", `SELECT data FROM task_list_templates WHERE name = $1`, taskListName)
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// TaskListTemplateVersion retrieves a specific version of a taskList template.
+func (s *Storage) TaskListTemplateVersion(ctx context.Context, taskListName string, version int) (*service.Template, error) {
+	var template service.Template
+	err := get(ctx, s.db, &template, "taskList template version not found",
+		`SELECT data FROM task_list_template_versions WHERE name = $1 AND version = $2`, taskListName, version)
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// AddTaskListTemplate inserts the first version of a new taskList template
+// in the `task_list_templates` and `task_list_template_versions` tables. If a
+// template with the same name was deleted, its kept versions are
+// continued, so that they're not overwritten.
+func (s *Storage) AddTaskListTemplate(ctx context.Context, template *service.Template) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		var last int
+		row := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM task_list_template_versions WHERE name = $1`, template.Name)
+		if err := row.Scan(&last); err != nil {
+			return err
+		}
+
+		template.TemplateVersion = last + 1
+		data, err := encode(template)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO task_list_templates (name, data) VALUES ($1, $2)`, template.Name, data)
+		if uniqueViolation(err) {
+			return errors.New(errors.Exist, "taskList template already exists")
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO task_list_template_versions (name, version, data) VALUES ($1, $2, $3)`,
+			template.Name, template.TemplateVersion, data)
+		return err
+	})
+}
+
+// UpdateTaskListTemplate saves the template as a new version of an existing
+// taskList template and makes it the latest version. Concurrent updates of
+// the same template are rejected by the primary key of template versions.
+func (s *Storage) UpdateTaskListTemplate(ctx context.Context, template *service.Template) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		var latest service.Template
+		err := get(ctx, tx, &latest, "taskList template not found", `SELECT data FROM task_list_templates WHERE name = $1`, template.Name)
+		if err != nil {
+			return err
+		}
+
+		template.TemplateVersion = latest.TemplateVersion + 1
+		data, err := encode(template)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO task_list_template_versions (name, version, data) VALUES ($1, $2, $3)`,
+			template.Name, template.TemplateVersion, data)
+		if uniqueViolation(err) {
+			return errors.New(errors.Exist, "taskList template was modified concurrently, try again")
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE task_list_templates SET data = $2 WHERE name = $1`, template.Name, data)
+		return err
+	})
+}
+
+// DeleteTaskListTemplate removes a taskList template. Its versions are kept
+// in the `task_list_template_versions` table, because they can be used
+// by taskLists created from them.
+func (s *Storage) DeleteTaskListTemplate(ctx context.Context, taskListName string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM task_list_templates WHERE name = $1`, taskListName)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return notFound(err, "taskList template not found")
+	}
+	return nil
+}
+
+// ListTaskListTemplates retrieves all taskList templates sorted by name.
+func (s *Storage) ListTaskListTemplates(ctx context.Context) ([]*service.Template, error) {
+	return list[service.Template](ctx, s.db, `SELECT data FROM task_list_templates ORDER BY name`)
+}
+
+// TaskListTemplatesByTask retrieves the taskList templates containing
+// a task with the given name in any of their groups.
+func (s *Storage) TaskListTemplatesByTask(ctx context.Context, taskName string) ([]*service.Template, error) {
+	return list[service.Template](ctx, s.db, `
+SELECT data FROM task_list_templates
+WHERE EXISTS (SELECT 1 FROM jsonb_array_elements(data->'groups') g WHERE g->'tasks' ? $1)
+ORDER BY name`, taskName)
+}
+
+// GetGroupTasks fetches all tasks of a group in the order of their creation.
+func (s *Storage) GetGroupTasks(ctx context.Context, group *service.Group) ([]*service.Task, error) {
+	return list[service.Task](ctx, s.db, `SELECT data FROM tasks WHERE group_id = $1 ORDER BY seq`, group.ID)
+}
+
+// SaveTaskListHistory adds a taskList to the `task_list_history` table.
+func (s *Storage) SaveTaskListHistory(ctx context.Context, taskList *service.TaskList) error {
+	data, err := encode(taskList)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+INSERT INTO task_list_history (id, name, state, cache_namespace, cache_scope, created_at, data)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO UPDATE SET state = EXCLUDED.state, data = EXCLUDED.data`,
+		taskList.ID, taskList.Name, taskList.State, taskList.CacheNamespace, taskList.CacheScope, taskList.CreatedAt, data)
+	return err
+}
+
+// TaskList retrieves a queued taskList by ID.
+func (s *Storage) TaskList(ctx context.Context, taskListID string) (*service.TaskList, error) {
+	var taskList service.TaskList
+	if err := get(ctx, s.db, &taskList, "taskList not found", `SELECT data FROM task_lists WHERE id = $1`, taskListID); err != nil {
+		return nil, err
+	}
+	return &taskList, nil
+}
+
+// TaskListHistory retrieves a finished taskList by ID.
+func (s *Storage) TaskListHistory(ctx context.Context, taskListID string) (*service.TaskList, error) {
+	var taskList service.TaskList
+	if err := get(ctx, s.db, &taskList, "taskList not found", `SELECT data FROM task_list_history WHERE id = $1`, taskListID); err != nil {
+		return nil, err
+	}
+	return &taskList, nil
+}
+
+// TaskLists retrieves taskLists matching the filter from the `task_lists` and
+// `task_list_history` tables sorted by creation time. A taskList which is
+// present in both tables is returned only once, as it is found in the history.
+func (s *Storage) TaskLists(ctx context.Context, f *service.Filter) ([]*service.TaskList, error) {
+	query, args := search("task_list_history", f)
+	history, err := list[service.TaskList](ctx, s.db, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args = search("task_lists", f)
+	queued, err := list[service.TaskList](ctx, s.db, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var lists []*service.TaskList
+	for _, list := range append(history, queued...) {
+		if seen[list.ID] {
+			continue
+		}
+		seen[list.ID] = true
+		lists = append(lists, list)
+	}
+
+	sort.Slice(lists, func(i, j int) bool {
+		return sortedBefore(lists[i].CreatedAt, lists[i].ID, lists[j].CreatedAt, lists[j].ID, f.Descending)
+	})
+	if len(lists) > f.Limit {
+		lists = lists[:f.Limit]
+	}
+
+	return lists, nil
+}
+
+func (s *Storage) EventTask(ctx context.Context, key, namespace, scope string) (*service.EventTask, error) {
+	var eventTask service.EventTask
+	err := get(ctx, s.db, &eventTask, "eventTask not found",
+		`SELECT data FROM event_tasks WHERE key = $1 AND namespace = $2 AND scope = $3`, key, namespace, scope)
+	if err != nil {
+		return nil, err
+	}
+	return &eventTask, nil
+}
+
+// AddEventTask inserts a new event task binding in the `event_tasks` table.
+func (s *Storage) AddEventTask(ctx context.Context, eventTask *service.EventTask) error {
+	data, err := encode(eventTask)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `INSERT INTO event_tasks (key, namespace, scope, data) VALUES ($1, $2, $3, $4)`,
+		eventTask.Key, eventTask.Namespace, eventTask.Scope, data)
+	if uniqueViolation(err) {
+		return errors.New(errors.Exist, "eventTask already exists")
+	}
+	return err
+}
+
+// DeleteEventTask removes the event task binding for the given cache key,
+// namespace and scope from the `event_tasks` table.
+func (s *Storage) DeleteEventTask(ctx context.Context, key, namespace, scope string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM event_tasks WHERE key = $1 AND namespace = $2 AND scope = $3`, key, namespace, scope)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return notFound(err, "eventTask not found")
+	}
+	return nil
+}
+
+// ListEventTasks retrieves all event task bindings sorted by cache key,
+// namespace and scope.
+func (s *Storage) ListEventTasks(ctx context.Context) ([]*service.EventTask, error) {
+	return list[service.EventTask](ctx, s.db, `SELECT data FROM event_tasks ORDER BY key, namespace, scope`)
+}
+
+// AddSchedule inserts a new schedule in the `schedules` table.
+func (s *Storage) AddSchedule(ctx context.Context, schedule *service.Schedule) error {
+	return saveSchedule(ctx, s.db, schedule)
+}
+
+func (s *Storage) Schedule(ctx context.Context, scheduleID string) (*service.Schedule, error) {
+	var schedule service.Schedule
+	if err := get(ctx, s.db, &schedule, "schedule not found", `SELECT data FROM schedules WHERE id = $1`, scheduleID); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// DeleteSchedule removes a schedule from the `schedules` table.
+func (s *Storage) DeleteSchedule(ctx context.Context, scheduleID string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM schedules WHERE id = $1`, scheduleID)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return notFound(err, "schedule not found")
+	}
+	return nil
+}
+
+// ListSchedules retrieves all schedules sorted by creation time.
+func (s *Storage) ListSchedules(ctx context.Context) ([]*service.Schedule, error) {
+	return list[service.Schedule](ctx, s.db, `SELECT data FROM schedules ORDER BY created_at`)
+}
+
+// DueSchedules retrieves the schedules which next run time has come or
+// which have a pending run, sorted by the next run time.
+func (s *Storage) DueSchedules(ctx context.Context, now time.Time) ([]*service.Schedule, error) {
+	return list[service.Schedule](ctx, s.db, `
+SELECT data FROM schedules WHERE next_run_at <= $1 OR data->>'pendingRun' IS NOT NULL ORDER BY next_run_at`, now)
+}
+
+// ClaimSchedule moves the next run time of a schedule from the scheduled
+// time of the run to nextRunAt and records the run as pending in the same
+// transaction. The update is conditional on the current next run time and
+// on the schedule having no pending run, so when several instances of the
+// service try to claim the same run, only one of them succeeds and the
+// others receive a NotFound error.
+func (s *Storage) ClaimSchedule(ctx context.Context, scheduleID string, nextRunAt time.Time, run *service.ScheduleRun) error {
+	return s.updateSchedule(ctx, scheduleID, func(schedule *service.Schedule) error {
+		if !schedule.NextRunAt.Equal(run.ScheduledAt) || schedule.PendingRun != nil {
+			return errors.New(errors.NotFound, "schedule run is already claimed")
+		}
+		schedule.NextRunAt = nextRunAt
+		schedule.PendingRun = run
+		return nil
+	})
+}
+
+// ClaimPendingRun changes the claim time of a pending run of a schedule,
+// so that it's retried by a single instance of the service. It returns a
+// NotFound error if the run was claimed again or completed in the meantime.
+func (s *Storage) ClaimPendingRun(ctx context.Context, scheduleID string, run *service.ScheduleRun, claimedAt time.Time) error {
+	return s.updateSchedule(ctx, scheduleID, func(schedule *service.Schedule) error {
+		if !pendingRun(schedule, run.ID) || !schedule.PendingRun.ClaimedAt.Equal(run.ClaimedAt) {
+			return errors.New(errors.NotFound, "schedule run is already claimed")
+		}
+		schedule.PendingRun.ClaimedAt = claimedAt
+		return nil
+	})
+}
+
+// CompleteScheduleRun records a pending run of a schedule keeping only the
+// most recent runs, and removes the pending run.
+func (s *Storage) CompleteScheduleRun(ctx context.Context, scheduleID string, run *service.ScheduleRun) error {
+	err := s.updateSchedule(ctx, scheduleID, func(schedule *service.Schedule) error {
+		if !pendingRun(schedule, run.ID) {
+			return errors.New(errors.NotFound, "schedule run is not pending")
+		}
+		schedule.PendingRun = nil
+		schedule.LastRunAt = run.ScheduledAt
+		schedule.Runs = append(schedule.Runs, *run)
+		if len(schedule.Runs) > service.MaxScheduleRuns {
+			schedule.Runs = schedule.Runs[len(schedule.Runs)-service.MaxScheduleRuns:]
+		}
+		return nil
+	})
+	if errors.Is(errors.NotFound, err) {
+		return nil
+	}
+	return err
+}
+
+// DiscardScheduleRun removes a pending run of a schedule without recording it.
+func (s *Storage) DiscardScheduleRun(ctx context.Context, scheduleID string, runID string) error {
+	err := s.updateSchedule(ctx, scheduleID, func(schedule *service.Schedule) error {
+		if !pendingRun(schedule, runID) {
+			return errors.New(errors.NotFound, "schedule run is not pending")
+		}
+		schedule.PendingRun = nil
+		return nil
+	})
+	if errors.Is(errors.NotFound, err) {
+		return nil
+	}
+	return err
+}
+
+// updateSchedule changes a schedule with the update function in a
+// transaction which locks the schedule.
+func (s *Storage) updateSchedule(ctx context.Context, scheduleID string, update func(*service.Schedule) error) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		var schedule service.Schedule
+		err := get(ctx, tx, &schedule, "schedule not found", `SELECT data FROM schedules WHERE id = $1 FOR UPDATE`, scheduleID)
+		if err != nil {
+			return err
+		}
+
+		if err := update(&schedule); err != nil {
+			return err
+		}
+		return saveSchedule(ctx, tx, &schedule)
+	})
+}
+
+// pendingRun reports whether the run with the given ID is pending.
+func pendingRun(schedule *service.Schedule, runID string) bool {
+	return schedule.PendingRun != nil && schedule.PendingRun.ID == runID
+}
+
+func saveSchedule(ctx context.Context, q querier, schedule *service.Schedule) error {
+	data, err := encode(schedule)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecContext(ctx, `
+INSERT INTO schedules (id, next_run_at, created_at, data) VALUES ($1, $2, $3, $4)
+ON CONFLICT (id) DO UPDATE SET next_run_at = EXCLUDED.next_run_at, data = EXCLUDED.data`,
+		schedule.ID, schedule.NextRunAt, schedule.CreatedAt, data)
+	return err
+}
+
+// AddIdempotencyKey inserts an idempotency key in the `idempotency_keys` table.
+// An expired key with the same value is replaced. If the key exists and is not
+// expired, an Exist error is returned.
+func (s *Storage) AddIdempotencyKey(ctx context.Context, key *service.IdempotencyKey) error {
+	data, err := encode(key)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+INSERT INTO idempotency_keys (key, expires_at, data) VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE SET expires_at = EXCLUDED.expires_at, data = EXCLUDED.data
+WHERE idempotency_keys.expires_at <= $4`,
+		key.Key, key.ExpiresAt, data, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		if err != nil {
+			return err
+		}
+		return errors.New(errors.Exist, "idempotency key already exists")
+	}
+
+	return nil
+}
+
+// IdempotencyKey retrieves an idempotency key which is not expired.
+func (s *Storage) IdempotencyKey(ctx context.Context, key string) (*service.IdempotencyKey, error) {
+	var idempotencyKey service.IdempotencyKey
+	err := get(ctx, s.db, &idempotencyKey, "idempotency key not found",
+		`SELECT data FROM idempotency_keys WHERE key = $1 AND expires_at > $2`, key, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &idempotencyKey, nil
+}
+
+// DeleteIdempotencyKey removes an idempotency key, so that it can be used again.
+func (s *Storage) DeleteIdempotencyKey(ctx context.Context, key string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE key = $1`, key)
+	return err
+}
+
+// AddWebhookDelivery inserts a webhook delivery in the `webhook_deliveries` table.
+func (s *Storage) AddWebhookDelivery(ctx context.Context, delivery *service.WebhookDelivery) error {
+	return saveWebhookDelivery(ctx, s.db, delivery)
+}
+
+// PollWebhookDelivery retrieves one pending webhook delivery which next attempt
+// time has come. The next attempt time is moved forward by the lease duration,
+// so that the delivery is not retrieved by other instances of the service while
+// it's being delivered. If the instance stops during the delivery, the delivery
+// is retrieved again after the lease expires.
+func (s *Storage) PollWebhookDelivery(ctx context.Context, lease time.Duration) (*service.WebhookDelivery, error) {
+	var delivery service.WebhookDelivery
+	err := s.tx(ctx, func(tx *sql.Tx) error {
+		now := time.Now()
+		err := get(ctx, tx, &delivery, "webhook delivery not found", `
+SELECT data FROM webhook_deliveries
+WHERE state = $1 AND next_attempt_at <= $2
+ORDER BY next_attempt_at
+LIMIT 1
+FOR UPDATE SKIP LOCKED`, service.WebhookPending, now)
+		if err != nil {
+			return err
+		}
+
+		delivery.NextAttemptAt = now.Add(lease)
+		return saveWebhookDelivery(ctx, tx, &delivery)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// UpdateWebhookDelivery saves the state and the attempts of a webhook delivery.
+func (s *Storage) UpdateWebhookDelivery(ctx context.Context, delivery *service.WebhookDelivery) error {
+	return saveWebhookDelivery(ctx, s.db, delivery)
+}
+
+// WebhookDeliveries retrieves the webhook deliveries of a task
+// or taskList sorted by creation time.
+func (s *Storage) WebhookDeliveries(ctx context.Context, resourceID string) ([]*service.WebhookDelivery, error) {
+	return list[service.WebhookDelivery](ctx, s.db,
+		`SELECT data FROM webhook_deliveries WHERE resource_id = $1 ORDER BY created_at`, resourceID)
+}
+
+func saveWebhookDelivery(ctx context.Context, q querier, delivery *service.WebhookDelivery) error {
+	data, err := encode(delivery)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecContext(ctx, `
+INSERT INTO webhook_deliveries (id, resource_id, state, next_attempt_at, created_at, data)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO UPDATE SET state = EXCLUDED.state, next_attempt_at = EXCLUDED.next_attempt_at, data = EXCLUDED.data`,
+		delivery.ID, delivery.ResourceID, delivery.State, delivery.NextAttemptAt, delivery.CreatedAt, data)
+	return err
+}
+
+// AddDeadLetter saves a task removed from the queue in the `dead_letters` table.
+// Adding the same task again replaces the previous dead letter.
+func (s *Storage) AddDeadLetter(ctx context.Context, deadLetter *service.DeadLetter) error {
+	data, err := encode(deadLetter)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+INSERT INTO dead_letters (id, task_name, dead_lettered_at, data) VALUES ($1, $2, $3, $4)
+ON CONFLICT (id) DO UPDATE SET task_name = EXCLUDED.task_name, dead_lettered_at = EXCLUDED.dead_lettered_at, data = EXCLUDED.data`,
+		deadLetter.ID, deadLetter.Task.Name, deadLetter.DeadLetteredAt, data)
+	return err
+}
+
+// DeadLetters retrieves dead letters from the newest to the oldest. If
+// taskName is not empty, only dead letters of tasks with this name are retrieved.
+func (s *Storage) DeadLetters(ctx context.Context, taskName string, limit int) ([]*service.DeadLetter, error) {
+	return list[service.DeadLetter](ctx, s.db, `
+SELECT data FROM dead_letters
+WHERE $1 = '' OR task_name = $1
+ORDER BY dead_lettered_at DESC, id
+LIMIT $2`, taskName, limit)
+}
+
+// DeadLetter retrieves the dead letter of a task.
+func (s *Storage) DeadLetter(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+	var letter service.DeadLetter
+	err := get(ctx, s.db, &letter, "dead letter not found", `SELECT data FROM dead_letters WHERE id = $1`, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return &letter, nil
+}
+
+// DeleteDeadLetter removes the dead letter of a task and returns it.
+func (s *Storage) DeleteDeadLetter(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+	var letter service.DeadLetter
+	err := get(ctx, s.db, &letter, "dead letter not found", `DELETE FROM dead_letters WHERE id = $1 RETURNING data`, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return &letter, nil
+}
+
+// PurgeDeadLetters removes dead letters and returns their number. If taskName is not
+// empty, only dead letters of tasks with this name are removed. If before is not zero,
+// only the tasks which were removed from the queue before the given time are removed.
+func (s *Storage) PurgeDeadLetters(ctx context.Context, taskName string, before time.Time) (int, error) {
+	result, err := s.db.ExecContext(ctx, `
+DELETE FROM dead_letters
+WHERE ($1 = '' OR task_name = $1) AND ($2 OR dead_lettered_at < $3)`,
+		taskName, before.IsZero(), before)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := result.RowsAffected()
+	return int(n), err
+}
+
+// tx runs fn in a transaction, which is committed if fn succeeds
+// and rolled back otherwise.
+func (s *Storage) tx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// get decodes the data column of the single row selected by the query
+// into v. It returns a NotFound error with the given message if no row
+// is selected.
+func get(ctx context.Context, q querier, v interface{}, message string, query string, args ...interface{}) error {
+	var data []byte
+	if err := q.QueryRowContext(ctx, query, args...).Scan(&data); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New(errors.NotFound, message)
+		}
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// list decodes the data column of the rows selected by the query.
+func list[T any](ctx context.Context, q querier, query string, args ...interface{}) ([]*T, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*T
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+
+		var item T
+		if err := json.Unmarshal(data, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+
+	return items, rows.Err()
+}
+
+// encode encodes a value for the data column. The JSON is passed as text,
+// because the driver would pass []byte as binary data.
+func encode(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// notFound returns err if it's not nil and a NotFound error otherwise.
+func notFound(err error, message string) error {
+	if err != nil {
+		return err
+	}
+	return errors.New(errors.NotFound, message)
+}
+
+// uniqueViolation reports whether err is caused by a duplicate key.
+func uniqueViolation(err error) bool {
+	e, ok := err.(*pq.Error)
+	return ok && e.Code == "23505"
+}
+
+// duplicateTable reports whether err is caused by creating an existing table.
+func duplicateTable(err error) bool {
+	e, ok := err.(*pq.Error)
+	return ok && e.Code == "42P07"
+}
+
+// search creates a query of the tasks or taskLists in the given table matching
+// the search criteria, sorted by creation time and ID and limited to the limit
+// of the filter. When a cursor is given, only rows positioned after it in the
+// sort order are selected.
+func search(table string, f *service.Filter) (string, []interface{}) {
+	var where []string
+	var args []interface{}
+	add := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(condition, len(args)))
+	}
+
+	if f.Name != "" {
+		add("name = $%d", f.Name)
+	}
+	if f.State != "" {
+		add("state = $%d", f.State)
+	}
+	if f.CacheNamespace != "" {
+		add("cache_namespace = $%d", f.CacheNamespace)
+	}
+	if f.CacheScope != "" {
+		add("cache_scope = $%d", f.CacheScope)
+	}
+	if !f.CreatedAfter.IsZero() {
+		add("created_at >= $%d", f.CreatedAfter)
+	}
+	if !f.CreatedBefore.IsZero() {
+		add("created_at < $%d", f.CreatedBefore)
+	}
+
+	order, op := "ASC", ">"
+	if f.Descending {
+		order, op = "DESC", "<"
+	}
+
+	if f.After != nil {
+		args = append(args, f.After.CreatedAt, f.After.ID)
+		where = append(where, fmt.Sprintf("(created_at, id) %s ($%d, $%d)", op, len(args)-1, len(args)))
+	}
+
+	query := "SELECT data FROM " + table
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	args = append(args, f.Limit)
+	query += fmt.Sprintf(" ORDER BY created_at %s, id %s LIMIT $%d", order, order, len(args))
+
+	return query, args
+}
+
+// sortedBefore reports whether an item with creation time t1 and id1 is
+// positioned before an item with creation time t2 and id2 in the sort order.
+func sortedBefore(t1 time.Time, id1 string, t2 time.Time, id2 string, descending bool) bool {
+	if t1.Equal(t2) {
+		if descending {
+			return id1 > id2
+		}
+		return id1 < id2
+	}
+	if descending {
+		return t1.After(t2)
+	}
+	return t1.Before(t2)
+}
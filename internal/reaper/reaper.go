@@ -0,0 +1,72 @@
+package reaper
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// Reaper periodically returns pending tasks and taskLists which lease has
+// expired to the queue. The lease of a task or taskList expires when the
+// instance of the service executing it stops without finishing the execution,
+// e.g. because its pod was killed, so that another instance can execute it.
+//
+// Cancelled tasks which lease has expired are moved to the history,
+// because they're not executed anymore.
+//
+// Multiple instances of the service can run a Reaper at the same time,
+// as requeuing a task or taskList with an expired lease is idempotent.
+type Reaper struct {
+	queue    service.Queue
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+func New(queue service.Queue, interval time.Duration, logger *zap.Logger) *Reaper {
+	return &Reaper{
+		queue:    queue,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+func (r *Reaper) Start(ctx context.Context) error {
+	defer r.logger.Info("lease reaper stopped")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.interval):
+			r.reap(ctx)
+		}
+	}
+}
+
+func (r *Reaper) reap(ctx context.Context) {
+	now := time.Now()
+
+	tasks, err := r.queue.RequeueExpired(ctx, now)
+	if err != nil {
+		r.logger.Error("error requeuing tasks with expired lease", zap.Error(err))
+	} else if tasks > 0 {
+		r.logger.Warn("tasks with expired lease are returned to the queue", zap.Int("tasks", tasks))
+	}
+
+	lists, err := r.queue.RequeueExpiredLists(ctx, now)
+	if err != nil {
+		r.logger.Error("error requeuing taskLists with expired lease", zap.Error(err))
+	} else if lists > 0 {
+		r.logger.Warn("taskLists with expired lease are returned to the queue", zap.Int("taskLists", lists))
+	}
+
+	cancelled, err := r.queue.ArchiveCancelled(ctx, now)
+	if err != nil {
+		r.logger.Error("error moving cancelled tasks to history", zap.Error(err))
+	} else if cancelled > 0 {
+		r.logger.Warn("cancelled tasks with expired lease are moved to history", zap.Int("tasks", cancelled))
+	}
+}
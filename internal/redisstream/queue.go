@@ -0,0 +1,462 @@
+package redisstream
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+const (
+	// group is the consumer group of the executors. Every instance of
+	// the service is a consumer of the group named by its lease owner.
+	group = "executors"
+
+	// idField is the field of a stream entry holding the task or taskList ID.
+	idField = "id"
+)
+
+// promote moves the IDs which time has come from the sorted set of
+// delayed entries (KEYS[1]) to the stream (KEYS[2]). The scores of the
+// sorted set are the times in unix milliseconds.
+var promote = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+for _, id in ipairs(ids) do
+	redis.call('XADD', KEYS[2], '*', 'id', id)
+	redis.call('ZREM', KEYS[1], id)
+end
+return #ids
+`)
+
+// stream holds the keys of the stream of tasks or taskLists and of the
+// sorted set of their delayed entries.
+type stream struct {
+	key     string
+	delayed string
+}
+
+// Queue dispatches tasks and taskLists to the executors through Redis
+// Streams read by a consumer group, so that the executors don't poll the
+// database. An entry carries the ID of a task or taskList which is kept in
+// the store.
+//
+// Entries read by a consumer stay in the pending entries list of the group
+// until the task or taskList is acked. Entries which are pending for longer
+// than the lease duration, e.g. because the instance executing them stopped,
+// are claimed by the next poll of another consumer, and heartbeats reset
+// their idle time. Redis Streams can't delay entries, so scheduled and unacked
+// tasks wait in a sorted set until their execution time and are then moved
+// to the stream. Claims of interrupted executions are counted in the retries
+// of the tasks, so the retry policies of the tasks limit the deliveries.
+type Queue struct {
+	client    redis.UniversalClient
+	store     service.Store
+	tasks     stream
+	lists     stream
+	fetchWait time.Duration
+
+	mu          sync.Mutex
+	taskEntries map[string]string // taskEntries are the entry IDs of polled tasks by task ID
+	listEntries map[string]string // listEntries are the entry IDs of polled taskLists by taskList ID
+}
+
+// New creates the consumer groups of the streams of tasks and taskLists
+// if they don't exist. The keys of the streams are "{<prefix>}:tasks" and
+// "{<prefix>}:taskLists". The prefix is a hash tag, so that the keys used
+// together in scripts and transactions are in the same slot of a Redis Cluster.
+func New(
+	ctx context.Context,
+	client redis.UniversalClient,
+	store service.Store,
+	prefix string,
+	fetchWait time.Duration,
+) (*Queue, error) {
+	tag := "{" + prefix + "}"
+	q := &Queue{
+		client:      client,
+		store:       store,
+		tasks:       stream{key: tag + ":tasks", delayed: tag + ":tasks:delayed"},
+		lists:       stream{key: tag + ":taskLists", delayed: tag + ":taskLists:delayed"},
+		fetchWait:   fetchWait,
+		taskEntries: make(map[string]string),
+		listEntries: make(map[string]string),
+	}
+
+	for _, s := range []stream{q.tasks, q.lists} {
+		err := client.XGroupCreateMkStream(ctx, s.key, group, "0").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return nil, errors.New("error creating consumer group", err)
+		}
+	}
+
+	return q, nil
+}
+
+// Add adds the task to the store and its ID to the stream. The task is
+// removed from the store if its ID can't be added to the stream.
+func (q *Queue) Add(ctx context.Context, task *service.Task) error {
+	if err := q.store.Add(ctx, task); err != nil {
+		return err
+	}
+
+	if err := q.publish(ctx, q.tasks, task.ID, task.RunAt); err != nil {
+		if err := q.store.Ack(ctx, task); err != nil {
+			return errors.New("failed to remove task from store", err)
+		}
+		return errors.New("error adding task to stream", err)
+	}
+
+	return nil
+}
+
+// Poll retrieves one task from the stream, see PollBatch.
+func (q *Queue) Poll(ctx context.Context, owner string, lease time.Duration) (*service.Task, error) {
+	tasks, err := q.PollBatch(ctx, owner, lease, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tasks[0], nil
+}
+
+// PollBatch reads up to limit entries from the stream, see read, and claims
+// their tasks in the store. Entries of tasks which were removed or cancelled
+// are dropped, so fewer tasks than entries may be returned. If claiming a task
+// fails, the tasks claimed before are returned and the entries which weren't
+// claimed stay pending until they're claimed by a later poll. If there are no
+// tasks to execute, it returns a NotFound error.
+func (q *Queue) PollBatch(ctx context.Context, owner string, lease time.Duration, limit int) ([]*service.Task, error) {
+	msgs, err := q.read(ctx, q.tasks, owner, lease, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []*service.Task
+	for _, msg := range msgs {
+		id, _ := msg.Values[idField].(string)
+		task, err := q.store.ClaimTask(ctx, id, owner, lease)
+		if err != nil {
+			if errors.Is(errors.NotFound, err) {
+				_ = q.drop(ctx, q.tasks, msg.ID)
+				continue
+			}
+			if len(tasks) > 0 {
+				return tasks, nil
+			}
+			return nil, err
+		}
+
+		q.mu.Lock()
+		q.taskEntries[task.ID] = msg.ID
+		q.mu.Unlock()
+
+		tasks = append(tasks, task)
+	}
+
+	if len(tasks) == 0 {
+		return nil, errors.New(errors.NotFound, "task not found")
+	}
+
+	return tasks, nil
+}
+
+// Heartbeat extends the lease of the task in the store and resets the
+// idle time of its pending entry, so that it's not claimed by other consumers.
+func (q *Queue) Heartbeat(ctx context.Context, task *service.Task, lease time.Duration) error {
+	if err := q.store.Heartbeat(ctx, task, lease); err != nil {
+		return err
+	}
+
+	entryID, ok := q.entry(q.taskEntries, task.ID, false)
+	if !ok {
+		return errors.New(errors.NotFound, "task lease not found")
+	}
+	return q.touch(ctx, q.tasks, task.LeaseOwner, entryID)
+}
+
+// Ack removes the task from the store and its entry from the stream.
+func (q *Queue) Ack(ctx context.Context, task *service.Task) error {
+	if err := q.store.Ack(ctx, task); err != nil {
+		return err
+	}
+
+	if entryID, ok := q.entry(q.taskEntries, task.ID, true); ok {
+		return q.drop(ctx, q.tasks, entryID)
+	}
+	return nil
+}
+
+// Unack returns the task to the queue in the store and replaces its
+// entry with a new one, which is added to the stream at the next
+// attempt time of the task.
+func (q *Queue) Unack(ctx context.Context, task *service.Task) error {
+	if err := q.store.Unack(ctx, task); err != nil {
+		return err
+	}
+
+	entryID, ok := q.entry(q.taskEntries, task.ID, true)
+	if !ok {
+		return nil
+	}
+
+	_, err := q.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAck(ctx, q.tasks.key, group, entryID)
+		pipe.XDel(ctx, q.tasks.key, entryID)
+		enqueue(ctx, pipe, q.tasks, task.ID, task.NextAttemptAt)
+		return nil
+	})
+	return err
+}
+
+// Cancel cancels the task in the store. The entry of a cancelled
+// task is dropped when it's read.
+func (q *Queue) Cancel(ctx context.Context, taskID string) (*service.Task, error) {
+	return q.store.Cancel(ctx, taskID)
+}
+
+// AddTaskList adds the taskList and its tasks to the store and the ID of
+// the taskList to the stream. The taskList and its tasks are removed from
+// the store if the ID can't be added to the stream.
+func (q *Queue) AddTaskList(ctx context.Context, taskList *service.TaskList, tasks []*service.Task) error {
+	if err := q.store.AddTaskList(ctx, taskList, tasks); err != nil {
+		return err
+	}
+
+	if err := q.publish(ctx, q.lists, taskList.ID, taskList.RunAt); err != nil {
+		for i := range taskList.Groups {
+			if err := q.store.AckGroupTasks(ctx, &taskList.Groups[i]); err != nil {
+				return errors.New("failed to remove group tasks from store", err)
+			}
+		}
+		if err := q.store.AckList(ctx, taskList); err != nil {
+			return errors.New("failed to remove taskList from store", err)
+		}
+		return errors.New("error adding taskList to stream", err)
+	}
+
+	return nil
+}
+
+// PollList reads one entry from the stream and claims its taskList in
+// the store in the same way as PollBatch does for tasks.
+func (q *Queue) PollList(ctx context.Context, owner string, lease time.Duration) (*service.TaskList, error) {
+	msgs, err := q.read(ctx, q.lists, owner, lease, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := msgs[0]
+	id, _ := msg.Values[idField].(string)
+	list, err := q.store.ClaimTaskList(ctx, id, owner, lease)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			_ = q.drop(ctx, q.lists, msg.ID)
+		}
+		return nil, err
+	}
+
+	q.mu.Lock()
+	q.listEntries[list.ID] = msg.ID
+	q.mu.Unlock()
+
+	return list, nil
+}
+
+// HeartbeatList extends the lease of the taskList in the store and
+// resets the idle time of its pending entry.
+func (q *Queue) HeartbeatList(ctx context.Context, taskList *service.TaskList, lease time.Duration) error {
+	if err := q.store.HeartbeatList(ctx, taskList, lease); err != nil {
+		return err
+	}
+
+	entryID, ok := q.entry(q.listEntries, taskList.ID, false)
+	if !ok {
+		return errors.New(errors.NotFound, "taskList lease not found")
+	}
+	return q.touch(ctx, q.lists, taskList.LeaseOwner, entryID)
+}
+
+// AckList removes the taskList from the store and its entry from the stream.
+func (q *Queue) AckList(ctx context.Context, taskList *service.TaskList) error {
+	if err := q.store.AckList(ctx, taskList); err != nil {
+		return err
+	}
+
+	if entryID, ok := q.entry(q.listEntries, taskList.ID, true); ok {
+		return q.drop(ctx, q.lists, entryID)
+	}
+	return nil
+}
+
+// AckGroupTasks removes the tasks of a group from the store. The tasks
+// of the groups are executed by the taskList executor and have no entries.
+func (q *Queue) AckGroupTasks(ctx context.Context, group *service.Group) error {
+	return q.store.AckGroupTasks(ctx, group)
+}
+
+// RequeueExpired doesn't requeue any tasks, because the entries which are
+// pending for longer than the lease duration are claimed by the polls.
+func (q *Queue) RequeueExpired(_ context.Context, _ time.Time) (int, error) {
+	return 0, nil
+}
+
+// RequeueExpiredLists doesn't requeue any taskLists, see RequeueExpired.
+func (q *Queue) RequeueExpiredLists(_ context.Context, _ time.Time) (int, error) {
+	return 0, nil
+}
+
+// ArchiveCancelled moves the cancelled tasks which lease has expired
+// from the store to the history. The entries of the tasks are dropped
+// when they're polled, because the tasks can't be claimed.
+func (q *Queue) ArchiveCancelled(ctx context.Context, now time.Time) (int, error) {
+	return q.store.ArchiveCancelled(ctx, now)
+}
+
+// publish adds the ID of a task or taskList to the stream, or to the
+// sorted set of delayed entries if it must not be executed before runAt.
+func (q *Queue) publish(ctx context.Context, s stream, id string, runAt time.Time) error {
+	_, err := q.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		enqueue(ctx, pipe, s, id, runAt)
+		return nil
+	})
+	return err
+}
+
+// read returns up to limit entries for the consumer named owner. The delayed
+// entries which time has come are moved to the stream first. Then the entries
+// which are pending for longer than the lease duration are claimed with XCLAIM,
+// and the rest is read with XREADGROUP, waiting up to the fetch wait for new
+// entries if there are no claimed entries. It returns a NotFound error if
+// there are no entries.
+func (q *Queue) read(ctx context.Context, s stream, owner string, lease time.Duration, limit int) ([]redis.XMessage, error) {
+	now := time.Now().UnixMilli()
+	if err := promote.Run(ctx, q.client, []string{s.delayed, s.key}, now).Err(); err != nil {
+		return nil, errors.New("error moving delayed entries to stream", err)
+	}
+
+	msgs, err := q.claim(ctx, s, owner, lease, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgs) < limit {
+		block := q.fetchWait
+		if len(msgs) > 0 {
+			block = -1
+		}
+
+		streams, err := q.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: owner,
+			Streams:  []string{s.key, ">"},
+			Count:    int64(limit - len(msgs)),
+			Block:    block,
+		}).Result()
+		if err != nil && err != redis.Nil {
+			return nil, errors.New("error reading stream", err)
+		}
+		for _, res := range streams {
+			msgs = append(msgs, res.Messages...)
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil, errors.New(errors.NotFound, "no entries in stream")
+	}
+
+	return msgs, nil
+}
+
+// claim transfers up to limit entries which are pending for longer than
+// the lease duration to the consumer named owner. Entries which were
+// deleted from the stream are acknowledged and skipped.
+func (q *Queue) claim(ctx context.Context, s stream, owner string, lease time.Duration, limit int) ([]redis.XMessage, error) {
+	pending, err := q.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: s.key,
+		Group:  group,
+		Idle:   lease,
+		Start:  "-",
+		End:    "+",
+		Count:  int64(limit),
+	}).Result()
+	if err != nil && err != redis.Nil {
+		return nil, errors.New("error getting pending entries", err)
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(pending))
+	for i, p := range pending {
+		ids[i] = p.ID
+	}
+
+	claimed, err := q.client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   s.key,
+		Group:    group,
+		Consumer: owner,
+		MinIdle:  lease,
+		Messages: ids,
+	}).Result()
+	if err != nil {
+		return nil, errors.New("error claiming pending entries", err)
+	}
+
+	var msgs []redis.XMessage
+	for _, msg := range claimed {
+		if _, ok := msg.Values[idField]; !ok {
+			_ = q.drop(ctx, s, msg.ID)
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// touch resets the idle time of a pending entry of the consumer named owner.
+func (q *Queue) touch(ctx context.Context, s stream, owner, entryID string) error {
+	return q.client.XClaimJustID(ctx, &redis.XClaimArgs{
+		Stream:   s.key,
+		Group:    group,
+		Consumer: owner,
+		Messages: []string{entryID},
+	}).Err()
+}
+
+// drop acknowledges an entry and deletes it from the stream.
+func (q *Queue) drop(ctx context.Context, s stream, entryID string) error {
+	_, err := q.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAck(ctx, s.key, group, entryID)
+		pipe.XDel(ctx, s.key, entryID)
+		return nil
+	})
+	return err
+}
+
+// entry returns the entry ID of a polled task or taskList and
+// removes it from the polled entries if remove is true.
+func (q *Queue) entry(entries map[string]string, id string, remove bool) (string, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entryID, ok := entries[id]
+	if ok && remove {
+		delete(entries, id)
+	}
+	return entryID, ok
+}
+
+// enqueue queues the commands adding an ID to the stream, or to the sorted
+// set of delayed entries if it must not be executed before the given time.
+func enqueue(ctx context.Context, pipe redis.Pipeliner, s stream, id string, at time.Time) {
+	if at.After(time.Now()) {
+		pipe.ZAdd(ctx, s.delayed, redis.Z{Score: float64(at.UnixMilli()), Member: id})
+		return
+	}
+	pipe.XAdd(ctx, &redis.XAddArgs{Stream: s.key, Values: map[string]interface{}{idField: id}})
+}
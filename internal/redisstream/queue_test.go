@@ -0,0 +1,188 @@
+package redisstream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/memory"
+	"github.com/eclipse-xfsc/task-sheduler/internal/redisstream"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// failingStore fails to claim the tasks with the given ID.
+type failingStore struct {
+	*memory.Storage
+	failID string
+}
+
+func (s *failingStore) ClaimTask(ctx context.Context, taskID, owner string, lease time.Duration) (*service.Task, error) {
+	if taskID == s.failID {
+		return nil, errors.New("some error")
+	}
+	return s.Storage.ClaimTask(ctx, taskID, owner, lease)
+}
+
+func newQueue(t *testing.T) (*redisstream.Queue, *memory.Storage, *miniredis.Miniredis) {
+	store := memory.New(time.Minute)
+	q, srv := newStoreQueue(t, store)
+	return q, store, srv
+}
+
+func newStoreQueue(t *testing.T, store service.Store) (*redisstream.Queue, *miniredis.Miniredis) {
+	srv := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	q, err := redisstream.New(context.Background(), client, store, "test", 10*time.Millisecond)
+	require.NoError(t, err)
+
+	// creating the queue again keeps the existing consumer groups
+	_, err = redisstream.New(context.Background(), client, store, "test", 10*time.Millisecond)
+	require.NoError(t, err)
+
+	return q, srv
+}
+
+func TestQueue_Implements(t *testing.T) {
+	q, _, _ := newQueue(t)
+	assert.Implements(t, (*service.Queue)(nil), q)
+}
+
+func TestQueue_Keys(t *testing.T) {
+	ctx := context.Background()
+	q, _, srv := newQueue(t)
+
+	assert.NoError(t, q.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+	assert.NoError(t, q.Add(ctx, &service.Task{ID: "456", State: service.Created, CreatedAt: time.Now(), RunAt: time.Now().Add(time.Hour)}))
+	assert.NoError(t, q.AddTaskList(ctx, &service.TaskList{ID: "789", State: service.Created, CreatedAt: time.Now()}, nil))
+
+	// the keys share the hash tag of the prefix to be in the same cluster slot
+	assert.ElementsMatch(t, []string{"{test}:tasks", "{test}:tasks:delayed", "{test}:taskLists"}, srv.Keys())
+}
+
+func TestQueue_Tasks(t *testing.T) {
+	ctx := context.Background()
+	q, store, _ := newQueue(t)
+
+	_, err := q.Poll(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	assert.NoError(t, q.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+
+	task, err := q.Poll(ctx, "owner", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "123", task.ID)
+	assert.Equal(t, service.State(service.Pending), task.State)
+	assert.NoError(t, q.Heartbeat(ctx, task, time.Minute))
+
+	// the entry is pending and not read again
+	_, err = q.Poll(ctx, "other", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	// a task returned to the queue waits for its next attempt
+	task.Error = "some error"
+	task.NextAttemptAt = time.Now().Add(time.Hour)
+	assert.NoError(t, q.Unack(ctx, task))
+	_, err = q.Poll(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	task.NextAttemptAt = time.Time{}
+	assert.NoError(t, q.Add(ctx, &service.Task{ID: "456", State: service.Created, CreatedAt: time.Now()}))
+	task, err = q.Poll(ctx, "owner", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "456", task.ID)
+
+	assert.NoError(t, q.Ack(ctx, task))
+	_, err = store.Task(ctx, "456")
+	assert.True(t, errors.Is(errors.NotFound, err))
+	_, err = q.Poll(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
+
+func TestQueue_StuckEntries(t *testing.T) {
+	ctx := context.Background()
+	q, _, srv := newQueue(t)
+
+	assert.NoError(t, q.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+	_, err := q.Poll(ctx, "owner", time.Minute)
+	require.NoError(t, err)
+
+	// the entry of a stopped consumer is claimed after the lease duration
+	srv.SetTime(time.Now().Add(2 * time.Minute))
+	task, err := q.Poll(ctx, "other", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "123", task.ID)
+	assert.Equal(t, "other", task.LeaseOwner)
+	assert.Equal(t, 1, task.Retries)
+	assert.Equal(t, service.LeaseExpiredError, task.Error)
+
+	// the previous owner can no longer extend the lease
+	err = q.Heartbeat(ctx, &service.Task{ID: "123", LeaseOwner: "owner"}, time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
+
+func TestQueue_PollBatchClaimError(t *testing.T) {
+	ctx := context.Background()
+	store := &failingStore{Storage: memory.New(time.Minute), failID: "456"}
+	q, srv := newStoreQueue(t, store)
+
+	for _, id := range []string{"123", "456", "789"} {
+		assert.NoError(t, q.Add(ctx, &service.Task{ID: id, State: service.Created, CreatedAt: time.Now()}))
+	}
+
+	// the tasks claimed before the error are returned
+	tasks, err := q.PollBatch(ctx, "owner", time.Minute, 3)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "123", tasks[0].ID)
+
+	// the entries which were not claimed are claimed after the lease duration
+	store.failID = ""
+	srv.SetTime(time.Now().Add(2 * time.Minute))
+	tasks, err = q.PollBatch(ctx, "owner", time.Minute, 3)
+	require.NoError(t, err)
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	assert.Contains(t, ids, "456")
+	assert.Contains(t, ids, "789")
+}
+
+func TestQueue_CancelledTask(t *testing.T) {
+	ctx := context.Background()
+	q, _, _ := newQueue(t)
+
+	assert.NoError(t, q.Add(ctx, &service.Task{ID: "123", State: service.Created, CreatedAt: time.Now()}))
+	_, err := q.Cancel(ctx, "123")
+	require.NoError(t, err)
+
+	_, err = q.Poll(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
+
+func TestQueue_ScheduledTaskList(t *testing.T) {
+	ctx := context.Background()
+	q, _, _ := newQueue(t)
+
+	runAt := time.Now().Add(100 * time.Millisecond)
+	list := &service.TaskList{ID: "123", State: service.Created, CreatedAt: time.Now(), RunAt: runAt}
+	assert.NoError(t, q.AddTaskList(ctx, list, nil))
+
+	_, err := q.PollList(ctx, "owner", time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	time.Sleep(time.Until(runAt))
+	list, err = q.PollList(ctx, "owner", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "123", list.ID)
+	assert.NoError(t, q.HeartbeatList(ctx, list, time.Minute))
+	assert.NoError(t, q.AckList(ctx, list))
+}
@@ -0,0 +1,241 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// pendingRunTimeout is the time after which a pending run of a schedule is
+// claimed again, because the instance of the service which claimed it may
+// have stopped before creating its task or taskList.
+const pendingRunTimeout = time.Minute
+
+// Scheduler periodically checks the schedules in storage and creates
+// tasks and taskLists for the schedules which next run time has come.
+//
+// Multiple instances of the service can run a Scheduler at the same time.
+// Each run of a schedule is claimed in storage and recorded as pending
+// before the task or taskList is added to the queue, so only one instance
+// creates it. The ID of the task or taskList is generated when the run is
+// claimed, so a pending run which isn't completed, e.g. because the instance
+// which claimed it stopped, is claimed again after pendingRunTimeout and its
+// task or taskList is created with the same ID, unless it already exists.
+type Scheduler struct {
+	storage      service.Storage
+	queue        service.Queue
+	pollInterval time.Duration
+	logger       *zap.Logger
+}
+
+func New(storage service.Storage, queue service.Queue, pollInterval time.Duration, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		storage:      storage,
+		queue:        queue,
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+}
+
+func (s *Scheduler) Start(ctx context.Context) error {
+	defer s.logger.Info("scheduler stopped")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.pollInterval):
+			s.runDue(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) runDue(ctx context.Context) {
+	now := time.Now()
+	schedules, err := s.storage.DueSchedules(ctx, now)
+	if err != nil {
+		s.logger.Error("error getting due schedules from storage", zap.Error(err))
+		return
+	}
+
+	for _, schedule := range schedules {
+		if err := s.run(ctx, schedule, now); err != nil {
+			s.logger.Error("error running schedule", zap.String("scheduleID", schedule.ID), zap.Error(err))
+		}
+	}
+}
+
+// run creates a task or taskList for the current run of the schedule,
+// or for the pending run of the schedule if it's not completed in time.
+// If the service was not running when previous runs were due, they are
+// skipped and only a single run is made.
+func (s *Scheduler) run(ctx context.Context, schedule *service.Schedule, now time.Time) error {
+	if run := schedule.PendingRun; run != nil {
+		if now.Sub(run.ClaimedAt) < pendingRunTimeout {
+			// the run is being created by another instance of the service
+			return nil
+		}
+
+		if err := s.storage.ClaimPendingRun(ctx, schedule.ID, run, now); err != nil {
+			if errors.Is(errors.NotFound, err) {
+				// the run is claimed again or completed by another instance
+				return nil
+			}
+			return err
+		}
+
+		run.ClaimedAt = now
+		return s.create(ctx, schedule, run, true)
+	}
+
+	next, err := schedule.Next(now)
+	if err != nil {
+		return err
+	}
+
+	run := &service.ScheduleRun{ScheduledAt: schedule.NextRunAt, ID: uuid.NewString(), ClaimedAt: now}
+	if err := s.storage.ClaimSchedule(ctx, schedule.ID, next, run); err != nil {
+		if errors.Is(errors.NotFound, err) {
+			// the run is claimed by another instance of the service
+			return nil
+		}
+		return err
+	}
+
+	return s.create(ctx, schedule, run, false)
+}
+
+// create creates the task or taskList of a claimed run and completes the run.
+// If the creation fails, the run stays pending and is retried after
+// pendingRunTimeout, unless the template is missing, which will not
+// appear on retry, so the run is discarded.
+//
+// A retried run may have created its task or taskList before, so it's
+// created only if it doesn't exist.
+func (s *Scheduler) create(ctx context.Context, schedule *service.Schedule, run *service.ScheduleRun, retry bool) error {
+	var err error
+	if schedule.TaskName != "" {
+		err = s.createTask(ctx, schedule, run.ID, retry)
+	} else {
+		err = s.createTaskList(ctx, schedule, run.ID, retry)
+	}
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			if err := s.storage.DiscardScheduleRun(ctx, schedule.ID, run.ID); err != nil {
+				s.logger.Error("error discarding schedule run", zap.String("scheduleID", schedule.ID), zap.Error(err))
+			}
+		}
+		return err
+	}
+
+	return s.storage.CompleteScheduleRun(ctx, schedule.ID, run)
+}
+
+func (s *Scheduler) createTask(ctx context.Context, schedule *service.Schedule, id string, retry bool) error {
+	if retry {
+		exists, err := s.taskExists(ctx, id)
+		if err != nil || exists {
+			return err
+		}
+	}
+
+	template, err := s.storage.TaskTemplate(ctx, schedule.TaskName)
+	if err != nil {
+		return err
+	}
+
+	task := service.NewTask(template, service.CreateOptions{
+		ID:             id,
+		Request:        schedule.Request,
+		CreatedAt:      time.Now(),
+		CacheNamespace: &schedule.CacheNamespace,
+		CacheScope:     &schedule.CacheScope,
+	})
+
+	if err := s.queue.Add(ctx, task); err != nil {
+		return errors.New("failed to create task", err)
+	}
+
+	return nil
+}
+
+func (s *Scheduler) createTaskList(ctx context.Context, schedule *service.Schedule, id string, retry bool) error {
+	if retry {
+		exists, err := s.taskListExists(ctx, id)
+		if err != nil || exists {
+			return err
+		}
+	}
+
+	template, err := s.storage.TaskListTemplate(ctx, schedule.TaskListName)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, group := range template.Groups {
+		names = append(names, group.Tasks...)
+	}
+
+	taskTemplates, err := s.storage.TaskTemplates(ctx, names)
+	if err != nil {
+		return err
+	}
+
+	taskList, tasks, err := service.NewTaskList(template, taskTemplates, service.CreateOptions{
+		ID:             id,
+		Request:        schedule.Request,
+		CreatedAt:      time.Now(),
+		CacheNamespace: &schedule.CacheNamespace,
+		CacheScope:     &schedule.CacheScope,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.queue.AddTaskList(ctx, taskList, tasks); err != nil {
+		return errors.New("failed to create taskList", err)
+	}
+
+	return nil
+}
+
+// taskExists reports whether a task is in the queue, in the history or in the dead letters.
+func (s *Scheduler) taskExists(ctx context.Context, id string) (bool, error) {
+	_, err := s.storage.Task(ctx, id)
+	if err == nil || !errors.Is(errors.NotFound, err) {
+		return err == nil, err
+	}
+
+	_, err = s.storage.TaskHistory(ctx, id)
+	if err == nil || !errors.Is(errors.NotFound, err) {
+		return err == nil, err
+	}
+
+	_, err = s.storage.DeadLetter(ctx, id)
+	if err == nil || !errors.Is(errors.NotFound, err) {
+		return err == nil, err
+	}
+
+	return false, nil
+}
+
+// taskListExists reports whether a taskList is in the queue or in the history.
+func (s *Scheduler) taskListExists(ctx context.Context, id string) (bool, error) {
+	_, err := s.storage.TaskList(ctx, id)
+	if err == nil || !errors.Is(errors.NotFound, err) {
+		return err == nil, err
+	}
+
+	_, err = s.storage.TaskListHistory(ctx, id)
+	if err == nil || !errors.Is(errors.NotFound, err) {
+		return err == nil, err
+	}
+
+	return false, nil
+}
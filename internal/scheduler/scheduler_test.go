@@ -0,0 +1,118 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/memory"
+	"github.com/eclipse-xfsc/task-sheduler/internal/scheduler"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+func TestScheduler_Start(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		schedule *service.Schedule
+		history  *service.Task
+
+		tasks []string // tasks are the IDs of the queued and finished tasks, "*" is any ID
+		runs  []string // runs are the IDs of the completed runs, "*" is the ID of the task
+	}{
+		{
+			name: "due run creates a task",
+			schedule: &service.Schedule{
+				ID:        "schedule",
+				Cron:      "0 * * * *",
+				Timezone:  "UTC",
+				TaskName:  "task",
+				NextRunAt: now.Add(-time.Minute),
+			},
+			tasks: []string{"*"},
+			runs:  []string{"*"},
+		},
+		{
+			name: "pending run which is not completed in time is created with its ID",
+			schedule: &service.Schedule{
+				ID:         "schedule",
+				Cron:       "0 * * * *",
+				Timezone:   "UTC",
+				TaskName:   "task",
+				NextRunAt:  now.Add(time.Hour),
+				PendingRun: &service.ScheduleRun{ID: "123", ScheduledAt: now.Add(-2 * time.Minute), ClaimedAt: now.Add(-2 * time.Minute)},
+			},
+			tasks: []string{"123"},
+			runs:  []string{"123"},
+		},
+		{
+			name: "pending run which task is already created is completed",
+			schedule: &service.Schedule{
+				ID:         "schedule",
+				Cron:       "0 * * * *",
+				Timezone:   "UTC",
+				TaskName:   "task",
+				NextRunAt:  now.Add(time.Hour),
+				PendingRun: &service.ScheduleRun{ID: "123", ScheduledAt: now.Add(-2 * time.Minute), ClaimedAt: now.Add(-2 * time.Minute)},
+			},
+			history: &service.Task{ID: "123", Name: "task", State: service.Done, CreatedAt: now},
+			tasks:   []string{"123"},
+			runs:    []string{"123"},
+		},
+		{
+			name: "pending run which is being created is not claimed",
+			schedule: &service.Schedule{
+				ID:         "schedule",
+				Cron:       "0 * * * *",
+				Timezone:   "UTC",
+				TaskName:   "task",
+				NextRunAt:  now.Add(-time.Minute),
+				PendingRun: &service.ScheduleRun{ID: "123", ScheduledAt: now.Add(-time.Hour), ClaimedAt: now},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			storage := memory.New(time.Minute)
+			require.NoError(t, storage.AddTaskTemplate(ctx, &service.Task{Name: "task", URL: "https://example.com", Method: "GET"}))
+			require.NoError(t, storage.AddSchedule(ctx, test.schedule))
+			if test.history != nil {
+				require.NoError(t, storage.SaveTaskHistory(ctx, test.history))
+			}
+
+			runCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			_ = scheduler.New(storage, storage, 10*time.Millisecond, zap.NewNop()).Start(runCtx)
+
+			tasks, err := storage.Tasks(ctx, &service.Filter{Limit: 10})
+			require.NoError(t, err)
+			assert.Equal(t, len(test.tasks), len(tasks))
+			for i, id := range test.tasks {
+				if id != "*" {
+					assert.Equal(t, id, tasks[i].ID)
+				}
+			}
+
+			schedule, err := storage.Schedule(ctx, test.schedule.ID)
+			require.NoError(t, err)
+			assert.Equal(t, len(test.runs), len(schedule.Runs))
+			for i, id := range test.runs {
+				if id == "*" {
+					// the ID of the created task is recorded in the run
+					id = tasks[i].ID
+				}
+				assert.Equal(t, id, schedule.Runs[i].ID)
+			}
+			if len(test.runs) > 0 {
+				assert.Nil(t, schedule.PendingRun)
+			}
+		})
+	}
+}
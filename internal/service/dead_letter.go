@@ -0,0 +1,15 @@
+package service
+
+import "time"
+
+// MaxTaskErrors is the number of most recent execution errors kept for a task.
+const MaxTaskErrors = 10
+
+// DeadLetter is a task which was removed from the queue, because its
+// execution failed more times than the maximum number of retries.
+// It can be inspected and put back in the queue or purged.
+type DeadLetter struct {
+	ID             string    `json:"id"`             // ID of the task.
+	Task           *Task     `json:"task"`           // Task as it was when it was removed from the queue.
+	DeadLetteredAt time.Time `json:"deadLetteredAt"` // DeadLetteredAt is the time when the task was removed from the queue.
+}
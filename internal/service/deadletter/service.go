@@ -0,0 +1,184 @@
+package deadletter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goadeadletter "github.com/eclipse-xfsc/task-sheduler/gen/dead_letter"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// defaultListLimit is the number of dead letters returned by List
+// when the request doesn't specify a limit.
+const defaultListLimit = 20
+
+type Service struct {
+	storage service.Storage
+	queue   service.Queue
+	logger  *zap.Logger
+}
+
+// New creates the deadLetter service.
+func New(storage service.Storage, queue service.Queue, logger *zap.Logger) *Service {
+	return &Service{
+		storage: storage,
+		queue:   queue,
+		logger:  logger,
+	}
+}
+
+// List the dead-lettered tasks from the newest to the oldest.
+func (s *Service) List(ctx context.Context, req *goadeadletter.ListDeadLettersRequest) (*goadeadletter.DeadLettersResponse, error) {
+	var taskName string
+	if req.TaskName != nil {
+		taskName = *req.TaskName
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+
+	letters, err := s.storage.DeadLetters(ctx, taskName, limit)
+	if err != nil {
+		s.logger.Error("error getting dead letters from storage", zap.Error(err))
+		return nil, err
+	}
+
+	res := &goadeadletter.DeadLettersResponse{DeadLetters: []*goadeadletter.DeadLetter{}}
+	for _, letter := range letters {
+		r, err := toResponse(letter)
+		if err != nil {
+			s.logger.Error("error converting dead letter", zap.String("taskID", letter.ID), zap.Error(err))
+			return nil, err
+		}
+		res.DeadLetters = append(res.DeadLetters, r)
+	}
+
+	return res, nil
+}
+
+// Requeue puts a dead-lettered task back in the queue with the same ID.
+// The retries, errors and the last response of the task are reset, and if the request contains
+// data, it replaces the original payload of the task.
+func (s *Service) Requeue(ctx context.Context, req *goadeadletter.RequeueDeadLetterRequest) (*goadeadletter.RequeueDeadLetterResult, error) {
+	if req.TaskID == "" {
+		return nil, errors.New(errors.BadRequest, "missing taskID")
+	}
+
+	var request []byte
+	if req.Data != nil {
+		var err error
+		request, err = json.Marshal(req.Data)
+		if err != nil {
+			return nil, errors.New(errors.BadRequest, "error marshaling request data to JSON", err)
+		}
+	}
+
+	logger := s.logger.With(zap.String("taskID", req.TaskID))
+
+	// The dead letter is removed before the task is added to the queue,
+	// so that concurrent requests cannot requeue the task twice.
+	letter, err := s.storage.DeleteDeadLetter(ctx, req.TaskID)
+	if err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			logger.Error("error removing dead letter from storage", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	task := *letter.Task
+	if request != nil {
+		task.Request = request
+	}
+	task.State = service.Created
+	task.Retries = 0
+	task.Error = ""
+	task.Errors = nil
+	task.FailureReason = ""
+	task.Response = nil
+	task.ResponseCode = 0
+	task.RunAt = time.Time{}
+	task.NextAttemptAt = time.Time{}
+	task.StartedAt = time.Time{}
+	task.FinishedAt = time.Time{}
+	task.LeaseOwner = ""
+	task.LeaseExpiresAt = time.Time{}
+
+	if err := s.queue.Add(ctx, &task); err != nil {
+		logger.Error("error adding task to queue", zap.Error(err))
+		if err := s.storage.AddDeadLetter(ctx, letter); err != nil {
+			logger.Error("error restoring dead letter", zap.Error(err))
+		}
+		return nil, errors.New("failed to requeue task", err)
+	}
+
+	return &goadeadletter.RequeueDeadLetterResult{TaskID: task.ID}, nil
+}
+
+// Purge permanently removes the dead-lettered tasks matching the request.
+func (s *Service) Purge(ctx context.Context, req *goadeadletter.PurgeDeadLettersRequest) (*goadeadletter.PurgeDeadLettersResult, error) {
+	var taskName string
+	if req.TaskName != nil {
+		taskName = *req.TaskName
+	}
+
+	var before time.Time
+	if req.Before != nil {
+		var err error
+		before, err = time.Parse(time.RFC3339, *req.Before)
+		if err != nil {
+			return nil, errors.New(errors.BadRequest, "invalid before time", err)
+		}
+	}
+
+	purged, err := s.storage.PurgeDeadLetters(ctx, taskName, before)
+	if err != nil {
+		s.logger.Error("error purging dead letters", zap.Error(err))
+		return nil, err
+	}
+
+	return &goadeadletter.PurgeDeadLettersResult{Purged: purged}, nil
+}
+
+func toResponse(letter *service.DeadLetter) (*goadeadletter.DeadLetter, error) {
+	task := letter.Task
+	res := &goadeadletter.DeadLetter{
+		TaskID:         task.ID,
+		TaskName:       task.Name,
+		Retries:        task.Retries,
+		Errors:         []string{},
+		CreatedAt:      task.CreatedAt.Format(time.RFC3339),
+		DeadLetteredAt: letter.DeadLetteredAt.Format(time.RFC3339),
+	}
+	if task.CacheNamespace != "" {
+		res.CacheNamespace = &task.CacheNamespace
+	}
+	if task.CacheScope != "" {
+		res.CacheScope = &task.CacheScope
+	}
+	if task.ResponseCode != 0 {
+		res.ResponseCode = &task.ResponseCode
+	}
+	if len(task.Response) > 0 {
+		res.Response = ptr.String(string(task.Response))
+	}
+	if task.FailureReason != "" {
+		res.FailureReason = &task.FailureReason
+	}
+	res.Errors = append(res.Errors, task.Errors...)
+
+	if len(task.Request) > 0 {
+		if err := json.NewDecoder(bytes.NewReader(task.Request)).Decode(&res.Data); err != nil {
+			return nil, errors.New("error decoding task request data", err)
+		}
+	}
+
+	return res, nil
+}
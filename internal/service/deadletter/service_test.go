@@ -0,0 +1,313 @@
+package deadletter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goadeadletter "github.com/eclipse-xfsc/task-sheduler/gen/dead_letter"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/deadletter"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/servicefakes"
+)
+
+func TestNew(t *testing.T) {
+	svc := deadletter.New(nil, nil, zap.NewNop())
+	assert.Implements(t, (*goadeadletter.Service)(nil), svc)
+}
+
+func TestService_List(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		req     *goadeadletter.ListDeadLettersRequest
+		storage *servicefakes.FakeStorage
+
+		res     *goadeadletter.DeadLettersResponse
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "error getting dead letters from storage",
+			req:  &goadeadletter.ListDeadLettersRequest{},
+			storage: &servicefakes.FakeStorage{
+				DeadLettersStub: func(ctx context.Context, taskName string, limit int) ([]*service.DeadLetter, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "invalid task request data",
+			req:  &goadeadletter.ListDeadLettersRequest{},
+			storage: &servicefakes.FakeStorage{
+				DeadLettersStub: func(ctx context.Context, taskName string, limit int) ([]*service.DeadLetter, error) {
+					return []*service.DeadLetter{{ID: "123", Task: &service.Task{ID: "123", Request: []byte("{")}}}, nil
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "error decoding task request data",
+		},
+		{
+			name: "no dead letters",
+			req:  &goadeadletter.ListDeadLettersRequest{},
+			storage: &servicefakes.FakeStorage{
+				DeadLettersStub: func(ctx context.Context, taskName string, limit int) ([]*service.DeadLetter, error) {
+					return nil, nil
+				},
+			},
+			res: &goadeadletter.DeadLettersResponse{DeadLetters: []*goadeadletter.DeadLetter{}},
+		},
+		{
+			name: "successfully list dead letters",
+			req:  &goadeadletter.ListDeadLettersRequest{TaskName: ptr.String("task"), Limit: 5},
+			storage: &servicefakes.FakeStorage{
+				DeadLettersStub: func(ctx context.Context, taskName string, limit int) ([]*service.DeadLetter, error) {
+					if taskName != "task" || limit != 5 {
+						return nil, errors.New("unexpected filter")
+					}
+					return []*service.DeadLetter{
+						{
+							ID: "123",
+							Task: &service.Task{
+								ID:             "123",
+								Name:           "task",
+								Request:        []byte(`{"key":"value"}`),
+								Retries:        10,
+								Error:          "error executing http request",
+								Errors:         []string{"error executing http request"},
+								CacheNamespace: "login",
+								ResponseCode:   503,
+								Response:       []byte("service unavailable"),
+								FailureReason:  service.ReasonStatus,
+								CreatedAt:      createdAt,
+							},
+							DeadLetteredAt: createdAt.Add(time.Hour),
+						},
+					}, nil
+				},
+			},
+			res: &goadeadletter.DeadLettersResponse{
+				DeadLetters: []*goadeadletter.DeadLetter{
+					{
+						TaskID:         "123",
+						TaskName:       "task",
+						Data:           map[string]interface{}{"key": "value"},
+						Retries:        10,
+						Errors:         []string{"error executing http request"},
+						CacheNamespace: ptr.String("login"),
+						ResponseCode:   ptr.Int(503),
+						Response:       ptr.String("service unavailable"),
+						FailureReason:  ptr.String(service.ReasonStatus),
+						CreatedAt:      "2023-01-01T10:00:00Z",
+						DeadLetteredAt: "2023-01-01T11:00:00Z",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := deadletter.New(test.storage, nil, zap.NewNop())
+			res, err := svc.List(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestService_Requeue(t *testing.T) {
+	deadLetter := func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+		return &service.DeadLetter{
+			ID: taskID,
+			Task: &service.Task{
+				ID:         taskID,
+				Name:       "task",
+				State:      service.Failed,
+				Request:    []byte(`{"key":"value"}`),
+				Retries:    10,
+				Error:      "some error",
+				Errors:     []string{"some error"},
+				FinishedAt: time.Now(),
+			},
+			DeadLetteredAt: time.Now(),
+		}, nil
+	}
+
+	tests := []struct {
+		name    string
+		req     *goadeadletter.RequeueDeadLetterRequest
+		storage *servicefakes.FakeStorage
+		queue   *servicefakes.FakeQueue
+
+		restored bool
+		errkind  errors.Kind
+		errtext  string
+	}{
+		{
+			name:    "missing taskID",
+			req:     &goadeadletter.RequeueDeadLetterRequest{},
+			errkind: errors.BadRequest,
+			errtext: "missing taskID",
+		},
+		{
+			name: "dead letter not found",
+			req:  &goadeadletter.RequeueDeadLetterRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				DeleteDeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "dead letter not found",
+		},
+		{
+			name: "dead letter is restored when adding the task to queue fails",
+			req:  &goadeadletter.RequeueDeadLetterRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				DeleteDeadLetterStub: deadLetter,
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, task *service.Task) error {
+					return errors.New("some error")
+				},
+			},
+			restored: true,
+			errkind:  errors.Unknown,
+			errtext:  "failed to requeue task",
+		},
+		{
+			name: "successfully requeue task with the original payload",
+			req:  &goadeadletter.RequeueDeadLetterRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				DeleteDeadLetterStub: deadLetter,
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, task *service.Task) error {
+					if string(task.Request) != `{"key":"value"}` {
+						return errors.New("unexpected request")
+					}
+					if task.State != service.Created || task.Retries != 0 || task.Error != "" || len(task.Errors) != 0 || !task.FinishedAt.IsZero() {
+						return errors.New("task is not reset")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully requeue task with edited payload",
+			req:  &goadeadletter.RequeueDeadLetterRequest{TaskID: "123", Data: map[string]interface{}{"key": "new value"}},
+			storage: &servicefakes.FakeStorage{
+				DeleteDeadLetterStub: deadLetter,
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, task *service.Task) error {
+					if string(task.Request) != `{"key":"new value"}` {
+						return errors.New("unexpected request")
+					}
+					return nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := deadletter.New(test.storage, test.queue, zap.NewNop())
+			res, err := svc.Requeue(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.req.TaskID, res.TaskID)
+			}
+
+			if test.storage != nil {
+				assert.Equal(t, test.restored, test.storage.AddDeadLetterCallCount() == 1)
+			}
+		})
+	}
+}
+
+func TestService_Purge(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goadeadletter.PurgeDeadLettersRequest
+		storage *servicefakes.FakeStorage
+
+		purged  int
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "invalid before time",
+			req:     &goadeadletter.PurgeDeadLettersRequest{Before: ptr.String("yesterday")},
+			errkind: errors.BadRequest,
+			errtext: "invalid before time",
+		},
+		{
+			name: "error purging dead letters",
+			req:  &goadeadletter.PurgeDeadLettersRequest{},
+			storage: &servicefakes.FakeStorage{
+				PurgeDeadLettersStub: func(ctx context.Context, taskName string, before time.Time) (int, error) {
+					return 0, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "successfully purge dead letters",
+			req:  &goadeadletter.PurgeDeadLettersRequest{TaskName: ptr.String("task"), Before: ptr.String("2023-01-01T10:00:00Z")},
+			storage: &servicefakes.FakeStorage{
+				PurgeDeadLettersStub: func(ctx context.Context, taskName string, before time.Time) (int, error) {
+					if taskName != "task" || !before.Equal(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)) {
+						return 0, errors.New("unexpected filter")
+					}
+					return 3, nil
+				},
+			},
+			purged: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := deadletter.New(test.storage, nil, zap.NewNop())
+			res, err := svc.Purge(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.purged, res.Purged)
+			}
+		})
+	}
+}
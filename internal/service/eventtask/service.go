@@ -0,0 +1,130 @@
+package eventtask
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goaeventtask "github.com/eclipse-xfsc/task-sheduler/gen/event_task"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+type Service struct {
+	storage service.Storage
+	logger  *zap.Logger
+}
+
+// New creates the eventTask service.
+func New(storage service.Storage, logger *zap.Logger) *Service {
+	return &Service{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Create an event task binding. The binding is rejected if the referenced
+// task template does not exist or does not execute a policy, because
+// tasks created for cache events must execute a policy.
+func (s *Service) Create(ctx context.Context, req *goaeventtask.EventTask) (*goaeventtask.EventTask, error) {
+	eventTask := fromRequest(req)
+	if eventTask.Key == "" {
+		return nil, errors.New(errors.BadRequest, "missing key")
+	}
+
+	if eventTask.TaskName == "" {
+		return nil, errors.New(errors.BadRequest, "missing taskName")
+	}
+
+	template, err := s.storage.TaskTemplate(ctx, eventTask.TaskName)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			return nil, errors.New(errors.BadRequest, "invalid eventTask: task template not found: "+eventTask.TaskName)
+		}
+		s.logger.Error("error getting task template from storage", zap.String("taskName", eventTask.TaskName), zap.Error(err))
+		return nil, err
+	}
+
+	if template.RequestPolicy == "" {
+		return nil, errors.New(errors.BadRequest, "invalid eventTask: task template must execute a policy")
+	}
+
+	if err := s.storage.AddEventTask(ctx, eventTask); err != nil {
+		if !errors.Is(errors.Exist, err) {
+			s.logger.Error("error adding eventTask to storage", zap.String("key", eventTask.Key), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return toResponse(eventTask), nil
+}
+
+// Delete an event task binding by cache key, namespace and scope.
+func (s *Service) Delete(ctx context.Context, req *goaeventtask.EventTaskRequest) error {
+	if req.Key == "" {
+		return errors.New(errors.BadRequest, "missing key")
+	}
+
+	var namespace, scope string
+	if req.Namespace != nil {
+		namespace = *req.Namespace
+	}
+	if req.Scope != nil {
+		scope = *req.Scope
+	}
+
+	if err := s.storage.DeleteEventTask(ctx, req.Key, namespace, scope); err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			s.logger.Error("error deleting eventTask from storage", zap.String("key", req.Key), zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// List all event task bindings.
+func (s *Service) List(ctx context.Context) (*goaeventtask.EventTasksResponse, error) {
+	eventTasks, err := s.storage.ListEventTasks(ctx)
+	if err != nil {
+		s.logger.Error("error getting eventTasks from storage", zap.Error(err))
+		return nil, err
+	}
+
+	res := &goaeventtask.EventTasksResponse{EventTasks: []*goaeventtask.EventTask{}}
+	for _, eventTask := range eventTasks {
+		res.EventTasks = append(res.EventTasks, toResponse(eventTask))
+	}
+
+	return res, nil
+}
+
+func fromRequest(req *goaeventtask.EventTask) *service.EventTask {
+	eventTask := &service.EventTask{
+		Key:      req.Key,
+		TaskName: req.TaskName,
+	}
+	if req.Namespace != nil {
+		eventTask.Namespace = *req.Namespace
+	}
+	if req.Scope != nil {
+		eventTask.Scope = *req.Scope
+	}
+
+	return eventTask
+}
+
+func toResponse(eventTask *service.EventTask) *goaeventtask.EventTask {
+	res := &goaeventtask.EventTask{
+		Key:      eventTask.Key,
+		TaskName: eventTask.TaskName,
+	}
+	if eventTask.Namespace != "" {
+		res.Namespace = &eventTask.Namespace
+	}
+	if eventTask.Scope != "" {
+		res.Scope = &eventTask.Scope
+	}
+
+	return res
+}
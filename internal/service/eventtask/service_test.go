@@ -0,0 +1,205 @@
+package eventtask_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goaeventtask "github.com/eclipse-xfsc/task-sheduler/gen/event_task"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/eventtask"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/servicefakes"
+)
+
+func TestNew(t *testing.T) {
+	svc := eventtask.New(nil, zap.NewNop())
+	assert.Implements(t, (*goaeventtask.Service)(nil), svc)
+}
+
+func TestService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goaeventtask.EventTask
+		storage *servicefakes.FakeStorage
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing key",
+			req:     &goaeventtask.EventTask{TaskName: "task"},
+			errkind: errors.BadRequest,
+			errtext: "missing key",
+		},
+		{
+			name:    "missing task name",
+			req:     &goaeventtask.EventTask{Key: "did:web:example.com"},
+			errkind: errors.BadRequest,
+			errtext: "missing taskName",
+		},
+		{
+			name: "task template not found",
+			req:  &goaeventtask.EventTask{Key: "did:web:example.com", TaskName: "task"},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound, "task template not found")
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task template not found: task",
+		},
+		{
+			name: "error getting task template",
+			req:  &goaeventtask.EventTask{Key: "did:web:example.com", TaskName: "task"},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "task template without request policy",
+			req:  &goaeventtask.EventTask{Key: "did:web:example.com", TaskName: "task"},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{Name: taskName, URL: "https://example.com", Method: "GET"}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task template must execute a policy",
+		},
+		{
+			name: "eventTask already exists",
+			req:  &goaeventtask.EventTask{Key: "did:web:example.com", TaskName: "task"},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{Name: taskName, RequestPolicy: "policies/example/example/1.0"}, nil
+				},
+				AddEventTaskStub: func(ctx context.Context, eventTask *service.EventTask) error {
+					return errors.New(errors.Exist, "eventTask already exists")
+				},
+			},
+			errkind: errors.Exist,
+			errtext: "eventTask already exists",
+		},
+		{
+			name: "successfully create eventTask",
+			req: &goaeventtask.EventTask{
+				Key:       "did:web:example.com",
+				Namespace: ptr.String("Login"),
+				Scope:     ptr.String("Administration"),
+				TaskName:  "task",
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{Name: taskName, RequestPolicy: "policies/example/example/1.0"}, nil
+				},
+				AddEventTaskStub: func(ctx context.Context, eventTask *service.EventTask) error {
+					return nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := eventtask.New(test.storage, zap.NewNop())
+			res, err := svc.Create(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.req, res)
+			}
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goaeventtask.EventTaskRequest
+		storage *servicefakes.FakeStorage
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing key",
+			req:     &goaeventtask.EventTaskRequest{},
+			errkind: errors.BadRequest,
+			errtext: "missing key",
+		},
+		{
+			name: "eventTask not found",
+			req:  &goaeventtask.EventTaskRequest{Key: "did:web:example.com"},
+			storage: &servicefakes.FakeStorage{
+				DeleteEventTaskStub: func(ctx context.Context, key, namespace, scope string) error {
+					return errors.New(errors.NotFound, "eventTask not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "eventTask not found",
+		},
+		{
+			name: "successfully delete eventTask",
+			req:  &goaeventtask.EventTaskRequest{Key: "did:web:example.com", Namespace: ptr.String("Login")},
+			storage: &servicefakes.FakeStorage{
+				DeleteEventTaskStub: func(ctx context.Context, key, namespace, scope string) error {
+					if namespace != "Login" || scope != "" {
+						return errors.New("unexpected namespace or scope")
+					}
+					return nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := eventtask.New(test.storage, zap.NewNop())
+			err := svc.Delete(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			} else {
+				assert.Empty(t, test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	storage := &servicefakes.FakeStorage{
+		ListEventTasksStub: func(ctx context.Context) ([]*service.EventTask, error) {
+			return []*service.EventTask{
+				{Key: "key1", TaskName: "task1"},
+				{Key: "key2", Namespace: "Login", Scope: "Administration", TaskName: "task2"},
+			}, nil
+		},
+	}
+
+	svc := eventtask.New(storage, zap.NewNop())
+	res, err := svc.List(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &goaeventtask.EventTasksResponse{
+		EventTasks: []*goaeventtask.EventTask{
+			{Key: "key1", TaskName: "task1"},
+			{Key: "key2", Namespace: ptr.String("Login"), Scope: ptr.String("Administration"), TaskName: "task2"},
+		},
+	}, res)
+}
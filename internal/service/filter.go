@@ -0,0 +1,166 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// DefaultSearchLimit is the number of tasks or taskLists returned by a search
+// when the request doesn't specify a limit.
+const DefaultSearchLimit = 20
+
+// SearchRequest contains the criteria of a task or taskList search request.
+// The search requests of the task and taskList services can be converted to it.
+type SearchRequest struct {
+	Name           *string
+	State          *string
+	CacheNamespace *string
+	CacheScope     *string
+	CreatedAfter   *string
+	CreatedBefore  *string
+	Sort           string
+	Limit          int
+	Cursor         *string
+}
+
+// Filter specifies the criteria for searching tasks and taskLists in storage.
+type Filter struct {
+	Name           string
+	State          string
+	CacheNamespace string
+	CacheScope     string
+	CreatedAfter   time.Time // CreatedAfter if set, selects items created at or after the given time.
+	CreatedBefore  time.Time // CreatedBefore if set, selects items created before the given time.
+	Descending     bool      // Descending sorts the results from the newest to the oldest.
+	After          *Cursor   // After if set, selects items positioned after the cursor in the sort order.
+	Limit          int       // Limit is the maximum number of items to return.
+}
+
+// Cursor is the position of an item in a list of results sorted
+// by creation time. The ID is used to order items created at the same time.
+type Cursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        string    `json:"id"`
+}
+
+// Encode returns the cursor as an opaque string which can be given to clients.
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// SearchFilter creates a storage search filter from the search request.
+func SearchFilter(req *SearchRequest) (*Filter, error) {
+	filter := &Filter{
+		Descending: req.Sort != "createdAt",
+		Limit:      req.Limit,
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultSearchLimit
+	}
+
+	if req.Name != nil {
+		filter.Name = *req.Name
+	}
+	if req.State != nil {
+		filter.State = *req.State
+	}
+	if req.CacheNamespace != nil {
+		filter.CacheNamespace = *req.CacheNamespace
+	}
+	if req.CacheScope != nil {
+		filter.CacheScope = *req.CacheScope
+	}
+
+	var err error
+	if filter.CreatedAfter, err = ParseTime(req.CreatedAfter); err != nil {
+		return nil, err
+	}
+	if filter.CreatedBefore, err = ParseTime(req.CreatedBefore); err != nil {
+		return nil, err
+	}
+
+	if req.Cursor != nil && *req.Cursor != "" {
+		if filter.After, err = DecodeCursor(*req.Cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	return filter, nil
+}
+
+// DecodeCursor parses a cursor created with Cursor.Encode.
+func DecodeCursor(cursor string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid cursor", err)
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid cursor", err)
+	}
+
+	return &c, nil
+}
+
+// ParseRunAt returns the time at which a task or taskList should be executed,
+// given either as an RFC3339 time or as a delay relative to now. A zero time
+// is returned if neither is set, meaning that execution should not be delayed.
+func ParseRunAt(runAt, delay *string, now time.Time) (time.Time, error) {
+	if runAt != nil && *runAt != "" && delay != nil && *delay != "" {
+		return time.Time{}, errors.New(errors.BadRequest, "runAt and delay cannot be used together")
+	}
+
+	if delay != nil && *delay != "" {
+		d, err := time.ParseDuration(*delay)
+		if err != nil {
+			return time.Time{}, errors.New(errors.BadRequest, "invalid delay format", err)
+		}
+		if d < 0 {
+			return time.Time{}, errors.New(errors.BadRequest, "delay cannot be negative")
+		}
+		return now.Add(d), nil
+	}
+
+	return ParseTime(runAt)
+}
+
+// ParseWait returns the time for which a create request should wait for the
+// created task or taskList to complete. The wait time is limited to max.
+// Zero is returned if wait is not set, meaning that the request should not wait.
+func ParseWait(wait *string, max time.Duration) (time.Duration, error) {
+	if wait == nil || *wait == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(*wait)
+	if err != nil {
+		return 0, errors.New(errors.BadRequest, "invalid wait format", err)
+	}
+	if d < 0 {
+		return 0, errors.New(errors.BadRequest, "wait cannot be negative")
+	}
+	if d > max {
+		d = max
+	}
+
+	return d, nil
+}
+
+// ParseTime parses an optional RFC3339 time parameter.
+func ParseTime(value *string) (time.Time, error) {
+	if value == nil || *value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return time.Time{}, errors.New(errors.BadRequest, "invalid time format, must be RFC3339", err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// IdempotencyKey records the task or taskList created by a request with
+// an Idempotency-Key header, so that retries of the request don't create
+// it again.
+type IdempotencyKey struct {
+	Key         string    `json:"key"`
+	RequestHash string    `json:"requestHash"` // RequestHash identifies the request which used the key.
+	ResourceID  string    `json:"resourceID"`  // ResourceID is the ID of the task or taskList created by the request.
+	CreatedAt   time.Time `json:"createdAt"`
+	ExpiresAt   time.Time `json:"expiresAt"` // ExpiresAt is the time after which the key can be used for a new request.
+}
+
+// RequestHash calculates the hash of a create request. The kind of the
+// created resource is part of the hash, so that the same key cannot be used
+// for creating both a task and a taskList.
+func RequestHash(kind string, req interface{}) (string, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return "", errors.New("error marshaling request", err)
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(kind))
+	hash.Write(data)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// ReserveIdempotencyKey saves the idempotency key together with the ID of the
+// task or taskList which is about to be created. If the key is already used
+// by the same request and has not expired, the ID of the task or taskList created
+// by the original request is returned and reserved is false. Reusing a key with
+// a different request is a conflict error.
+func ReserveIdempotencyKey(ctx context.Context, storage Storage, key *IdempotencyKey) (id string, reserved bool, err error) {
+	err = storage.AddIdempotencyKey(ctx, key)
+	if err == nil {
+		return key.ResourceID, true, nil
+	}
+	if !errors.Is(errors.Exist, err) {
+		return "", false, err
+	}
+
+	existing, err := storage.IdempotencyKey(ctx, key.Key)
+	if err != nil {
+		return "", false, err
+	}
+
+	if existing.RequestHash != key.RequestHash {
+		return "", false, errors.New(errors.Exist, "idempotency key is already used with a different request")
+	}
+
+	return existing.ResourceID, false, nil
+}
@@ -0,0 +1,14 @@
+package service
+
+import "time"
+
+// LeaseExpiredError is the error of a task which was returned to the queue,
+// because the instance of the service executing it stopped renewing its lease.
+const LeaseExpiredError = "task lease expired"
+
+// HeartbeatInterval returns how often the lease of a task or taskList is
+// renewed during its execution, so that a single missed heartbeat doesn't
+// let the lease expire.
+func HeartbeatInterval(lease time.Duration) time.Duration {
+	return lease / 3
+}
@@ -0,0 +1,24 @@
+package service
+
+import "time"
+
+// MaxPriority is the highest priority of a task or taskList.
+// The lowest and default priority is 0.
+const MaxPriority = 9
+
+// Rank returns the time by which tasks and taskLists are ordered in the queue,
+// the ones with the earliest rank being polled first. The rank is the time at
+// which the task becomes ready for execution, moved back by one aging period
+// for each priority level.
+//
+// Tasks with higher priority are polled before tasks with lower priority which
+// became ready up to `priority * aging` earlier. A task with lower priority which
+// has waited longer than that is polled first, so it cannot be starved by a
+// constant flow of tasks with higher priority.
+func Rank(createdAt, runAt time.Time, priority int, aging time.Duration) time.Time {
+	readyAt := createdAt
+	if runAt.After(readyAt) {
+		readyAt = runAt
+	}
+	return readyAt.Add(-time.Duration(priority) * aging)
+}
@@ -1,19 +1,51 @@
 package service
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 //go:generate counterfeiter . Queue
 
 type Queue interface {
 	// Task related methods
 	Add(ctx context.Context, task *Task) error
-	Poll(ctx context.Context) (*Task, error)
+	Poll(ctx context.Context, owner string, lease time.Duration) (*Task, error)
+	PollBatch(ctx context.Context, owner string, lease time.Duration, limit int) ([]*Task, error)
+	Heartbeat(ctx context.Context, task *Task, lease time.Duration) error
 	Ack(ctx context.Context, task *Task) error
 	Unack(ctx context.Context, task *Task) error
+	Cancel(ctx context.Context, taskID string) (*Task, error)
 
 	// TaskList related methods
 	AddTaskList(ctx context.Context, taskList *TaskList, tasks []*Task) error
-	PollList(ctx context.Context) (*TaskList, error)
+	PollList(ctx context.Context, owner string, lease time.Duration) (*TaskList, error)
+	HeartbeatList(ctx context.Context, taskList *TaskList, lease time.Duration) error
 	AckList(ctx context.Context, taskList *TaskList) error
 	AckGroupTasks(ctx context.Context, group *Group) error
+
+	// Lease related methods
+	RequeueExpired(ctx context.Context, now time.Time) (int, error)
+	RequeueExpiredLists(ctx context.Context, now time.Time) (int, error)
+	ArchiveCancelled(ctx context.Context, now time.Time) (int, error)
+}
+
+// Store keeps the state of the tasks and taskLists dispatched by a queue
+// which delivers only their IDs, e.g. through a message broker, so that
+// clients can find and cancel them, and the tasks of the taskList groups,
+// which are fetched by the taskList executor.
+type Store interface {
+	Queue
+	ClaimTask(ctx context.Context, taskID, owner string, lease time.Duration) (*Task, error)
+	ClaimTaskList(ctx context.Context, taskListID, owner string, lease time.Duration) (*TaskList, error)
+}
+
+// QueueWatcher notifies the executors when tasks or taskLists which may be
+// executed are added or returned to the queue, so that they don't have to
+// wait for the next poll. A notification doesn't guarantee that there is
+// work in the queue, and work which becomes due later (e.g. scheduled tasks)
+// is not notified, so the executors still poll the queue periodically.
+type QueueWatcher interface {
+	WatchTasks(ctx context.Context) (<-chan struct{}, error)
+	WatchTaskLists(ctx context.Context) (<-chan struct{}, error)
 }
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"math/rand"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// Reasons of task execution failures, which can be used in
+// retry policies to select the errors that are retried.
+const (
+	// ReasonHTTP is a failure of the HTTP request of a task.
+	ReasonHTTP = "http"
+
+	// ReasonPolicy is a failure of a policy evaluation.
+	ReasonPolicy = "policy"
+
+	// ReasonCache is a failure to store the task result in the cache.
+	ReasonCache = "cache"
+
+	// ReasonStatus is a response to the HTTP request of a task
+	// with a status code which is not accepted.
+	ReasonStatus = "status"
+
+	// ReasonTimeout is a task execution which exceeded the timeout of
+	// the task or the deadline of its group or taskList.
+	ReasonTimeout = "timeout"
+)
+
+// ExecutionError is an error of a task execution together
+// with the reason of the failure.
+type ExecutionError struct {
+	Reason string
+	Err    error
+
+	// Permanent is set when retrying the task cannot succeed.
+	Permanent bool
+
+	// RetryAfter if set, is the minimum time to wait before retrying the task.
+	RetryAfter time.Duration
+}
+
+func (e *ExecutionError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExecutionError) Unwrap() error {
+	return e.Err
+}
+
+// FailureReason returns the reason of a task execution failure,
+// or an empty string if the error is not an ExecutionError.
+func FailureReason(err error) string {
+	if e, ok := err.(*ExecutionError); ok {
+		return e.Reason
+	}
+	return ""
+}
+
+// TimeoutError returns the error of a task execution which exceeded its deadline.
+// The execution may be retried, as the next attempt may complete in time.
+func TimeoutError(err error) *ExecutionError {
+	return &ExecutionError{
+		Reason: ReasonTimeout,
+		Err:    errors.New(errors.Timeout, "task execution timed out", err),
+	}
+}
+
+// RetryPolicy specifies how failed executions of a task are retried.
+// Zero values are replaced by the defaults of the task executor.
+type RetryPolicy struct {
+	MaxAttempts int           `json:"maxAttempts"` // MaxAttempts is the maximum number of task executions.
+	BaseDelay   time.Duration `json:"baseDelay"`   // BaseDelay is the delay before the first retry.
+	MaxDelay    time.Duration `json:"maxDelay"`    // MaxDelay is the maximum delay between retries.
+	RetryOn     []string      `json:"retryOn"`     // RetryOn contains the retried failure reasons, all failures are retried if empty.
+}
+
+// WithDefaults returns a copy of the policy in which zero values are replaced
+// by the values of the given default policy. The policy can be nil.
+func (p *RetryPolicy) WithDefaults(defaults RetryPolicy) RetryPolicy {
+	if p == nil {
+		return defaults
+	}
+
+	policy := *p
+	if policy.MaxAttempts == 0 {
+		policy.MaxAttempts = defaults.MaxAttempts
+	}
+	if policy.BaseDelay == 0 {
+		policy.BaseDelay = defaults.BaseDelay
+	}
+	if policy.MaxDelay == 0 {
+		policy.MaxDelay = defaults.MaxDelay
+	}
+	if len(policy.RetryOn) == 0 {
+		policy.RetryOn = defaults.RetryOn
+	}
+	return policy
+}
+
+// Retryable reports whether a failure with the given reason is retried.
+func (p RetryPolicy) Retryable(reason string) bool {
+	if len(p.RetryOn) == 0 {
+		return true
+	}
+	for _, r := range p.RetryOn {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the delay before the next execution of a task which has
+// failed the given number of times. The delay grows exponentially from the
+// base delay up to the maximum delay, and a random jitter of up to a half
+// of the delay is subtracted, so that tasks which failed at the same time
+// are not retried at the same time.
+func (p RetryPolicy) Backoff(failures int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return delay - time.Duration(rand.Int63n(int64(half)+1)) //nolint:gosec
+}
@@ -0,0 +1,147 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+func TestRetryPolicy_WithDefaults(t *testing.T) {
+	defaults := service.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: time.Minute, RetryOn: []string{service.ReasonHTTP}}
+
+	tests := []struct {
+		name   string
+		policy *service.RetryPolicy
+
+		result service.RetryPolicy
+	}{
+		{
+			name:   "nil policy",
+			result: defaults,
+		},
+		{
+			name:   "empty policy",
+			policy: &service.RetryPolicy{},
+			result: defaults,
+		},
+		{
+			name:   "overridden values",
+			policy: &service.RetryPolicy{MaxAttempts: 2, MaxDelay: time.Hour, RetryOn: []string{service.ReasonTimeout}},
+			result: service.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Second, MaxDelay: time.Hour, RetryOn: []string{service.ReasonTimeout}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.result, test.policy.WithDefaults(defaults))
+		})
+	}
+}
+
+func TestRetryPolicy_Retryable(t *testing.T) {
+	tests := []struct {
+		name    string
+		retryOn []string
+		reason  string
+
+		retryable bool
+	}{
+		{
+			name:      "all failures are retried without retryOn",
+			reason:    service.ReasonStatus,
+			retryable: true,
+		},
+		{
+			name:      "failures without reason are retried without retryOn",
+			retryable: true,
+		},
+		{
+			name:      "listed reason is retried",
+			retryOn:   []string{service.ReasonHTTP, service.ReasonTimeout},
+			reason:    service.ReasonTimeout,
+			retryable: true,
+		},
+		{
+			name:    "reason which is not listed is not retried",
+			retryOn: []string{service.ReasonHTTP, service.ReasonTimeout},
+			reason:  service.ReasonStatus,
+		},
+		{
+			name:    "failure without reason is not retried with retryOn",
+			retryOn: []string{service.ReasonHTTP},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy := service.RetryPolicy{RetryOn: test.retryOn}
+			assert.Equal(t, test.retryable, policy.Retryable(test.reason))
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   service.RetryPolicy
+		failures int
+
+		// delay is the backoff delay before the jitter is subtracted,
+		// the returned delay is between a half of it and the delay
+		delay time.Duration
+	}{
+		{
+			name:     "first retry uses the base delay",
+			policy:   service.RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Minute},
+			failures: 1,
+			delay:    time.Second,
+		},
+		{
+			name:     "delay doubles with every failure",
+			policy:   service.RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Minute},
+			failures: 4,
+			delay:    8 * time.Second,
+		},
+		{
+			name:     "delay is capped at the maximum delay",
+			policy:   service.RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Minute},
+			failures: 7,
+			delay:    time.Minute,
+		},
+		{
+			name:     "delay is capped after many failures",
+			policy:   service.RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Minute},
+			failures: 1000,
+			delay:    time.Minute,
+		},
+		{
+			name:     "base delay is capped at the maximum delay",
+			policy:   service.RetryPolicy{BaseDelay: time.Hour, MaxDelay: time.Minute},
+			failures: 1,
+			delay:    time.Minute,
+		},
+		{
+			name:     "zero delay",
+			failures: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// the jitter is random, so the delay is checked repeatedly
+			delays := make(map[time.Duration]bool)
+			for i := 0; i < 100; i++ {
+				delay := test.policy.Backoff(test.failures)
+				assert.GreaterOrEqual(t, delay, test.delay/2)
+				assert.LessOrEqual(t, delay, test.delay)
+				delays[delay] = true
+			}
+			if test.delay > 0 {
+				assert.Greater(t, len(delays), 1, "delay has no jitter")
+			}
+		})
+	}
+}
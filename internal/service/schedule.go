@@ -0,0 +1,60 @@
+package service
+
+import (
+	"time"
+	_ "time/tzdata" // timezones must be available in minimal container images
+
+	"github.com/robfig/cron/v3"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// MaxScheduleRuns is the number of most recent runs kept for a schedule.
+const MaxScheduleRuns = 20
+
+// Schedule creates a task or a taskList periodically according
+// to a cron expression. Exactly one of TaskName and TaskListName is set.
+type Schedule struct {
+	ID             string        `json:"id"`
+	Cron           string        `json:"cron"`           // Cron is a standard cron expression (minute, hour, day of month, month, day of week).
+	Timezone       string        `json:"timezone"`       // Timezone in which the cron expression is evaluated.
+	TaskName       string        `json:"taskName"`       // TaskName is the name of the task template to create tasks from.
+	TaskListName   string        `json:"taskListName"`   // TaskListName is the name of the taskList template to create taskLists from.
+	Request        []byte        `json:"request"`        // Request is the input of the created tasks and taskLists.
+	CacheNamespace string        `json:"cacheNamespace"` // CacheNamespace if set, overrides the template cache key namespace.
+	CacheScope     string        `json:"cacheScope"`     // CacheScope if set, overrides the template cache key scope.
+	NextRunAt      time.Time     `json:"nextRunAt"`      // NextRunAt is the time of the next run.
+	LastRunAt      time.Time     `json:"lastRunAt"`      // LastRunAt is the time of the last run.
+	Runs           []ScheduleRun `json:"runs"`           // Runs contains the most recent runs.
+	CreatedAt      time.Time     `json:"createdAt"`
+
+	// PendingRun is the claimed run which task or taskList may not be
+	// created yet. It's recorded in Runs when the creation is completed.
+	PendingRun *ScheduleRun `json:"pendingRun"`
+}
+
+// ScheduleRun is a single run of a schedule.
+type ScheduleRun struct {
+	ScheduledAt time.Time `json:"scheduledAt"` // ScheduledAt is the time for which the run was scheduled.
+	ID          string    `json:"id"`          // ID of the created task or taskList.
+	ClaimedAt   time.Time `json:"claimedAt"`   // ClaimedAt is the time when the run was last claimed.
+}
+
+// Next returns the first time after the given one when the schedule should run.
+func (s *Schedule) Next(after time.Time) (time.Time, error) {
+	sched, err := cron.ParseStandard(s.Cron)
+	if err != nil {
+		return time.Time{}, errors.New(errors.BadRequest, "invalid cron expression", err)
+	}
+
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.Time{}, errors.New(errors.BadRequest, "invalid timezone", err)
+	}
+
+	if spec, ok := sched.(*cron.SpecSchedule); ok {
+		spec.Location = loc
+	}
+
+	return sched.Next(after.In(loc)).UTC(), nil
+}
@@ -0,0 +1,199 @@
+package schedule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goaschedule "github.com/eclipse-xfsc/task-sheduler/gen/schedule"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+type Service struct {
+	storage service.Storage
+	logger  *zap.Logger
+}
+
+// New creates the schedule service.
+func New(storage service.Storage, logger *zap.Logger) *Service {
+	return &Service{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Create a schedule for periodic creation of a task or a taskList.
+func (s *Service) Create(ctx context.Context, req *goaschedule.CreateScheduleRequest) (*goaschedule.Schedule, error) {
+	schedule := &service.Schedule{
+		ID:        uuid.NewString(),
+		Cron:      req.Cron,
+		Timezone:  "UTC",
+		Runs:      []service.ScheduleRun{},
+		CreatedAt: time.Now(),
+	}
+	if req.Timezone != nil && *req.Timezone != "" {
+		schedule.Timezone = *req.Timezone
+	}
+	if req.TaskName != nil {
+		schedule.TaskName = *req.TaskName
+	}
+	if req.TaskListName != nil {
+		schedule.TaskListName = *req.TaskListName
+	}
+	if req.CacheNamespace != nil {
+		schedule.CacheNamespace = *req.CacheNamespace
+	}
+	if req.CacheScope != nil {
+		schedule.CacheScope = *req.CacheScope
+	}
+
+	if (schedule.TaskName == "") == (schedule.TaskListName == "") {
+		return nil, errors.New(errors.BadRequest, "invalid schedule: exactly one of taskName and taskListName must be set")
+	}
+
+	next, err := schedule.Next(schedule.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	schedule.NextRunAt = next
+
+	if err := s.checkTemplate(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	request, err := json.Marshal(req.Data)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "error marshaling request data to JSON", err)
+	}
+	schedule.Request = request
+
+	if err := s.storage.AddSchedule(ctx, schedule); err != nil {
+		s.logger.Error("error adding schedule to storage", zap.Error(err))
+		return nil, err
+	}
+
+	return toResponse(schedule)
+}
+
+// Get a schedule by ID.
+func (s *Service) Get(ctx context.Context, req *goaschedule.ScheduleRequest) (*goaschedule.Schedule, error) {
+	if req.ScheduleID == "" {
+		return nil, errors.New(errors.BadRequest, "missing scheduleID")
+	}
+
+	schedule, err := s.storage.Schedule(ctx, req.ScheduleID)
+	if err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			s.logger.Error("error getting schedule from storage", zap.String("scheduleID", req.ScheduleID), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return toResponse(schedule)
+}
+
+// Delete a schedule by ID. Tasks and taskLists already created
+// by the schedule are not affected.
+func (s *Service) Delete(ctx context.Context, req *goaschedule.ScheduleRequest) error {
+	if req.ScheduleID == "" {
+		return errors.New(errors.BadRequest, "missing scheduleID")
+	}
+
+	if err := s.storage.DeleteSchedule(ctx, req.ScheduleID); err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			s.logger.Error("error deleting schedule from storage", zap.String("scheduleID", req.ScheduleID), zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// List all schedules.
+func (s *Service) List(ctx context.Context) (*goaschedule.SchedulesResponse, error) {
+	schedules, err := s.storage.ListSchedules(ctx)
+	if err != nil {
+		s.logger.Error("error getting schedules from storage", zap.Error(err))
+		return nil, err
+	}
+
+	res := &goaschedule.SchedulesResponse{Schedules: []*goaschedule.Schedule{}}
+	for _, schedule := range schedules {
+		r, err := toResponse(schedule)
+		if err != nil {
+			s.logger.Error("error converting schedule", zap.String("scheduleID", schedule.ID), zap.Error(err))
+			return nil, err
+		}
+		res.Schedules = append(res.Schedules, r)
+	}
+
+	return res, nil
+}
+
+// checkTemplate verifies that the template from which
+// the schedule creates tasks or taskLists exists.
+func (s *Service) checkTemplate(ctx context.Context, schedule *service.Schedule) error {
+	var err error
+	if schedule.TaskName != "" {
+		_, err = s.storage.TaskTemplate(ctx, schedule.TaskName)
+	} else {
+		_, err = s.storage.TaskListTemplate(ctx, schedule.TaskListName)
+	}
+
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			return errors.New(errors.BadRequest, "invalid schedule", err)
+		}
+		s.logger.Error("error getting template from storage", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func toResponse(schedule *service.Schedule) (*goaschedule.Schedule, error) {
+	res := &goaschedule.Schedule{
+		ID:        schedule.ID,
+		Cron:      schedule.Cron,
+		Timezone:  schedule.Timezone,
+		NextRunAt: schedule.NextRunAt.Format(time.RFC3339),
+		CreatedAt: schedule.CreatedAt.Format(time.RFC3339),
+		Runs:      []*goaschedule.ScheduleRun{},
+	}
+	if schedule.TaskName != "" {
+		res.TaskName = &schedule.TaskName
+	}
+	if schedule.TaskListName != "" {
+		res.TaskListName = &schedule.TaskListName
+	}
+	if schedule.CacheNamespace != "" {
+		res.CacheNamespace = &schedule.CacheNamespace
+	}
+	if schedule.CacheScope != "" {
+		res.CacheScope = &schedule.CacheScope
+	}
+	if !schedule.LastRunAt.IsZero() {
+		res.LastRunAt = ptr.String(schedule.LastRunAt.Format(time.RFC3339))
+	}
+
+	if len(schedule.Request) > 0 {
+		if err := json.NewDecoder(bytes.NewReader(schedule.Request)).Decode(&res.Data); err != nil {
+			return nil, errors.New("error decoding schedule request data", err)
+		}
+	}
+
+	for _, run := range schedule.Runs {
+		res.Runs = append(res.Runs, &goaschedule.ScheduleRun{
+			ScheduledAt: run.ScheduledAt.Format(time.RFC3339),
+			ID:          run.ID,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,231 @@
+package schedule_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goaschedule "github.com/eclipse-xfsc/task-sheduler/gen/schedule"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/schedule"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/servicefakes"
+)
+
+func TestNew(t *testing.T) {
+	svc := schedule.New(nil, zap.NewNop())
+	assert.Implements(t, (*goaschedule.Service)(nil), svc)
+}
+
+func TestService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goaschedule.CreateScheduleRequest
+		storage *servicefakes.FakeStorage
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "neither task nor taskList name",
+			req:     &goaschedule.CreateScheduleRequest{Cron: "0 2 * * *"},
+			errkind: errors.BadRequest,
+			errtext: "exactly one of taskName and taskListName must be set",
+		},
+		{
+			name:    "both task and taskList name",
+			req:     &goaschedule.CreateScheduleRequest{Cron: "0 2 * * *", TaskName: ptr.String("task"), TaskListName: ptr.String("list")},
+			errkind: errors.BadRequest,
+			errtext: "exactly one of taskName and taskListName must be set",
+		},
+		{
+			name:    "invalid cron expression",
+			req:     &goaschedule.CreateScheduleRequest{Cron: "every day", TaskName: ptr.String("task")},
+			errkind: errors.BadRequest,
+			errtext: "invalid cron expression",
+		},
+		{
+			name:    "invalid timezone",
+			req:     &goaschedule.CreateScheduleRequest{Cron: "0 2 * * *", Timezone: ptr.String("Mars/Olympus"), TaskName: ptr.String("task")},
+			errkind: errors.BadRequest,
+			errtext: "invalid timezone",
+		},
+		{
+			name: "task template not found",
+			req:  &goaschedule.CreateScheduleRequest{Cron: "0 2 * * *", TaskName: ptr.String("task")},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound, "task template not found")
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task template not found",
+		},
+		{
+			name: "taskList template not found",
+			req:  &goaschedule.CreateScheduleRequest{Cron: "0 2 * * *", TaskListName: ptr.String("list")},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateStub: func(ctx context.Context, taskListName string) (*service.Template, error) {
+					return nil, errors.New(errors.NotFound, "taskList template not found")
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "taskList template not found",
+		},
+		{
+			name: "error adding schedule to storage",
+			req:  &goaschedule.CreateScheduleRequest{Cron: "0 2 * * *", TaskName: ptr.String("task")},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{Name: taskName}, nil
+				},
+				AddScheduleStub: func(ctx context.Context, schedule *service.Schedule) error {
+					return errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "successfully create schedule",
+			req: &goaschedule.CreateScheduleRequest{
+				Cron:         "0 2 * * *",
+				Timezone:     ptr.String("Europe/Berlin"),
+				TaskListName: ptr.String("list"),
+				Data:         map[string]interface{}{"key": "value"},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateStub: func(ctx context.Context, taskListName string) (*service.Template, error) {
+					return &service.Template{Name: taskListName}, nil
+				},
+				AddScheduleStub: func(ctx context.Context, schedule *service.Schedule) error {
+					return nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := schedule.New(test.storage, zap.NewNop())
+			res, err := svc.Create(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.NotEmpty(t, res.ID)
+				assert.Equal(t, test.req.Cron, res.Cron)
+				assert.Equal(t, test.req.Data, res.Data)
+				assert.Empty(t, res.Runs)
+
+				next, err := time.Parse(time.RFC3339, res.NextRunAt)
+				assert.NoError(t, err)
+				loc, _ := time.LoadLocation(res.Timezone)
+				assert.Equal(t, 2, next.In(loc).Hour())
+				assert.Equal(t, 0, next.In(loc).Minute())
+			}
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	scheduledAt := time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		req     *goaschedule.ScheduleRequest
+		storage *servicefakes.FakeStorage
+
+		res     *goaschedule.Schedule
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing scheduleID",
+			req:     &goaschedule.ScheduleRequest{},
+			errkind: errors.BadRequest,
+			errtext: "missing scheduleID",
+		},
+		{
+			name: "schedule not found",
+			req:  &goaschedule.ScheduleRequest{ScheduleID: "123"},
+			storage: &servicefakes.FakeStorage{
+				ScheduleStub: func(ctx context.Context, scheduleID string) (*service.Schedule, error) {
+					return nil, errors.New(errors.NotFound, "schedule not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "schedule not found",
+		},
+		{
+			name: "successfully get schedule",
+			req:  &goaschedule.ScheduleRequest{ScheduleID: "123"},
+			storage: &servicefakes.FakeStorage{
+				ScheduleStub: func(ctx context.Context, scheduleID string) (*service.Schedule, error) {
+					return &service.Schedule{
+						ID:        scheduleID,
+						Cron:      "0 2 * * *",
+						Timezone:  "UTC",
+						TaskName:  "task",
+						Request:   []byte(`{"key":"value"}`),
+						NextRunAt: scheduledAt.Add(24 * time.Hour),
+						LastRunAt: scheduledAt,
+						Runs:      []service.ScheduleRun{{ScheduledAt: scheduledAt, ID: "456"}},
+						CreatedAt: scheduledAt.Add(-time.Hour),
+					}, nil
+				},
+			},
+			res: &goaschedule.Schedule{
+				ID:        "123",
+				Cron:      "0 2 * * *",
+				Timezone:  "UTC",
+				TaskName:  ptr.String("task"),
+				Data:      map[string]interface{}{"key": "value"},
+				NextRunAt: "2023-01-02T02:00:00Z",
+				LastRunAt: ptr.String("2023-01-01T02:00:00Z"),
+				CreatedAt: "2023-01-01T01:00:00Z",
+				Runs:      []*goaschedule.ScheduleRun{{ScheduledAt: "2023-01-01T02:00:00Z", ID: "456"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := schedule.New(test.storage, zap.NewNop())
+			res, err := svc.Get(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	storage := &servicefakes.FakeStorage{
+		DeleteScheduleStub: func(ctx context.Context, scheduleID string) error {
+			return errors.New(errors.NotFound, "schedule not found")
+		},
+	}
+
+	svc := schedule.New(storage, zap.NewNop())
+	err := svc.Delete(context.Background(), &goaschedule.ScheduleRequest{ScheduleID: "123"})
+	assert.Error(t, err)
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
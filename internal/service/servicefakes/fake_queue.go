@@ -4,6 +4,7 @@ package servicefakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/eclipse-xfsc/task-sheduler/internal/service"
 )
@@ -70,10 +71,66 @@ type FakeQueue struct {
 	addTaskListReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PollStub        func(context.Context) (*service.Task, error)
+	ArchiveCancelledStub        func(context.Context, time.Time) (int, error)
+	archiveCancelledMutex       sync.RWMutex
+	archiveCancelledArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	archiveCancelledReturns struct {
+		result1 int
+		result2 error
+	}
+	archiveCancelledReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	CancelStub        func(context.Context, string) (*service.Task, error)
+	cancelMutex       sync.RWMutex
+	cancelArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	cancelReturns struct {
+		result1 *service.Task
+		result2 error
+	}
+	cancelReturnsOnCall map[int]struct {
+		result1 *service.Task
+		result2 error
+	}
+	HeartbeatStub        func(context.Context, *service.Task, time.Duration) error
+	heartbeatMutex       sync.RWMutex
+	heartbeatArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.Task
+		arg3 time.Duration
+	}
+	heartbeatReturns struct {
+		result1 error
+	}
+	heartbeatReturnsOnCall map[int]struct {
+		result1 error
+	}
+	HeartbeatListStub        func(context.Context, *service.TaskList, time.Duration) error
+	heartbeatListMutex       sync.RWMutex
+	heartbeatListArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.TaskList
+		arg3 time.Duration
+	}
+	heartbeatListReturns struct {
+		result1 error
+	}
+	heartbeatListReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PollStub        func(context.Context, string, time.Duration) (*service.Task, error)
 	pollMutex       sync.RWMutex
 	pollArgsForCall []struct {
 		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
 	}
 	pollReturns struct {
 		result1 *service.Task
@@ -83,10 +140,28 @@ type FakeQueue struct {
 		result1 *service.Task
 		result2 error
 	}
-	PollListStub        func(context.Context) (*service.TaskList, error)
+	PollBatchStub        func(context.Context, string, time.Duration, int) ([]*service.Task, error)
+	pollBatchMutex       sync.RWMutex
+	pollBatchArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
+		arg4 int
+	}
+	pollBatchReturns struct {
+		result1 []*service.Task
+		result2 error
+	}
+	pollBatchReturnsOnCall map[int]struct {
+		result1 []*service.Task
+		result2 error
+	}
+	PollListStub        func(context.Context, string, time.Duration) (*service.TaskList, error)
 	pollListMutex       sync.RWMutex
 	pollListArgsForCall []struct {
 		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
 	}
 	pollListReturns struct {
 		result1 *service.TaskList
@@ -96,6 +171,34 @@ type FakeQueue struct {
 		result1 *service.TaskList
 		result2 error
 	}
+	RequeueExpiredStub        func(context.Context, time.Time) (int, error)
+	requeueExpiredMutex       sync.RWMutex
+	requeueExpiredArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	requeueExpiredReturns struct {
+		result1 int
+		result2 error
+	}
+	requeueExpiredReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	RequeueExpiredListsStub        func(context.Context, time.Time) (int, error)
+	requeueExpiredListsMutex       sync.RWMutex
+	requeueExpiredListsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	requeueExpiredListsReturns struct {
+		result1 int
+		result2 error
+	}
+	requeueExpiredListsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	UnackStub        func(context.Context, *service.Task) error
 	unackMutex       sync.RWMutex
 	unackArgsForCall []struct {
@@ -428,18 +531,276 @@ func (fake *FakeQueue) AddTaskListReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeQueue) Poll(arg1 context.Context) (*service.Task, error) {
+func (fake *FakeQueue) ArchiveCancelled(arg1 context.Context, arg2 time.Time) (int, error) {
+	fake.archiveCancelledMutex.Lock()
+	ret, specificReturn := fake.archiveCancelledReturnsOnCall[len(fake.archiveCancelledArgsForCall)]
+	fake.archiveCancelledArgsForCall = append(fake.archiveCancelledArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.ArchiveCancelledStub
+	fakeReturns := fake.archiveCancelledReturns
+	fake.recordInvocation("ArchiveCancelled", []interface{}{arg1, arg2})
+	fake.archiveCancelledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeQueue) ArchiveCancelledCallCount() int {
+	fake.archiveCancelledMutex.RLock()
+	defer fake.archiveCancelledMutex.RUnlock()
+	return len(fake.archiveCancelledArgsForCall)
+}
+
+func (fake *FakeQueue) ArchiveCancelledCalls(stub func(context.Context, time.Time) (int, error)) {
+	fake.archiveCancelledMutex.Lock()
+	defer fake.archiveCancelledMutex.Unlock()
+	fake.ArchiveCancelledStub = stub
+}
+
+func (fake *FakeQueue) ArchiveCancelledArgsForCall(i int) (context.Context, time.Time) {
+	fake.archiveCancelledMutex.RLock()
+	defer fake.archiveCancelledMutex.RUnlock()
+	argsForCall := fake.archiveCancelledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeQueue) ArchiveCancelledReturns(result1 int, result2 error) {
+	fake.archiveCancelledMutex.Lock()
+	defer fake.archiveCancelledMutex.Unlock()
+	fake.ArchiveCancelledStub = nil
+	fake.archiveCancelledReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) ArchiveCancelledReturnsOnCall(i int, result1 int, result2 error) {
+	fake.archiveCancelledMutex.Lock()
+	defer fake.archiveCancelledMutex.Unlock()
+	fake.ArchiveCancelledStub = nil
+	if fake.archiveCancelledReturnsOnCall == nil {
+		fake.archiveCancelledReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.archiveCancelledReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) Cancel(arg1 context.Context, arg2 string) (*service.Task, error) {
+	fake.cancelMutex.Lock()
+	ret, specificReturn := fake.cancelReturnsOnCall[len(fake.cancelArgsForCall)]
+	fake.cancelArgsForCall = append(fake.cancelArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CancelStub
+	fakeReturns := fake.cancelReturns
+	fake.recordInvocation("Cancel", []interface{}{arg1, arg2})
+	fake.cancelMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeQueue) CancelCallCount() int {
+	fake.cancelMutex.RLock()
+	defer fake.cancelMutex.RUnlock()
+	return len(fake.cancelArgsForCall)
+}
+
+func (fake *FakeQueue) CancelCalls(stub func(context.Context, string) (*service.Task, error)) {
+	fake.cancelMutex.Lock()
+	defer fake.cancelMutex.Unlock()
+	fake.CancelStub = stub
+}
+
+func (fake *FakeQueue) CancelArgsForCall(i int) (context.Context, string) {
+	fake.cancelMutex.RLock()
+	defer fake.cancelMutex.RUnlock()
+	argsForCall := fake.cancelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeQueue) CancelReturns(result1 *service.Task, result2 error) {
+	fake.cancelMutex.Lock()
+	defer fake.cancelMutex.Unlock()
+	fake.CancelStub = nil
+	fake.cancelReturns = struct {
+		result1 *service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) CancelReturnsOnCall(i int, result1 *service.Task, result2 error) {
+	fake.cancelMutex.Lock()
+	defer fake.cancelMutex.Unlock()
+	fake.CancelStub = nil
+	if fake.cancelReturnsOnCall == nil {
+		fake.cancelReturnsOnCall = make(map[int]struct {
+			result1 *service.Task
+			result2 error
+		})
+	}
+	fake.cancelReturnsOnCall[i] = struct {
+		result1 *service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) Heartbeat(arg1 context.Context, arg2 *service.Task, arg3 time.Duration) error {
+	fake.heartbeatMutex.Lock()
+	ret, specificReturn := fake.heartbeatReturnsOnCall[len(fake.heartbeatArgsForCall)]
+	fake.heartbeatArgsForCall = append(fake.heartbeatArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.Task
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.HeartbeatStub
+	fakeReturns := fake.heartbeatReturns
+	fake.recordInvocation("Heartbeat", []interface{}{arg1, arg2, arg3})
+	fake.heartbeatMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeQueue) HeartbeatCallCount() int {
+	fake.heartbeatMutex.RLock()
+	defer fake.heartbeatMutex.RUnlock()
+	return len(fake.heartbeatArgsForCall)
+}
+
+func (fake *FakeQueue) HeartbeatCalls(stub func(context.Context, *service.Task, time.Duration) error) {
+	fake.heartbeatMutex.Lock()
+	defer fake.heartbeatMutex.Unlock()
+	fake.HeartbeatStub = stub
+}
+
+func (fake *FakeQueue) HeartbeatArgsForCall(i int) (context.Context, *service.Task, time.Duration) {
+	fake.heartbeatMutex.RLock()
+	defer fake.heartbeatMutex.RUnlock()
+	argsForCall := fake.heartbeatArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeQueue) HeartbeatReturns(result1 error) {
+	fake.heartbeatMutex.Lock()
+	defer fake.heartbeatMutex.Unlock()
+	fake.HeartbeatStub = nil
+	fake.heartbeatReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQueue) HeartbeatReturnsOnCall(i int, result1 error) {
+	fake.heartbeatMutex.Lock()
+	defer fake.heartbeatMutex.Unlock()
+	fake.HeartbeatStub = nil
+	if fake.heartbeatReturnsOnCall == nil {
+		fake.heartbeatReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.heartbeatReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQueue) HeartbeatList(arg1 context.Context, arg2 *service.TaskList, arg3 time.Duration) error {
+	fake.heartbeatListMutex.Lock()
+	ret, specificReturn := fake.heartbeatListReturnsOnCall[len(fake.heartbeatListArgsForCall)]
+	fake.heartbeatListArgsForCall = append(fake.heartbeatListArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.TaskList
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.HeartbeatListStub
+	fakeReturns := fake.heartbeatListReturns
+	fake.recordInvocation("HeartbeatList", []interface{}{arg1, arg2, arg3})
+	fake.heartbeatListMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeQueue) HeartbeatListCallCount() int {
+	fake.heartbeatListMutex.RLock()
+	defer fake.heartbeatListMutex.RUnlock()
+	return len(fake.heartbeatListArgsForCall)
+}
+
+func (fake *FakeQueue) HeartbeatListCalls(stub func(context.Context, *service.TaskList, time.Duration) error) {
+	fake.heartbeatListMutex.Lock()
+	defer fake.heartbeatListMutex.Unlock()
+	fake.HeartbeatListStub = stub
+}
+
+func (fake *FakeQueue) HeartbeatListArgsForCall(i int) (context.Context, *service.TaskList, time.Duration) {
+	fake.heartbeatListMutex.RLock()
+	defer fake.heartbeatListMutex.RUnlock()
+	argsForCall := fake.heartbeatListArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeQueue) HeartbeatListReturns(result1 error) {
+	fake.heartbeatListMutex.Lock()
+	defer fake.heartbeatListMutex.Unlock()
+	fake.HeartbeatListStub = nil
+	fake.heartbeatListReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQueue) HeartbeatListReturnsOnCall(i int, result1 error) {
+	fake.heartbeatListMutex.Lock()
+	defer fake.heartbeatListMutex.Unlock()
+	fake.HeartbeatListStub = nil
+	if fake.heartbeatListReturnsOnCall == nil {
+		fake.heartbeatListReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.heartbeatListReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQueue) Poll(arg1 context.Context, arg2 string, arg3 time.Duration) (*service.Task, error) {
 	fake.pollMutex.Lock()
 	ret, specificReturn := fake.pollReturnsOnCall[len(fake.pollArgsForCall)]
 	fake.pollArgsForCall = append(fake.pollArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
+		arg2 string
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
 	stub := fake.PollStub
 	fakeReturns := fake.pollReturns
-	fake.recordInvocation("Poll", []interface{}{arg1})
+	fake.recordInvocation("Poll", []interface{}{arg1, arg2, arg3})
 	fake.pollMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -453,17 +814,17 @@ func (fake *FakeQueue) PollCallCount() int {
 	return len(fake.pollArgsForCall)
 }
 
-func (fake *FakeQueue) PollCalls(stub func(context.Context) (*service.Task, error)) {
+func (fake *FakeQueue) PollCalls(stub func(context.Context, string, time.Duration) (*service.Task, error)) {
 	fake.pollMutex.Lock()
 	defer fake.pollMutex.Unlock()
 	fake.PollStub = stub
 }
 
-func (fake *FakeQueue) PollArgsForCall(i int) context.Context {
+func (fake *FakeQueue) PollArgsForCall(i int) (context.Context, string, time.Duration) {
 	fake.pollMutex.RLock()
 	defer fake.pollMutex.RUnlock()
 	argsForCall := fake.pollArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeQueue) PollReturns(result1 *service.Task, result2 error) {
@@ -492,18 +853,87 @@ func (fake *FakeQueue) PollReturnsOnCall(i int, result1 *service.Task, result2 e
 	}{result1, result2}
 }
 
-func (fake *FakeQueue) PollList(arg1 context.Context) (*service.TaskList, error) {
+func (fake *FakeQueue) PollBatch(arg1 context.Context, arg2 string, arg3 time.Duration, arg4 int) ([]*service.Task, error) {
+	fake.pollBatchMutex.Lock()
+	ret, specificReturn := fake.pollBatchReturnsOnCall[len(fake.pollBatchArgsForCall)]
+	fake.pollBatchArgsForCall = append(fake.pollBatchArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PollBatchStub
+	fakeReturns := fake.pollBatchReturns
+	fake.recordInvocation("PollBatch", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pollBatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeQueue) PollBatchCallCount() int {
+	fake.pollBatchMutex.RLock()
+	defer fake.pollBatchMutex.RUnlock()
+	return len(fake.pollBatchArgsForCall)
+}
+
+func (fake *FakeQueue) PollBatchCalls(stub func(context.Context, string, time.Duration, int) ([]*service.Task, error)) {
+	fake.pollBatchMutex.Lock()
+	defer fake.pollBatchMutex.Unlock()
+	fake.PollBatchStub = stub
+}
+
+func (fake *FakeQueue) PollBatchArgsForCall(i int) (context.Context, string, time.Duration, int) {
+	fake.pollBatchMutex.RLock()
+	defer fake.pollBatchMutex.RUnlock()
+	argsForCall := fake.pollBatchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeQueue) PollBatchReturns(result1 []*service.Task, result2 error) {
+	fake.pollBatchMutex.Lock()
+	defer fake.pollBatchMutex.Unlock()
+	fake.PollBatchStub = nil
+	fake.pollBatchReturns = struct {
+		result1 []*service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) PollBatchReturnsOnCall(i int, result1 []*service.Task, result2 error) {
+	fake.pollBatchMutex.Lock()
+	defer fake.pollBatchMutex.Unlock()
+	fake.PollBatchStub = nil
+	if fake.pollBatchReturnsOnCall == nil {
+		fake.pollBatchReturnsOnCall = make(map[int]struct {
+			result1 []*service.Task
+			result2 error
+		})
+	}
+	fake.pollBatchReturnsOnCall[i] = struct {
+		result1 []*service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) PollList(arg1 context.Context, arg2 string, arg3 time.Duration) (*service.TaskList, error) {
 	fake.pollListMutex.Lock()
 	ret, specificReturn := fake.pollListReturnsOnCall[len(fake.pollListArgsForCall)]
 	fake.pollListArgsForCall = append(fake.pollListArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
+		arg2 string
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
 	stub := fake.PollListStub
 	fakeReturns := fake.pollListReturns
-	fake.recordInvocation("PollList", []interface{}{arg1})
+	fake.recordInvocation("PollList", []interface{}{arg1, arg2, arg3})
 	fake.pollListMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -517,17 +947,17 @@ func (fake *FakeQueue) PollListCallCount() int {
 	return len(fake.pollListArgsForCall)
 }
 
-func (fake *FakeQueue) PollListCalls(stub func(context.Context) (*service.TaskList, error)) {
+func (fake *FakeQueue) PollListCalls(stub func(context.Context, string, time.Duration) (*service.TaskList, error)) {
 	fake.pollListMutex.Lock()
 	defer fake.pollListMutex.Unlock()
 	fake.PollListStub = stub
 }
 
-func (fake *FakeQueue) PollListArgsForCall(i int) context.Context {
+func (fake *FakeQueue) PollListArgsForCall(i int) (context.Context, string, time.Duration) {
 	fake.pollListMutex.RLock()
 	defer fake.pollListMutex.RUnlock()
 	argsForCall := fake.pollListArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeQueue) PollListReturns(result1 *service.TaskList, result2 error) {
@@ -556,6 +986,136 @@ func (fake *FakeQueue) PollListReturnsOnCall(i int, result1 *service.TaskList, r
 	}{result1, result2}
 }
 
+func (fake *FakeQueue) RequeueExpired(arg1 context.Context, arg2 time.Time) (int, error) {
+	fake.requeueExpiredMutex.Lock()
+	ret, specificReturn := fake.requeueExpiredReturnsOnCall[len(fake.requeueExpiredArgsForCall)]
+	fake.requeueExpiredArgsForCall = append(fake.requeueExpiredArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.RequeueExpiredStub
+	fakeReturns := fake.requeueExpiredReturns
+	fake.recordInvocation("RequeueExpired", []interface{}{arg1, arg2})
+	fake.requeueExpiredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeQueue) RequeueExpiredCallCount() int {
+	fake.requeueExpiredMutex.RLock()
+	defer fake.requeueExpiredMutex.RUnlock()
+	return len(fake.requeueExpiredArgsForCall)
+}
+
+func (fake *FakeQueue) RequeueExpiredCalls(stub func(context.Context, time.Time) (int, error)) {
+	fake.requeueExpiredMutex.Lock()
+	defer fake.requeueExpiredMutex.Unlock()
+	fake.RequeueExpiredStub = stub
+}
+
+func (fake *FakeQueue) RequeueExpiredArgsForCall(i int) (context.Context, time.Time) {
+	fake.requeueExpiredMutex.RLock()
+	defer fake.requeueExpiredMutex.RUnlock()
+	argsForCall := fake.requeueExpiredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeQueue) RequeueExpiredReturns(result1 int, result2 error) {
+	fake.requeueExpiredMutex.Lock()
+	defer fake.requeueExpiredMutex.Unlock()
+	fake.RequeueExpiredStub = nil
+	fake.requeueExpiredReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) RequeueExpiredReturnsOnCall(i int, result1 int, result2 error) {
+	fake.requeueExpiredMutex.Lock()
+	defer fake.requeueExpiredMutex.Unlock()
+	fake.RequeueExpiredStub = nil
+	if fake.requeueExpiredReturnsOnCall == nil {
+		fake.requeueExpiredReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.requeueExpiredReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) RequeueExpiredLists(arg1 context.Context, arg2 time.Time) (int, error) {
+	fake.requeueExpiredListsMutex.Lock()
+	ret, specificReturn := fake.requeueExpiredListsReturnsOnCall[len(fake.requeueExpiredListsArgsForCall)]
+	fake.requeueExpiredListsArgsForCall = append(fake.requeueExpiredListsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.RequeueExpiredListsStub
+	fakeReturns := fake.requeueExpiredListsReturns
+	fake.recordInvocation("RequeueExpiredLists", []interface{}{arg1, arg2})
+	fake.requeueExpiredListsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeQueue) RequeueExpiredListsCallCount() int {
+	fake.requeueExpiredListsMutex.RLock()
+	defer fake.requeueExpiredListsMutex.RUnlock()
+	return len(fake.requeueExpiredListsArgsForCall)
+}
+
+func (fake *FakeQueue) RequeueExpiredListsCalls(stub func(context.Context, time.Time) (int, error)) {
+	fake.requeueExpiredListsMutex.Lock()
+	defer fake.requeueExpiredListsMutex.Unlock()
+	fake.RequeueExpiredListsStub = stub
+}
+
+func (fake *FakeQueue) RequeueExpiredListsArgsForCall(i int) (context.Context, time.Time) {
+	fake.requeueExpiredListsMutex.RLock()
+	defer fake.requeueExpiredListsMutex.RUnlock()
+	argsForCall := fake.requeueExpiredListsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeQueue) RequeueExpiredListsReturns(result1 int, result2 error) {
+	fake.requeueExpiredListsMutex.Lock()
+	defer fake.requeueExpiredListsMutex.Unlock()
+	fake.RequeueExpiredListsStub = nil
+	fake.requeueExpiredListsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) RequeueExpiredListsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.requeueExpiredListsMutex.Lock()
+	defer fake.requeueExpiredListsMutex.Unlock()
+	fake.RequeueExpiredListsStub = nil
+	if fake.requeueExpiredListsReturnsOnCall == nil {
+		fake.requeueExpiredListsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.requeueExpiredListsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeQueue) Unack(arg1 context.Context, arg2 *service.Task) error {
 	fake.unackMutex.Lock()
 	ret, specificReturn := fake.unackReturnsOnCall[len(fake.unackArgsForCall)]
@@ -631,10 +1191,24 @@ func (fake *FakeQueue) Invocations() map[string][][]interface{} {
 	defer fake.addMutex.RUnlock()
 	fake.addTaskListMutex.RLock()
 	defer fake.addTaskListMutex.RUnlock()
+	fake.archiveCancelledMutex.RLock()
+	defer fake.archiveCancelledMutex.RUnlock()
+	fake.cancelMutex.RLock()
+	defer fake.cancelMutex.RUnlock()
+	fake.heartbeatMutex.RLock()
+	defer fake.heartbeatMutex.RUnlock()
+	fake.heartbeatListMutex.RLock()
+	defer fake.heartbeatListMutex.RUnlock()
 	fake.pollMutex.RLock()
 	defer fake.pollMutex.RUnlock()
+	fake.pollBatchMutex.RLock()
+	defer fake.pollBatchMutex.RUnlock()
 	fake.pollListMutex.RLock()
 	defer fake.pollListMutex.RUnlock()
+	fake.requeueExpiredMutex.RLock()
+	defer fake.requeueExpiredMutex.RUnlock()
+	fake.requeueExpiredListsMutex.RLock()
+	defer fake.requeueExpiredListsMutex.RUnlock()
 	fake.unackMutex.RLock()
 	defer fake.unackMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
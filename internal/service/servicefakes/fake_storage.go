@@ -4,11 +4,269 @@ package servicefakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/eclipse-xfsc/task-sheduler/internal/service"
 )
 
 type FakeStorage struct {
+	AddDeadLetterStub        func(context.Context, *service.DeadLetter) error
+	addDeadLetterMutex       sync.RWMutex
+	addDeadLetterArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.DeadLetter
+	}
+	addDeadLetterReturns struct {
+		result1 error
+	}
+	addDeadLetterReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddEventTaskStub        func(context.Context, *service.EventTask) error
+	addEventTaskMutex       sync.RWMutex
+	addEventTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.EventTask
+	}
+	addEventTaskReturns struct {
+		result1 error
+	}
+	addEventTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddIdempotencyKeyStub        func(context.Context, *service.IdempotencyKey) error
+	addIdempotencyKeyMutex       sync.RWMutex
+	addIdempotencyKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.IdempotencyKey
+	}
+	addIdempotencyKeyReturns struct {
+		result1 error
+	}
+	addIdempotencyKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddScheduleStub        func(context.Context, *service.Schedule) error
+	addScheduleMutex       sync.RWMutex
+	addScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.Schedule
+	}
+	addScheduleReturns struct {
+		result1 error
+	}
+	addScheduleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddTaskListTemplateStub        func(context.Context, *service.Template) error
+	addTaskListTemplateMutex       sync.RWMutex
+	addTaskListTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.Template
+	}
+	addTaskListTemplateReturns struct {
+		result1 error
+	}
+	addTaskListTemplateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddTaskTemplateStub        func(context.Context, *service.Task) error
+	addTaskTemplateMutex       sync.RWMutex
+	addTaskTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.Task
+	}
+	addTaskTemplateReturns struct {
+		result1 error
+	}
+	addTaskTemplateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddWebhookDeliveryStub        func(context.Context, *service.WebhookDelivery) error
+	addWebhookDeliveryMutex       sync.RWMutex
+	addWebhookDeliveryArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.WebhookDelivery
+	}
+	addWebhookDeliveryReturns struct {
+		result1 error
+	}
+	addWebhookDeliveryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ClaimPendingRunStub        func(context.Context, string, *service.ScheduleRun, time.Time) error
+	claimPendingRunMutex       sync.RWMutex
+	claimPendingRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *service.ScheduleRun
+		arg4 time.Time
+	}
+	claimPendingRunReturns struct {
+		result1 error
+	}
+	claimPendingRunReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ClaimScheduleStub        func(context.Context, string, time.Time, *service.ScheduleRun) error
+	claimScheduleMutex       sync.RWMutex
+	claimScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+		arg4 *service.ScheduleRun
+	}
+	claimScheduleReturns struct {
+		result1 error
+	}
+	claimScheduleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CompleteScheduleRunStub        func(context.Context, string, *service.ScheduleRun) error
+	completeScheduleRunMutex       sync.RWMutex
+	completeScheduleRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *service.ScheduleRun
+	}
+	completeScheduleRunReturns struct {
+		result1 error
+	}
+	completeScheduleRunReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeadLetterStub        func(context.Context, string) (*service.DeadLetter, error)
+	deadLetterMutex       sync.RWMutex
+	deadLetterArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deadLetterReturns struct {
+		result1 *service.DeadLetter
+		result2 error
+	}
+	deadLetterReturnsOnCall map[int]struct {
+		result1 *service.DeadLetter
+		result2 error
+	}
+	DeadLettersStub        func(context.Context, string, int) ([]*service.DeadLetter, error)
+	deadLettersMutex       sync.RWMutex
+	deadLettersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	deadLettersReturns struct {
+		result1 []*service.DeadLetter
+		result2 error
+	}
+	deadLettersReturnsOnCall map[int]struct {
+		result1 []*service.DeadLetter
+		result2 error
+	}
+	DeleteDeadLetterStub        func(context.Context, string) (*service.DeadLetter, error)
+	deleteDeadLetterMutex       sync.RWMutex
+	deleteDeadLetterArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteDeadLetterReturns struct {
+		result1 *service.DeadLetter
+		result2 error
+	}
+	deleteDeadLetterReturnsOnCall map[int]struct {
+		result1 *service.DeadLetter
+		result2 error
+	}
+	DeleteEventTaskStub        func(context.Context, string, string, string) error
+	deleteEventTaskMutex       sync.RWMutex
+	deleteEventTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	deleteEventTaskReturns struct {
+		result1 error
+	}
+	deleteEventTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteIdempotencyKeyStub        func(context.Context, string) error
+	deleteIdempotencyKeyMutex       sync.RWMutex
+	deleteIdempotencyKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteIdempotencyKeyReturns struct {
+		result1 error
+	}
+	deleteIdempotencyKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteScheduleStub        func(context.Context, string) error
+	deleteScheduleMutex       sync.RWMutex
+	deleteScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteScheduleReturns struct {
+		result1 error
+	}
+	deleteScheduleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteTaskListTemplateStub        func(context.Context, string) error
+	deleteTaskListTemplateMutex       sync.RWMutex
+	deleteTaskListTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteTaskListTemplateReturns struct {
+		result1 error
+	}
+	deleteTaskListTemplateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteTaskTemplateStub        func(context.Context, string) error
+	deleteTaskTemplateMutex       sync.RWMutex
+	deleteTaskTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteTaskTemplateReturns struct {
+		result1 error
+	}
+	deleteTaskTemplateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DiscardScheduleRunStub        func(context.Context, string, string) error
+	discardScheduleRunMutex       sync.RWMutex
+	discardScheduleRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	discardScheduleRunReturns struct {
+		result1 error
+	}
+	discardScheduleRunReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DueSchedulesStub        func(context.Context, time.Time) ([]*service.Schedule, error)
+	dueSchedulesMutex       sync.RWMutex
+	dueSchedulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	dueSchedulesReturns struct {
+		result1 []*service.Schedule
+		result2 error
+	}
+	dueSchedulesReturnsOnCall map[int]struct {
+		result1 []*service.Schedule
+		result2 error
+	}
 	EventTaskStub        func(context.Context, string, string, string) (*service.EventTask, error)
 	eventTaskMutex       sync.RWMutex
 	eventTaskArgsForCall []struct {
@@ -39,6 +297,101 @@ type FakeStorage struct {
 		result1 []*service.Task
 		result2 error
 	}
+	IdempotencyKeyStub        func(context.Context, string) (*service.IdempotencyKey, error)
+	idempotencyKeyMutex       sync.RWMutex
+	idempotencyKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	idempotencyKeyReturns struct {
+		result1 *service.IdempotencyKey
+		result2 error
+	}
+	idempotencyKeyReturnsOnCall map[int]struct {
+		result1 *service.IdempotencyKey
+		result2 error
+	}
+	ListEventTasksStub        func(context.Context) ([]*service.EventTask, error)
+	listEventTasksMutex       sync.RWMutex
+	listEventTasksArgsForCall []struct {
+		arg1 context.Context
+	}
+	listEventTasksReturns struct {
+		result1 []*service.EventTask
+		result2 error
+	}
+	listEventTasksReturnsOnCall map[int]struct {
+		result1 []*service.EventTask
+		result2 error
+	}
+	ListSchedulesStub        func(context.Context) ([]*service.Schedule, error)
+	listSchedulesMutex       sync.RWMutex
+	listSchedulesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listSchedulesReturns struct {
+		result1 []*service.Schedule
+		result2 error
+	}
+	listSchedulesReturnsOnCall map[int]struct {
+		result1 []*service.Schedule
+		result2 error
+	}
+	ListTaskListTemplatesStub        func(context.Context) ([]*service.Template, error)
+	listTaskListTemplatesMutex       sync.RWMutex
+	listTaskListTemplatesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listTaskListTemplatesReturns struct {
+		result1 []*service.Template
+		result2 error
+	}
+	listTaskListTemplatesReturnsOnCall map[int]struct {
+		result1 []*service.Template
+		result2 error
+	}
+	ListTaskTemplatesStub        func(context.Context) ([]*service.Task, error)
+	listTaskTemplatesMutex       sync.RWMutex
+	listTaskTemplatesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listTaskTemplatesReturns struct {
+		result1 []*service.Task
+		result2 error
+	}
+	listTaskTemplatesReturnsOnCall map[int]struct {
+		result1 []*service.Task
+		result2 error
+	}
+	PollWebhookDeliveryStub        func(context.Context, time.Duration) (*service.WebhookDelivery, error)
+	pollWebhookDeliveryMutex       sync.RWMutex
+	pollWebhookDeliveryArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Duration
+	}
+	pollWebhookDeliveryReturns struct {
+		result1 *service.WebhookDelivery
+		result2 error
+	}
+	pollWebhookDeliveryReturnsOnCall map[int]struct {
+		result1 *service.WebhookDelivery
+		result2 error
+	}
+	PurgeDeadLettersStub        func(context.Context, string, time.Time) (int, error)
+	purgeDeadLettersMutex       sync.RWMutex
+	purgeDeadLettersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}
+	purgeDeadLettersReturns struct {
+		result1 int
+		result2 error
+	}
+	purgeDeadLettersReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	SaveTaskHistoryStub        func(context.Context, *service.Task) error
 	saveTaskHistoryMutex       sync.RWMutex
 	saveTaskHistoryArgsForCall []struct {
@@ -63,6 +416,20 @@ type FakeStorage struct {
 	saveTaskListHistoryReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ScheduleStub        func(context.Context, string) (*service.Schedule, error)
+	scheduleMutex       sync.RWMutex
+	scheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	scheduleReturns struct {
+		result1 *service.Schedule
+		result2 error
+	}
+	scheduleReturnsOnCall map[int]struct {
+		result1 *service.Schedule
+		result2 error
+	}
 	TaskStub        func(context.Context, string) (*service.Task, error)
 	taskMutex       sync.RWMutex
 	taskArgsForCall []struct {
@@ -133,6 +500,49 @@ type FakeStorage struct {
 		result1 *service.Template
 		result2 error
 	}
+	TaskListTemplateVersionStub        func(context.Context, string, int) (*service.Template, error)
+	taskListTemplateVersionMutex       sync.RWMutex
+	taskListTemplateVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	taskListTemplateVersionReturns struct {
+		result1 *service.Template
+		result2 error
+	}
+	taskListTemplateVersionReturnsOnCall map[int]struct {
+		result1 *service.Template
+		result2 error
+	}
+	TaskListTemplatesByTaskStub        func(context.Context, string) ([]*service.Template, error)
+	taskListTemplatesByTaskMutex       sync.RWMutex
+	taskListTemplatesByTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	taskListTemplatesByTaskReturns struct {
+		result1 []*service.Template
+		result2 error
+	}
+	taskListTemplatesByTaskReturnsOnCall map[int]struct {
+		result1 []*service.Template
+		result2 error
+	}
+	TaskListsStub        func(context.Context, *service.Filter) ([]*service.TaskList, error)
+	taskListsMutex       sync.RWMutex
+	taskListsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.Filter
+	}
+	taskListsReturns struct {
+		result1 []*service.TaskList
+		result2 error
+	}
+	taskListsReturnsOnCall map[int]struct {
+		result1 []*service.TaskList
+		result2 error
+	}
 	TaskTemplateStub        func(context.Context, string) (*service.Task, error)
 	taskTemplateMutex       sync.RWMutex
 	taskTemplateArgsForCall []struct {
@@ -147,6 +557,21 @@ type FakeStorage struct {
 		result1 *service.Task
 		result2 error
 	}
+	TaskTemplateVersionStub        func(context.Context, string, int) (*service.Task, error)
+	taskTemplateVersionMutex       sync.RWMutex
+	taskTemplateVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	taskTemplateVersionReturns struct {
+		result1 *service.Task
+		result2 error
+	}
+	taskTemplateVersionReturnsOnCall map[int]struct {
+		result1 *service.Task
+		result2 error
+	}
 	TaskTemplatesStub        func(context.Context, []string) (map[string]*service.Task, error)
 	taskTemplatesMutex       sync.RWMutex
 	taskTemplatesArgsForCall []struct {
@@ -161,12 +586,1337 @@ type FakeStorage struct {
 		result1 map[string]*service.Task
 		result2 error
 	}
+	TasksStub        func(context.Context, *service.Filter) ([]*service.Task, error)
+	tasksMutex       sync.RWMutex
+	tasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.Filter
+	}
+	tasksReturns struct {
+		result1 []*service.Task
+		result2 error
+	}
+	tasksReturnsOnCall map[int]struct {
+		result1 []*service.Task
+		result2 error
+	}
+	UpdateTaskListTemplateStub        func(context.Context, *service.Template) error
+	updateTaskListTemplateMutex       sync.RWMutex
+	updateTaskListTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.Template
+	}
+	updateTaskListTemplateReturns struct {
+		result1 error
+	}
+	updateTaskListTemplateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateTaskTemplateStub        func(context.Context, *service.Task) error
+	updateTaskTemplateMutex       sync.RWMutex
+	updateTaskTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.Task
+	}
+	updateTaskTemplateReturns struct {
+		result1 error
+	}
+	updateTaskTemplateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateWebhookDeliveryStub        func(context.Context, *service.WebhookDelivery) error
+	updateWebhookDeliveryMutex       sync.RWMutex
+	updateWebhookDeliveryArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.WebhookDelivery
+	}
+	updateWebhookDeliveryReturns struct {
+		result1 error
+	}
+	updateWebhookDeliveryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WebhookDeliveriesStub        func(context.Context, string) ([]*service.WebhookDelivery, error)
+	webhookDeliveriesMutex       sync.RWMutex
+	webhookDeliveriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	webhookDeliveriesReturns struct {
+		result1 []*service.WebhookDelivery
+		result2 error
+	}
+	webhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []*service.WebhookDelivery
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeStorage) EventTask(arg1 context.Context, arg2 string, arg3 string, arg4 string) (*service.EventTask, error) {
-	fake.eventTaskMutex.Lock()
+func (fake *FakeStorage) AddDeadLetter(arg1 context.Context, arg2 *service.DeadLetter) error {
+	fake.addDeadLetterMutex.Lock()
+	ret, specificReturn := fake.addDeadLetterReturnsOnCall[len(fake.addDeadLetterArgsForCall)]
+	fake.addDeadLetterArgsForCall = append(fake.addDeadLetterArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.DeadLetter
+	}{arg1, arg2})
+	stub := fake.AddDeadLetterStub
+	fakeReturns := fake.addDeadLetterReturns
+	fake.recordInvocation("AddDeadLetter", []interface{}{arg1, arg2})
+	fake.addDeadLetterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) AddDeadLetterCallCount() int {
+	fake.addDeadLetterMutex.RLock()
+	defer fake.addDeadLetterMutex.RUnlock()
+	return len(fake.addDeadLetterArgsForCall)
+}
+
+func (fake *FakeStorage) AddDeadLetterCalls(stub func(context.Context, *service.DeadLetter) error) {
+	fake.addDeadLetterMutex.Lock()
+	defer fake.addDeadLetterMutex.Unlock()
+	fake.AddDeadLetterStub = stub
+}
+
+func (fake *FakeStorage) AddDeadLetterArgsForCall(i int) (context.Context, *service.DeadLetter) {
+	fake.addDeadLetterMutex.RLock()
+	defer fake.addDeadLetterMutex.RUnlock()
+	argsForCall := fake.addDeadLetterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) AddDeadLetterReturns(result1 error) {
+	fake.addDeadLetterMutex.Lock()
+	defer fake.addDeadLetterMutex.Unlock()
+	fake.AddDeadLetterStub = nil
+	fake.addDeadLetterReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddDeadLetterReturnsOnCall(i int, result1 error) {
+	fake.addDeadLetterMutex.Lock()
+	defer fake.addDeadLetterMutex.Unlock()
+	fake.AddDeadLetterStub = nil
+	if fake.addDeadLetterReturnsOnCall == nil {
+		fake.addDeadLetterReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addDeadLetterReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddEventTask(arg1 context.Context, arg2 *service.EventTask) error {
+	fake.addEventTaskMutex.Lock()
+	ret, specificReturn := fake.addEventTaskReturnsOnCall[len(fake.addEventTaskArgsForCall)]
+	fake.addEventTaskArgsForCall = append(fake.addEventTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.EventTask
+	}{arg1, arg2})
+	stub := fake.AddEventTaskStub
+	fakeReturns := fake.addEventTaskReturns
+	fake.recordInvocation("AddEventTask", []interface{}{arg1, arg2})
+	fake.addEventTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) AddEventTaskCallCount() int {
+	fake.addEventTaskMutex.RLock()
+	defer fake.addEventTaskMutex.RUnlock()
+	return len(fake.addEventTaskArgsForCall)
+}
+
+func (fake *FakeStorage) AddEventTaskCalls(stub func(context.Context, *service.EventTask) error) {
+	fake.addEventTaskMutex.Lock()
+	defer fake.addEventTaskMutex.Unlock()
+	fake.AddEventTaskStub = stub
+}
+
+func (fake *FakeStorage) AddEventTaskArgsForCall(i int) (context.Context, *service.EventTask) {
+	fake.addEventTaskMutex.RLock()
+	defer fake.addEventTaskMutex.RUnlock()
+	argsForCall := fake.addEventTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) AddEventTaskReturns(result1 error) {
+	fake.addEventTaskMutex.Lock()
+	defer fake.addEventTaskMutex.Unlock()
+	fake.AddEventTaskStub = nil
+	fake.addEventTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddEventTaskReturnsOnCall(i int, result1 error) {
+	fake.addEventTaskMutex.Lock()
+	defer fake.addEventTaskMutex.Unlock()
+	fake.AddEventTaskStub = nil
+	if fake.addEventTaskReturnsOnCall == nil {
+		fake.addEventTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addEventTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddIdempotencyKey(arg1 context.Context, arg2 *service.IdempotencyKey) error {
+	fake.addIdempotencyKeyMutex.Lock()
+	ret, specificReturn := fake.addIdempotencyKeyReturnsOnCall[len(fake.addIdempotencyKeyArgsForCall)]
+	fake.addIdempotencyKeyArgsForCall = append(fake.addIdempotencyKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.IdempotencyKey
+	}{arg1, arg2})
+	stub := fake.AddIdempotencyKeyStub
+	fakeReturns := fake.addIdempotencyKeyReturns
+	fake.recordInvocation("AddIdempotencyKey", []interface{}{arg1, arg2})
+	fake.addIdempotencyKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) AddIdempotencyKeyCallCount() int {
+	fake.addIdempotencyKeyMutex.RLock()
+	defer fake.addIdempotencyKeyMutex.RUnlock()
+	return len(fake.addIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeStorage) AddIdempotencyKeyCalls(stub func(context.Context, *service.IdempotencyKey) error) {
+	fake.addIdempotencyKeyMutex.Lock()
+	defer fake.addIdempotencyKeyMutex.Unlock()
+	fake.AddIdempotencyKeyStub = stub
+}
+
+func (fake *FakeStorage) AddIdempotencyKeyArgsForCall(i int) (context.Context, *service.IdempotencyKey) {
+	fake.addIdempotencyKeyMutex.RLock()
+	defer fake.addIdempotencyKeyMutex.RUnlock()
+	argsForCall := fake.addIdempotencyKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) AddIdempotencyKeyReturns(result1 error) {
+	fake.addIdempotencyKeyMutex.Lock()
+	defer fake.addIdempotencyKeyMutex.Unlock()
+	fake.AddIdempotencyKeyStub = nil
+	fake.addIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddIdempotencyKeyReturnsOnCall(i int, result1 error) {
+	fake.addIdempotencyKeyMutex.Lock()
+	defer fake.addIdempotencyKeyMutex.Unlock()
+	fake.AddIdempotencyKeyStub = nil
+	if fake.addIdempotencyKeyReturnsOnCall == nil {
+		fake.addIdempotencyKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addIdempotencyKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddSchedule(arg1 context.Context, arg2 *service.Schedule) error {
+	fake.addScheduleMutex.Lock()
+	ret, specificReturn := fake.addScheduleReturnsOnCall[len(fake.addScheduleArgsForCall)]
+	fake.addScheduleArgsForCall = append(fake.addScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.Schedule
+	}{arg1, arg2})
+	stub := fake.AddScheduleStub
+	fakeReturns := fake.addScheduleReturns
+	fake.recordInvocation("AddSchedule", []interface{}{arg1, arg2})
+	fake.addScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) AddScheduleCallCount() int {
+	fake.addScheduleMutex.RLock()
+	defer fake.addScheduleMutex.RUnlock()
+	return len(fake.addScheduleArgsForCall)
+}
+
+func (fake *FakeStorage) AddScheduleCalls(stub func(context.Context, *service.Schedule) error) {
+	fake.addScheduleMutex.Lock()
+	defer fake.addScheduleMutex.Unlock()
+	fake.AddScheduleStub = stub
+}
+
+func (fake *FakeStorage) AddScheduleArgsForCall(i int) (context.Context, *service.Schedule) {
+	fake.addScheduleMutex.RLock()
+	defer fake.addScheduleMutex.RUnlock()
+	argsForCall := fake.addScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) AddScheduleReturns(result1 error) {
+	fake.addScheduleMutex.Lock()
+	defer fake.addScheduleMutex.Unlock()
+	fake.AddScheduleStub = nil
+	fake.addScheduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddScheduleReturnsOnCall(i int, result1 error) {
+	fake.addScheduleMutex.Lock()
+	defer fake.addScheduleMutex.Unlock()
+	fake.AddScheduleStub = nil
+	if fake.addScheduleReturnsOnCall == nil {
+		fake.addScheduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addScheduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddTaskListTemplate(arg1 context.Context, arg2 *service.Template) error {
+	fake.addTaskListTemplateMutex.Lock()
+	ret, specificReturn := fake.addTaskListTemplateReturnsOnCall[len(fake.addTaskListTemplateArgsForCall)]
+	fake.addTaskListTemplateArgsForCall = append(fake.addTaskListTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.Template
+	}{arg1, arg2})
+	stub := fake.AddTaskListTemplateStub
+	fakeReturns := fake.addTaskListTemplateReturns
+	fake.recordInvocation("AddTaskListTemplate", []interface{}{arg1, arg2})
+	fake.addTaskListTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) AddTaskListTemplateCallCount() int {
+	fake.addTaskListTemplateMutex.RLock()
+	defer fake.addTaskListTemplateMutex.RUnlock()
+	return len(fake.addTaskListTemplateArgsForCall)
+}
+
+func (fake *FakeStorage) AddTaskListTemplateCalls(stub func(context.Context, *service.Template) error) {
+	fake.addTaskListTemplateMutex.Lock()
+	defer fake.addTaskListTemplateMutex.Unlock()
+	fake.AddTaskListTemplateStub = stub
+}
+
+func (fake *FakeStorage) AddTaskListTemplateArgsForCall(i int) (context.Context, *service.Template) {
+	fake.addTaskListTemplateMutex.RLock()
+	defer fake.addTaskListTemplateMutex.RUnlock()
+	argsForCall := fake.addTaskListTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) AddTaskListTemplateReturns(result1 error) {
+	fake.addTaskListTemplateMutex.Lock()
+	defer fake.addTaskListTemplateMutex.Unlock()
+	fake.AddTaskListTemplateStub = nil
+	fake.addTaskListTemplateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddTaskListTemplateReturnsOnCall(i int, result1 error) {
+	fake.addTaskListTemplateMutex.Lock()
+	defer fake.addTaskListTemplateMutex.Unlock()
+	fake.AddTaskListTemplateStub = nil
+	if fake.addTaskListTemplateReturnsOnCall == nil {
+		fake.addTaskListTemplateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addTaskListTemplateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddTaskTemplate(arg1 context.Context, arg2 *service.Task) error {
+	fake.addTaskTemplateMutex.Lock()
+	ret, specificReturn := fake.addTaskTemplateReturnsOnCall[len(fake.addTaskTemplateArgsForCall)]
+	fake.addTaskTemplateArgsForCall = append(fake.addTaskTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.Task
+	}{arg1, arg2})
+	stub := fake.AddTaskTemplateStub
+	fakeReturns := fake.addTaskTemplateReturns
+	fake.recordInvocation("AddTaskTemplate", []interface{}{arg1, arg2})
+	fake.addTaskTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) AddTaskTemplateCallCount() int {
+	fake.addTaskTemplateMutex.RLock()
+	defer fake.addTaskTemplateMutex.RUnlock()
+	return len(fake.addTaskTemplateArgsForCall)
+}
+
+func (fake *FakeStorage) AddTaskTemplateCalls(stub func(context.Context, *service.Task) error) {
+	fake.addTaskTemplateMutex.Lock()
+	defer fake.addTaskTemplateMutex.Unlock()
+	fake.AddTaskTemplateStub = stub
+}
+
+func (fake *FakeStorage) AddTaskTemplateArgsForCall(i int) (context.Context, *service.Task) {
+	fake.addTaskTemplateMutex.RLock()
+	defer fake.addTaskTemplateMutex.RUnlock()
+	argsForCall := fake.addTaskTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) AddTaskTemplateReturns(result1 error) {
+	fake.addTaskTemplateMutex.Lock()
+	defer fake.addTaskTemplateMutex.Unlock()
+	fake.AddTaskTemplateStub = nil
+	fake.addTaskTemplateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddTaskTemplateReturnsOnCall(i int, result1 error) {
+	fake.addTaskTemplateMutex.Lock()
+	defer fake.addTaskTemplateMutex.Unlock()
+	fake.AddTaskTemplateStub = nil
+	if fake.addTaskTemplateReturnsOnCall == nil {
+		fake.addTaskTemplateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addTaskTemplateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddWebhookDelivery(arg1 context.Context, arg2 *service.WebhookDelivery) error {
+	fake.addWebhookDeliveryMutex.Lock()
+	ret, specificReturn := fake.addWebhookDeliveryReturnsOnCall[len(fake.addWebhookDeliveryArgsForCall)]
+	fake.addWebhookDeliveryArgsForCall = append(fake.addWebhookDeliveryArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.WebhookDelivery
+	}{arg1, arg2})
+	stub := fake.AddWebhookDeliveryStub
+	fakeReturns := fake.addWebhookDeliveryReturns
+	fake.recordInvocation("AddWebhookDelivery", []interface{}{arg1, arg2})
+	fake.addWebhookDeliveryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) AddWebhookDeliveryCallCount() int {
+	fake.addWebhookDeliveryMutex.RLock()
+	defer fake.addWebhookDeliveryMutex.RUnlock()
+	return len(fake.addWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeStorage) AddWebhookDeliveryCalls(stub func(context.Context, *service.WebhookDelivery) error) {
+	fake.addWebhookDeliveryMutex.Lock()
+	defer fake.addWebhookDeliveryMutex.Unlock()
+	fake.AddWebhookDeliveryStub = stub
+}
+
+func (fake *FakeStorage) AddWebhookDeliveryArgsForCall(i int) (context.Context, *service.WebhookDelivery) {
+	fake.addWebhookDeliveryMutex.RLock()
+	defer fake.addWebhookDeliveryMutex.RUnlock()
+	argsForCall := fake.addWebhookDeliveryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) AddWebhookDeliveryReturns(result1 error) {
+	fake.addWebhookDeliveryMutex.Lock()
+	defer fake.addWebhookDeliveryMutex.Unlock()
+	fake.AddWebhookDeliveryStub = nil
+	fake.addWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) AddWebhookDeliveryReturnsOnCall(i int, result1 error) {
+	fake.addWebhookDeliveryMutex.Lock()
+	defer fake.addWebhookDeliveryMutex.Unlock()
+	fake.AddWebhookDeliveryStub = nil
+	if fake.addWebhookDeliveryReturnsOnCall == nil {
+		fake.addWebhookDeliveryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addWebhookDeliveryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) ClaimPendingRun(arg1 context.Context, arg2 string, arg3 *service.ScheduleRun, arg4 time.Time) error {
+	fake.claimPendingRunMutex.Lock()
+	ret, specificReturn := fake.claimPendingRunReturnsOnCall[len(fake.claimPendingRunArgsForCall)]
+	fake.claimPendingRunArgsForCall = append(fake.claimPendingRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *service.ScheduleRun
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ClaimPendingRunStub
+	fakeReturns := fake.claimPendingRunReturns
+	fake.recordInvocation("ClaimPendingRun", []interface{}{arg1, arg2, arg3, arg4})
+	fake.claimPendingRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) ClaimPendingRunCallCount() int {
+	fake.claimPendingRunMutex.RLock()
+	defer fake.claimPendingRunMutex.RUnlock()
+	return len(fake.claimPendingRunArgsForCall)
+}
+
+func (fake *FakeStorage) ClaimPendingRunCalls(stub func(context.Context, string, *service.ScheduleRun, time.Time) error) {
+	fake.claimPendingRunMutex.Lock()
+	defer fake.claimPendingRunMutex.Unlock()
+	fake.ClaimPendingRunStub = stub
+}
+
+func (fake *FakeStorage) ClaimPendingRunArgsForCall(i int) (context.Context, string, *service.ScheduleRun, time.Time) {
+	fake.claimPendingRunMutex.RLock()
+	defer fake.claimPendingRunMutex.RUnlock()
+	argsForCall := fake.claimPendingRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStorage) ClaimPendingRunReturns(result1 error) {
+	fake.claimPendingRunMutex.Lock()
+	defer fake.claimPendingRunMutex.Unlock()
+	fake.ClaimPendingRunStub = nil
+	fake.claimPendingRunReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) ClaimPendingRunReturnsOnCall(i int, result1 error) {
+	fake.claimPendingRunMutex.Lock()
+	defer fake.claimPendingRunMutex.Unlock()
+	fake.ClaimPendingRunStub = nil
+	if fake.claimPendingRunReturnsOnCall == nil {
+		fake.claimPendingRunReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.claimPendingRunReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) ClaimSchedule(arg1 context.Context, arg2 string, arg3 time.Time, arg4 *service.ScheduleRun) error {
+	fake.claimScheduleMutex.Lock()
+	ret, specificReturn := fake.claimScheduleReturnsOnCall[len(fake.claimScheduleArgsForCall)]
+	fake.claimScheduleArgsForCall = append(fake.claimScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+		arg4 *service.ScheduleRun
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ClaimScheduleStub
+	fakeReturns := fake.claimScheduleReturns
+	fake.recordInvocation("ClaimSchedule", []interface{}{arg1, arg2, arg3, arg4})
+	fake.claimScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) ClaimScheduleCallCount() int {
+	fake.claimScheduleMutex.RLock()
+	defer fake.claimScheduleMutex.RUnlock()
+	return len(fake.claimScheduleArgsForCall)
+}
+
+func (fake *FakeStorage) ClaimScheduleCalls(stub func(context.Context, string, time.Time, *service.ScheduleRun) error) {
+	fake.claimScheduleMutex.Lock()
+	defer fake.claimScheduleMutex.Unlock()
+	fake.ClaimScheduleStub = stub
+}
+
+func (fake *FakeStorage) ClaimScheduleArgsForCall(i int) (context.Context, string, time.Time, *service.ScheduleRun) {
+	fake.claimScheduleMutex.RLock()
+	defer fake.claimScheduleMutex.RUnlock()
+	argsForCall := fake.claimScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStorage) ClaimScheduleReturns(result1 error) {
+	fake.claimScheduleMutex.Lock()
+	defer fake.claimScheduleMutex.Unlock()
+	fake.ClaimScheduleStub = nil
+	fake.claimScheduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) ClaimScheduleReturnsOnCall(i int, result1 error) {
+	fake.claimScheduleMutex.Lock()
+	defer fake.claimScheduleMutex.Unlock()
+	fake.ClaimScheduleStub = nil
+	if fake.claimScheduleReturnsOnCall == nil {
+		fake.claimScheduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.claimScheduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CompleteScheduleRun(arg1 context.Context, arg2 string, arg3 *service.ScheduleRun) error {
+	fake.completeScheduleRunMutex.Lock()
+	ret, specificReturn := fake.completeScheduleRunReturnsOnCall[len(fake.completeScheduleRunArgsForCall)]
+	fake.completeScheduleRunArgsForCall = append(fake.completeScheduleRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *service.ScheduleRun
+	}{arg1, arg2, arg3})
+	stub := fake.CompleteScheduleRunStub
+	fakeReturns := fake.completeScheduleRunReturns
+	fake.recordInvocation("CompleteScheduleRun", []interface{}{arg1, arg2, arg3})
+	fake.completeScheduleRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) CompleteScheduleRunCallCount() int {
+	fake.completeScheduleRunMutex.RLock()
+	defer fake.completeScheduleRunMutex.RUnlock()
+	return len(fake.completeScheduleRunArgsForCall)
+}
+
+func (fake *FakeStorage) CompleteScheduleRunCalls(stub func(context.Context, string, *service.ScheduleRun) error) {
+	fake.completeScheduleRunMutex.Lock()
+	defer fake.completeScheduleRunMutex.Unlock()
+	fake.CompleteScheduleRunStub = stub
+}
+
+func (fake *FakeStorage) CompleteScheduleRunArgsForCall(i int) (context.Context, string, *service.ScheduleRun) {
+	fake.completeScheduleRunMutex.RLock()
+	defer fake.completeScheduleRunMutex.RUnlock()
+	argsForCall := fake.completeScheduleRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) CompleteScheduleRunReturns(result1 error) {
+	fake.completeScheduleRunMutex.Lock()
+	defer fake.completeScheduleRunMutex.Unlock()
+	fake.CompleteScheduleRunStub = nil
+	fake.completeScheduleRunReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CompleteScheduleRunReturnsOnCall(i int, result1 error) {
+	fake.completeScheduleRunMutex.Lock()
+	defer fake.completeScheduleRunMutex.Unlock()
+	fake.CompleteScheduleRunStub = nil
+	if fake.completeScheduleRunReturnsOnCall == nil {
+		fake.completeScheduleRunReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.completeScheduleRunReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeadLetter(arg1 context.Context, arg2 string) (*service.DeadLetter, error) {
+	fake.deadLetterMutex.Lock()
+	ret, specificReturn := fake.deadLetterReturnsOnCall[len(fake.deadLetterArgsForCall)]
+	fake.deadLetterArgsForCall = append(fake.deadLetterArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeadLetterStub
+	fakeReturns := fake.deadLetterReturns
+	fake.recordInvocation("DeadLetter", []interface{}{arg1, arg2})
+	fake.deadLetterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) DeadLetterCallCount() int {
+	fake.deadLetterMutex.RLock()
+	defer fake.deadLetterMutex.RUnlock()
+	return len(fake.deadLetterArgsForCall)
+}
+
+func (fake *FakeStorage) DeadLetterCalls(stub func(context.Context, string) (*service.DeadLetter, error)) {
+	fake.deadLetterMutex.Lock()
+	defer fake.deadLetterMutex.Unlock()
+	fake.DeadLetterStub = stub
+}
+
+func (fake *FakeStorage) DeadLetterArgsForCall(i int) (context.Context, string) {
+	fake.deadLetterMutex.RLock()
+	defer fake.deadLetterMutex.RUnlock()
+	argsForCall := fake.deadLetterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) DeadLetterReturns(result1 *service.DeadLetter, result2 error) {
+	fake.deadLetterMutex.Lock()
+	defer fake.deadLetterMutex.Unlock()
+	fake.DeadLetterStub = nil
+	fake.deadLetterReturns = struct {
+		result1 *service.DeadLetter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) DeadLetterReturnsOnCall(i int, result1 *service.DeadLetter, result2 error) {
+	fake.deadLetterMutex.Lock()
+	defer fake.deadLetterMutex.Unlock()
+	fake.DeadLetterStub = nil
+	if fake.deadLetterReturnsOnCall == nil {
+		fake.deadLetterReturnsOnCall = make(map[int]struct {
+			result1 *service.DeadLetter
+			result2 error
+		})
+	}
+	fake.deadLetterReturnsOnCall[i] = struct {
+		result1 *service.DeadLetter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) DeadLetters(arg1 context.Context, arg2 string, arg3 int) ([]*service.DeadLetter, error) {
+	fake.deadLettersMutex.Lock()
+	ret, specificReturn := fake.deadLettersReturnsOnCall[len(fake.deadLettersArgsForCall)]
+	fake.deadLettersArgsForCall = append(fake.deadLettersArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeadLettersStub
+	fakeReturns := fake.deadLettersReturns
+	fake.recordInvocation("DeadLetters", []interface{}{arg1, arg2, arg3})
+	fake.deadLettersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) DeadLettersCallCount() int {
+	fake.deadLettersMutex.RLock()
+	defer fake.deadLettersMutex.RUnlock()
+	return len(fake.deadLettersArgsForCall)
+}
+
+func (fake *FakeStorage) DeadLettersCalls(stub func(context.Context, string, int) ([]*service.DeadLetter, error)) {
+	fake.deadLettersMutex.Lock()
+	defer fake.deadLettersMutex.Unlock()
+	fake.DeadLettersStub = stub
+}
+
+func (fake *FakeStorage) DeadLettersArgsForCall(i int) (context.Context, string, int) {
+	fake.deadLettersMutex.RLock()
+	defer fake.deadLettersMutex.RUnlock()
+	argsForCall := fake.deadLettersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) DeadLettersReturns(result1 []*service.DeadLetter, result2 error) {
+	fake.deadLettersMutex.Lock()
+	defer fake.deadLettersMutex.Unlock()
+	fake.DeadLettersStub = nil
+	fake.deadLettersReturns = struct {
+		result1 []*service.DeadLetter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) DeadLettersReturnsOnCall(i int, result1 []*service.DeadLetter, result2 error) {
+	fake.deadLettersMutex.Lock()
+	defer fake.deadLettersMutex.Unlock()
+	fake.DeadLettersStub = nil
+	if fake.deadLettersReturnsOnCall == nil {
+		fake.deadLettersReturnsOnCall = make(map[int]struct {
+			result1 []*service.DeadLetter
+			result2 error
+		})
+	}
+	fake.deadLettersReturnsOnCall[i] = struct {
+		result1 []*service.DeadLetter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) DeleteDeadLetter(arg1 context.Context, arg2 string) (*service.DeadLetter, error) {
+	fake.deleteDeadLetterMutex.Lock()
+	ret, specificReturn := fake.deleteDeadLetterReturnsOnCall[len(fake.deleteDeadLetterArgsForCall)]
+	fake.deleteDeadLetterArgsForCall = append(fake.deleteDeadLetterArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteDeadLetterStub
+	fakeReturns := fake.deleteDeadLetterReturns
+	fake.recordInvocation("DeleteDeadLetter", []interface{}{arg1, arg2})
+	fake.deleteDeadLetterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) DeleteDeadLetterCallCount() int {
+	fake.deleteDeadLetterMutex.RLock()
+	defer fake.deleteDeadLetterMutex.RUnlock()
+	return len(fake.deleteDeadLetterArgsForCall)
+}
+
+func (fake *FakeStorage) DeleteDeadLetterCalls(stub func(context.Context, string) (*service.DeadLetter, error)) {
+	fake.deleteDeadLetterMutex.Lock()
+	defer fake.deleteDeadLetterMutex.Unlock()
+	fake.DeleteDeadLetterStub = stub
+}
+
+func (fake *FakeStorage) DeleteDeadLetterArgsForCall(i int) (context.Context, string) {
+	fake.deleteDeadLetterMutex.RLock()
+	defer fake.deleteDeadLetterMutex.RUnlock()
+	argsForCall := fake.deleteDeadLetterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) DeleteDeadLetterReturns(result1 *service.DeadLetter, result2 error) {
+	fake.deleteDeadLetterMutex.Lock()
+	defer fake.deleteDeadLetterMutex.Unlock()
+	fake.DeleteDeadLetterStub = nil
+	fake.deleteDeadLetterReturns = struct {
+		result1 *service.DeadLetter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) DeleteDeadLetterReturnsOnCall(i int, result1 *service.DeadLetter, result2 error) {
+	fake.deleteDeadLetterMutex.Lock()
+	defer fake.deleteDeadLetterMutex.Unlock()
+	fake.DeleteDeadLetterStub = nil
+	if fake.deleteDeadLetterReturnsOnCall == nil {
+		fake.deleteDeadLetterReturnsOnCall = make(map[int]struct {
+			result1 *service.DeadLetter
+			result2 error
+		})
+	}
+	fake.deleteDeadLetterReturnsOnCall[i] = struct {
+		result1 *service.DeadLetter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) DeleteEventTask(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.deleteEventTaskMutex.Lock()
+	ret, specificReturn := fake.deleteEventTaskReturnsOnCall[len(fake.deleteEventTaskArgsForCall)]
+	fake.deleteEventTaskArgsForCall = append(fake.deleteEventTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteEventTaskStub
+	fakeReturns := fake.deleteEventTaskReturns
+	fake.recordInvocation("DeleteEventTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteEventTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) DeleteEventTaskCallCount() int {
+	fake.deleteEventTaskMutex.RLock()
+	defer fake.deleteEventTaskMutex.RUnlock()
+	return len(fake.deleteEventTaskArgsForCall)
+}
+
+func (fake *FakeStorage) DeleteEventTaskCalls(stub func(context.Context, string, string, string) error) {
+	fake.deleteEventTaskMutex.Lock()
+	defer fake.deleteEventTaskMutex.Unlock()
+	fake.DeleteEventTaskStub = stub
+}
+
+func (fake *FakeStorage) DeleteEventTaskArgsForCall(i int) (context.Context, string, string, string) {
+	fake.deleteEventTaskMutex.RLock()
+	defer fake.deleteEventTaskMutex.RUnlock()
+	argsForCall := fake.deleteEventTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStorage) DeleteEventTaskReturns(result1 error) {
+	fake.deleteEventTaskMutex.Lock()
+	defer fake.deleteEventTaskMutex.Unlock()
+	fake.DeleteEventTaskStub = nil
+	fake.deleteEventTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteEventTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteEventTaskMutex.Lock()
+	defer fake.deleteEventTaskMutex.Unlock()
+	fake.DeleteEventTaskStub = nil
+	if fake.deleteEventTaskReturnsOnCall == nil {
+		fake.deleteEventTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteEventTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteIdempotencyKey(arg1 context.Context, arg2 string) error {
+	fake.deleteIdempotencyKeyMutex.Lock()
+	ret, specificReturn := fake.deleteIdempotencyKeyReturnsOnCall[len(fake.deleteIdempotencyKeyArgsForCall)]
+	fake.deleteIdempotencyKeyArgsForCall = append(fake.deleteIdempotencyKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteIdempotencyKeyStub
+	fakeReturns := fake.deleteIdempotencyKeyReturns
+	fake.recordInvocation("DeleteIdempotencyKey", []interface{}{arg1, arg2})
+	fake.deleteIdempotencyKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) DeleteIdempotencyKeyCallCount() int {
+	fake.deleteIdempotencyKeyMutex.RLock()
+	defer fake.deleteIdempotencyKeyMutex.RUnlock()
+	return len(fake.deleteIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeStorage) DeleteIdempotencyKeyCalls(stub func(context.Context, string) error) {
+	fake.deleteIdempotencyKeyMutex.Lock()
+	defer fake.deleteIdempotencyKeyMutex.Unlock()
+	fake.DeleteIdempotencyKeyStub = stub
+}
+
+func (fake *FakeStorage) DeleteIdempotencyKeyArgsForCall(i int) (context.Context, string) {
+	fake.deleteIdempotencyKeyMutex.RLock()
+	defer fake.deleteIdempotencyKeyMutex.RUnlock()
+	argsForCall := fake.deleteIdempotencyKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) DeleteIdempotencyKeyReturns(result1 error) {
+	fake.deleteIdempotencyKeyMutex.Lock()
+	defer fake.deleteIdempotencyKeyMutex.Unlock()
+	fake.DeleteIdempotencyKeyStub = nil
+	fake.deleteIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteIdempotencyKeyReturnsOnCall(i int, result1 error) {
+	fake.deleteIdempotencyKeyMutex.Lock()
+	defer fake.deleteIdempotencyKeyMutex.Unlock()
+	fake.DeleteIdempotencyKeyStub = nil
+	if fake.deleteIdempotencyKeyReturnsOnCall == nil {
+		fake.deleteIdempotencyKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteIdempotencyKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteSchedule(arg1 context.Context, arg2 string) error {
+	fake.deleteScheduleMutex.Lock()
+	ret, specificReturn := fake.deleteScheduleReturnsOnCall[len(fake.deleteScheduleArgsForCall)]
+	fake.deleteScheduleArgsForCall = append(fake.deleteScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteScheduleStub
+	fakeReturns := fake.deleteScheduleReturns
+	fake.recordInvocation("DeleteSchedule", []interface{}{arg1, arg2})
+	fake.deleteScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) DeleteScheduleCallCount() int {
+	fake.deleteScheduleMutex.RLock()
+	defer fake.deleteScheduleMutex.RUnlock()
+	return len(fake.deleteScheduleArgsForCall)
+}
+
+func (fake *FakeStorage) DeleteScheduleCalls(stub func(context.Context, string) error) {
+	fake.deleteScheduleMutex.Lock()
+	defer fake.deleteScheduleMutex.Unlock()
+	fake.DeleteScheduleStub = stub
+}
+
+func (fake *FakeStorage) DeleteScheduleArgsForCall(i int) (context.Context, string) {
+	fake.deleteScheduleMutex.RLock()
+	defer fake.deleteScheduleMutex.RUnlock()
+	argsForCall := fake.deleteScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) DeleteScheduleReturns(result1 error) {
+	fake.deleteScheduleMutex.Lock()
+	defer fake.deleteScheduleMutex.Unlock()
+	fake.DeleteScheduleStub = nil
+	fake.deleteScheduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteScheduleReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduleMutex.Lock()
+	defer fake.deleteScheduleMutex.Unlock()
+	fake.DeleteScheduleStub = nil
+	if fake.deleteScheduleReturnsOnCall == nil {
+		fake.deleteScheduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteTaskListTemplate(arg1 context.Context, arg2 string) error {
+	fake.deleteTaskListTemplateMutex.Lock()
+	ret, specificReturn := fake.deleteTaskListTemplateReturnsOnCall[len(fake.deleteTaskListTemplateArgsForCall)]
+	fake.deleteTaskListTemplateArgsForCall = append(fake.deleteTaskListTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteTaskListTemplateStub
+	fakeReturns := fake.deleteTaskListTemplateReturns
+	fake.recordInvocation("DeleteTaskListTemplate", []interface{}{arg1, arg2})
+	fake.deleteTaskListTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) DeleteTaskListTemplateCallCount() int {
+	fake.deleteTaskListTemplateMutex.RLock()
+	defer fake.deleteTaskListTemplateMutex.RUnlock()
+	return len(fake.deleteTaskListTemplateArgsForCall)
+}
+
+func (fake *FakeStorage) DeleteTaskListTemplateCalls(stub func(context.Context, string) error) {
+	fake.deleteTaskListTemplateMutex.Lock()
+	defer fake.deleteTaskListTemplateMutex.Unlock()
+	fake.DeleteTaskListTemplateStub = stub
+}
+
+func (fake *FakeStorage) DeleteTaskListTemplateArgsForCall(i int) (context.Context, string) {
+	fake.deleteTaskListTemplateMutex.RLock()
+	defer fake.deleteTaskListTemplateMutex.RUnlock()
+	argsForCall := fake.deleteTaskListTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) DeleteTaskListTemplateReturns(result1 error) {
+	fake.deleteTaskListTemplateMutex.Lock()
+	defer fake.deleteTaskListTemplateMutex.Unlock()
+	fake.DeleteTaskListTemplateStub = nil
+	fake.deleteTaskListTemplateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteTaskListTemplateReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskListTemplateMutex.Lock()
+	defer fake.deleteTaskListTemplateMutex.Unlock()
+	fake.DeleteTaskListTemplateStub = nil
+	if fake.deleteTaskListTemplateReturnsOnCall == nil {
+		fake.deleteTaskListTemplateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTaskListTemplateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteTaskTemplate(arg1 context.Context, arg2 string) error {
+	fake.deleteTaskTemplateMutex.Lock()
+	ret, specificReturn := fake.deleteTaskTemplateReturnsOnCall[len(fake.deleteTaskTemplateArgsForCall)]
+	fake.deleteTaskTemplateArgsForCall = append(fake.deleteTaskTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteTaskTemplateStub
+	fakeReturns := fake.deleteTaskTemplateReturns
+	fake.recordInvocation("DeleteTaskTemplate", []interface{}{arg1, arg2})
+	fake.deleteTaskTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) DeleteTaskTemplateCallCount() int {
+	fake.deleteTaskTemplateMutex.RLock()
+	defer fake.deleteTaskTemplateMutex.RUnlock()
+	return len(fake.deleteTaskTemplateArgsForCall)
+}
+
+func (fake *FakeStorage) DeleteTaskTemplateCalls(stub func(context.Context, string) error) {
+	fake.deleteTaskTemplateMutex.Lock()
+	defer fake.deleteTaskTemplateMutex.Unlock()
+	fake.DeleteTaskTemplateStub = stub
+}
+
+func (fake *FakeStorage) DeleteTaskTemplateArgsForCall(i int) (context.Context, string) {
+	fake.deleteTaskTemplateMutex.RLock()
+	defer fake.deleteTaskTemplateMutex.RUnlock()
+	argsForCall := fake.deleteTaskTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) DeleteTaskTemplateReturns(result1 error) {
+	fake.deleteTaskTemplateMutex.Lock()
+	defer fake.deleteTaskTemplateMutex.Unlock()
+	fake.DeleteTaskTemplateStub = nil
+	fake.deleteTaskTemplateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteTaskTemplateReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskTemplateMutex.Lock()
+	defer fake.deleteTaskTemplateMutex.Unlock()
+	fake.DeleteTaskTemplateStub = nil
+	if fake.deleteTaskTemplateReturnsOnCall == nil {
+		fake.deleteTaskTemplateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTaskTemplateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DiscardScheduleRun(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.discardScheduleRunMutex.Lock()
+	ret, specificReturn := fake.discardScheduleRunReturnsOnCall[len(fake.discardScheduleRunArgsForCall)]
+	fake.discardScheduleRunArgsForCall = append(fake.discardScheduleRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DiscardScheduleRunStub
+	fakeReturns := fake.discardScheduleRunReturns
+	fake.recordInvocation("DiscardScheduleRun", []interface{}{arg1, arg2, arg3})
+	fake.discardScheduleRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) DiscardScheduleRunCallCount() int {
+	fake.discardScheduleRunMutex.RLock()
+	defer fake.discardScheduleRunMutex.RUnlock()
+	return len(fake.discardScheduleRunArgsForCall)
+}
+
+func (fake *FakeStorage) DiscardScheduleRunCalls(stub func(context.Context, string, string) error) {
+	fake.discardScheduleRunMutex.Lock()
+	defer fake.discardScheduleRunMutex.Unlock()
+	fake.DiscardScheduleRunStub = stub
+}
+
+func (fake *FakeStorage) DiscardScheduleRunArgsForCall(i int) (context.Context, string, string) {
+	fake.discardScheduleRunMutex.RLock()
+	defer fake.discardScheduleRunMutex.RUnlock()
+	argsForCall := fake.discardScheduleRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) DiscardScheduleRunReturns(result1 error) {
+	fake.discardScheduleRunMutex.Lock()
+	defer fake.discardScheduleRunMutex.Unlock()
+	fake.DiscardScheduleRunStub = nil
+	fake.discardScheduleRunReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DiscardScheduleRunReturnsOnCall(i int, result1 error) {
+	fake.discardScheduleRunMutex.Lock()
+	defer fake.discardScheduleRunMutex.Unlock()
+	fake.DiscardScheduleRunStub = nil
+	if fake.discardScheduleRunReturnsOnCall == nil {
+		fake.discardScheduleRunReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.discardScheduleRunReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DueSchedules(arg1 context.Context, arg2 time.Time) ([]*service.Schedule, error) {
+	fake.dueSchedulesMutex.Lock()
+	ret, specificReturn := fake.dueSchedulesReturnsOnCall[len(fake.dueSchedulesArgsForCall)]
+	fake.dueSchedulesArgsForCall = append(fake.dueSchedulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.DueSchedulesStub
+	fakeReturns := fake.dueSchedulesReturns
+	fake.recordInvocation("DueSchedules", []interface{}{arg1, arg2})
+	fake.dueSchedulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) DueSchedulesCallCount() int {
+	fake.dueSchedulesMutex.RLock()
+	defer fake.dueSchedulesMutex.RUnlock()
+	return len(fake.dueSchedulesArgsForCall)
+}
+
+func (fake *FakeStorage) DueSchedulesCalls(stub func(context.Context, time.Time) ([]*service.Schedule, error)) {
+	fake.dueSchedulesMutex.Lock()
+	defer fake.dueSchedulesMutex.Unlock()
+	fake.DueSchedulesStub = stub
+}
+
+func (fake *FakeStorage) DueSchedulesArgsForCall(i int) (context.Context, time.Time) {
+	fake.dueSchedulesMutex.RLock()
+	defer fake.dueSchedulesMutex.RUnlock()
+	argsForCall := fake.dueSchedulesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) DueSchedulesReturns(result1 []*service.Schedule, result2 error) {
+	fake.dueSchedulesMutex.Lock()
+	defer fake.dueSchedulesMutex.Unlock()
+	fake.DueSchedulesStub = nil
+	fake.dueSchedulesReturns = struct {
+		result1 []*service.Schedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) DueSchedulesReturnsOnCall(i int, result1 []*service.Schedule, result2 error) {
+	fake.dueSchedulesMutex.Lock()
+	defer fake.dueSchedulesMutex.Unlock()
+	fake.DueSchedulesStub = nil
+	if fake.dueSchedulesReturnsOnCall == nil {
+		fake.dueSchedulesReturnsOnCall = make(map[int]struct {
+			result1 []*service.Schedule
+			result2 error
+		})
+	}
+	fake.dueSchedulesReturnsOnCall[i] = struct {
+		result1 []*service.Schedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) EventTask(arg1 context.Context, arg2 string, arg3 string, arg4 string) (*service.EventTask, error) {
+	fake.eventTaskMutex.Lock()
 	ret, specificReturn := fake.eventTaskReturnsOnCall[len(fake.eventTaskArgsForCall)]
 	fake.eventTaskArgsForCall = append(fake.eventTaskArgsForCall, struct {
 		arg1 context.Context
@@ -193,58 +1943,510 @@ func (fake *FakeStorage) EventTaskCallCount() int {
 	return len(fake.eventTaskArgsForCall)
 }
 
-func (fake *FakeStorage) EventTaskCalls(stub func(context.Context, string, string, string) (*service.EventTask, error)) {
-	fake.eventTaskMutex.Lock()
-	defer fake.eventTaskMutex.Unlock()
-	fake.EventTaskStub = stub
+func (fake *FakeStorage) EventTaskCalls(stub func(context.Context, string, string, string) (*service.EventTask, error)) {
+	fake.eventTaskMutex.Lock()
+	defer fake.eventTaskMutex.Unlock()
+	fake.EventTaskStub = stub
+}
+
+func (fake *FakeStorage) EventTaskArgsForCall(i int) (context.Context, string, string, string) {
+	fake.eventTaskMutex.RLock()
+	defer fake.eventTaskMutex.RUnlock()
+	argsForCall := fake.eventTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStorage) EventTaskReturns(result1 *service.EventTask, result2 error) {
+	fake.eventTaskMutex.Lock()
+	defer fake.eventTaskMutex.Unlock()
+	fake.EventTaskStub = nil
+	fake.eventTaskReturns = struct {
+		result1 *service.EventTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) EventTaskReturnsOnCall(i int, result1 *service.EventTask, result2 error) {
+	fake.eventTaskMutex.Lock()
+	defer fake.eventTaskMutex.Unlock()
+	fake.EventTaskStub = nil
+	if fake.eventTaskReturnsOnCall == nil {
+		fake.eventTaskReturnsOnCall = make(map[int]struct {
+			result1 *service.EventTask
+			result2 error
+		})
+	}
+	fake.eventTaskReturnsOnCall[i] = struct {
+		result1 *service.EventTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) GetGroupTasks(arg1 context.Context, arg2 *service.Group) ([]*service.Task, error) {
+	fake.getGroupTasksMutex.Lock()
+	ret, specificReturn := fake.getGroupTasksReturnsOnCall[len(fake.getGroupTasksArgsForCall)]
+	fake.getGroupTasksArgsForCall = append(fake.getGroupTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.Group
+	}{arg1, arg2})
+	stub := fake.GetGroupTasksStub
+	fakeReturns := fake.getGroupTasksReturns
+	fake.recordInvocation("GetGroupTasks", []interface{}{arg1, arg2})
+	fake.getGroupTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) GetGroupTasksCallCount() int {
+	fake.getGroupTasksMutex.RLock()
+	defer fake.getGroupTasksMutex.RUnlock()
+	return len(fake.getGroupTasksArgsForCall)
+}
+
+func (fake *FakeStorage) GetGroupTasksCalls(stub func(context.Context, *service.Group) ([]*service.Task, error)) {
+	fake.getGroupTasksMutex.Lock()
+	defer fake.getGroupTasksMutex.Unlock()
+	fake.GetGroupTasksStub = stub
+}
+
+func (fake *FakeStorage) GetGroupTasksArgsForCall(i int) (context.Context, *service.Group) {
+	fake.getGroupTasksMutex.RLock()
+	defer fake.getGroupTasksMutex.RUnlock()
+	argsForCall := fake.getGroupTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) GetGroupTasksReturns(result1 []*service.Task, result2 error) {
+	fake.getGroupTasksMutex.Lock()
+	defer fake.getGroupTasksMutex.Unlock()
+	fake.GetGroupTasksStub = nil
+	fake.getGroupTasksReturns = struct {
+		result1 []*service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) GetGroupTasksReturnsOnCall(i int, result1 []*service.Task, result2 error) {
+	fake.getGroupTasksMutex.Lock()
+	defer fake.getGroupTasksMutex.Unlock()
+	fake.GetGroupTasksStub = nil
+	if fake.getGroupTasksReturnsOnCall == nil {
+		fake.getGroupTasksReturnsOnCall = make(map[int]struct {
+			result1 []*service.Task
+			result2 error
+		})
+	}
+	fake.getGroupTasksReturnsOnCall[i] = struct {
+		result1 []*service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) IdempotencyKey(arg1 context.Context, arg2 string) (*service.IdempotencyKey, error) {
+	fake.idempotencyKeyMutex.Lock()
+	ret, specificReturn := fake.idempotencyKeyReturnsOnCall[len(fake.idempotencyKeyArgsForCall)]
+	fake.idempotencyKeyArgsForCall = append(fake.idempotencyKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IdempotencyKeyStub
+	fakeReturns := fake.idempotencyKeyReturns
+	fake.recordInvocation("IdempotencyKey", []interface{}{arg1, arg2})
+	fake.idempotencyKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) IdempotencyKeyCallCount() int {
+	fake.idempotencyKeyMutex.RLock()
+	defer fake.idempotencyKeyMutex.RUnlock()
+	return len(fake.idempotencyKeyArgsForCall)
+}
+
+func (fake *FakeStorage) IdempotencyKeyCalls(stub func(context.Context, string) (*service.IdempotencyKey, error)) {
+	fake.idempotencyKeyMutex.Lock()
+	defer fake.idempotencyKeyMutex.Unlock()
+	fake.IdempotencyKeyStub = stub
+}
+
+func (fake *FakeStorage) IdempotencyKeyArgsForCall(i int) (context.Context, string) {
+	fake.idempotencyKeyMutex.RLock()
+	defer fake.idempotencyKeyMutex.RUnlock()
+	argsForCall := fake.idempotencyKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) IdempotencyKeyReturns(result1 *service.IdempotencyKey, result2 error) {
+	fake.idempotencyKeyMutex.Lock()
+	defer fake.idempotencyKeyMutex.Unlock()
+	fake.IdempotencyKeyStub = nil
+	fake.idempotencyKeyReturns = struct {
+		result1 *service.IdempotencyKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) IdempotencyKeyReturnsOnCall(i int, result1 *service.IdempotencyKey, result2 error) {
+	fake.idempotencyKeyMutex.Lock()
+	defer fake.idempotencyKeyMutex.Unlock()
+	fake.IdempotencyKeyStub = nil
+	if fake.idempotencyKeyReturnsOnCall == nil {
+		fake.idempotencyKeyReturnsOnCall = make(map[int]struct {
+			result1 *service.IdempotencyKey
+			result2 error
+		})
+	}
+	fake.idempotencyKeyReturnsOnCall[i] = struct {
+		result1 *service.IdempotencyKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ListEventTasks(arg1 context.Context) ([]*service.EventTask, error) {
+	fake.listEventTasksMutex.Lock()
+	ret, specificReturn := fake.listEventTasksReturnsOnCall[len(fake.listEventTasksArgsForCall)]
+	fake.listEventTasksArgsForCall = append(fake.listEventTasksArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListEventTasksStub
+	fakeReturns := fake.listEventTasksReturns
+	fake.recordInvocation("ListEventTasks", []interface{}{arg1})
+	fake.listEventTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ListEventTasksCallCount() int {
+	fake.listEventTasksMutex.RLock()
+	defer fake.listEventTasksMutex.RUnlock()
+	return len(fake.listEventTasksArgsForCall)
+}
+
+func (fake *FakeStorage) ListEventTasksCalls(stub func(context.Context) ([]*service.EventTask, error)) {
+	fake.listEventTasksMutex.Lock()
+	defer fake.listEventTasksMutex.Unlock()
+	fake.ListEventTasksStub = stub
+}
+
+func (fake *FakeStorage) ListEventTasksArgsForCall(i int) context.Context {
+	fake.listEventTasksMutex.RLock()
+	defer fake.listEventTasksMutex.RUnlock()
+	argsForCall := fake.listEventTasksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStorage) ListEventTasksReturns(result1 []*service.EventTask, result2 error) {
+	fake.listEventTasksMutex.Lock()
+	defer fake.listEventTasksMutex.Unlock()
+	fake.ListEventTasksStub = nil
+	fake.listEventTasksReturns = struct {
+		result1 []*service.EventTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ListEventTasksReturnsOnCall(i int, result1 []*service.EventTask, result2 error) {
+	fake.listEventTasksMutex.Lock()
+	defer fake.listEventTasksMutex.Unlock()
+	fake.ListEventTasksStub = nil
+	if fake.listEventTasksReturnsOnCall == nil {
+		fake.listEventTasksReturnsOnCall = make(map[int]struct {
+			result1 []*service.EventTask
+			result2 error
+		})
+	}
+	fake.listEventTasksReturnsOnCall[i] = struct {
+		result1 []*service.EventTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ListSchedules(arg1 context.Context) ([]*service.Schedule, error) {
+	fake.listSchedulesMutex.Lock()
+	ret, specificReturn := fake.listSchedulesReturnsOnCall[len(fake.listSchedulesArgsForCall)]
+	fake.listSchedulesArgsForCall = append(fake.listSchedulesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListSchedulesStub
+	fakeReturns := fake.listSchedulesReturns
+	fake.recordInvocation("ListSchedules", []interface{}{arg1})
+	fake.listSchedulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ListSchedulesCallCount() int {
+	fake.listSchedulesMutex.RLock()
+	defer fake.listSchedulesMutex.RUnlock()
+	return len(fake.listSchedulesArgsForCall)
+}
+
+func (fake *FakeStorage) ListSchedulesCalls(stub func(context.Context) ([]*service.Schedule, error)) {
+	fake.listSchedulesMutex.Lock()
+	defer fake.listSchedulesMutex.Unlock()
+	fake.ListSchedulesStub = stub
+}
+
+func (fake *FakeStorage) ListSchedulesArgsForCall(i int) context.Context {
+	fake.listSchedulesMutex.RLock()
+	defer fake.listSchedulesMutex.RUnlock()
+	argsForCall := fake.listSchedulesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStorage) ListSchedulesReturns(result1 []*service.Schedule, result2 error) {
+	fake.listSchedulesMutex.Lock()
+	defer fake.listSchedulesMutex.Unlock()
+	fake.ListSchedulesStub = nil
+	fake.listSchedulesReturns = struct {
+		result1 []*service.Schedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ListSchedulesReturnsOnCall(i int, result1 []*service.Schedule, result2 error) {
+	fake.listSchedulesMutex.Lock()
+	defer fake.listSchedulesMutex.Unlock()
+	fake.ListSchedulesStub = nil
+	if fake.listSchedulesReturnsOnCall == nil {
+		fake.listSchedulesReturnsOnCall = make(map[int]struct {
+			result1 []*service.Schedule
+			result2 error
+		})
+	}
+	fake.listSchedulesReturnsOnCall[i] = struct {
+		result1 []*service.Schedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ListTaskListTemplates(arg1 context.Context) ([]*service.Template, error) {
+	fake.listTaskListTemplatesMutex.Lock()
+	ret, specificReturn := fake.listTaskListTemplatesReturnsOnCall[len(fake.listTaskListTemplatesArgsForCall)]
+	fake.listTaskListTemplatesArgsForCall = append(fake.listTaskListTemplatesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListTaskListTemplatesStub
+	fakeReturns := fake.listTaskListTemplatesReturns
+	fake.recordInvocation("ListTaskListTemplates", []interface{}{arg1})
+	fake.listTaskListTemplatesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ListTaskListTemplatesCallCount() int {
+	fake.listTaskListTemplatesMutex.RLock()
+	defer fake.listTaskListTemplatesMutex.RUnlock()
+	return len(fake.listTaskListTemplatesArgsForCall)
+}
+
+func (fake *FakeStorage) ListTaskListTemplatesCalls(stub func(context.Context) ([]*service.Template, error)) {
+	fake.listTaskListTemplatesMutex.Lock()
+	defer fake.listTaskListTemplatesMutex.Unlock()
+	fake.ListTaskListTemplatesStub = stub
+}
+
+func (fake *FakeStorage) ListTaskListTemplatesArgsForCall(i int) context.Context {
+	fake.listTaskListTemplatesMutex.RLock()
+	defer fake.listTaskListTemplatesMutex.RUnlock()
+	argsForCall := fake.listTaskListTemplatesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStorage) ListTaskListTemplatesReturns(result1 []*service.Template, result2 error) {
+	fake.listTaskListTemplatesMutex.Lock()
+	defer fake.listTaskListTemplatesMutex.Unlock()
+	fake.ListTaskListTemplatesStub = nil
+	fake.listTaskListTemplatesReturns = struct {
+		result1 []*service.Template
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ListTaskListTemplatesReturnsOnCall(i int, result1 []*service.Template, result2 error) {
+	fake.listTaskListTemplatesMutex.Lock()
+	defer fake.listTaskListTemplatesMutex.Unlock()
+	fake.ListTaskListTemplatesStub = nil
+	if fake.listTaskListTemplatesReturnsOnCall == nil {
+		fake.listTaskListTemplatesReturnsOnCall = make(map[int]struct {
+			result1 []*service.Template
+			result2 error
+		})
+	}
+	fake.listTaskListTemplatesReturnsOnCall[i] = struct {
+		result1 []*service.Template
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ListTaskTemplates(arg1 context.Context) ([]*service.Task, error) {
+	fake.listTaskTemplatesMutex.Lock()
+	ret, specificReturn := fake.listTaskTemplatesReturnsOnCall[len(fake.listTaskTemplatesArgsForCall)]
+	fake.listTaskTemplatesArgsForCall = append(fake.listTaskTemplatesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListTaskTemplatesStub
+	fakeReturns := fake.listTaskTemplatesReturns
+	fake.recordInvocation("ListTaskTemplates", []interface{}{arg1})
+	fake.listTaskTemplatesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ListTaskTemplatesCallCount() int {
+	fake.listTaskTemplatesMutex.RLock()
+	defer fake.listTaskTemplatesMutex.RUnlock()
+	return len(fake.listTaskTemplatesArgsForCall)
+}
+
+func (fake *FakeStorage) ListTaskTemplatesCalls(stub func(context.Context) ([]*service.Task, error)) {
+	fake.listTaskTemplatesMutex.Lock()
+	defer fake.listTaskTemplatesMutex.Unlock()
+	fake.ListTaskTemplatesStub = stub
+}
+
+func (fake *FakeStorage) ListTaskTemplatesArgsForCall(i int) context.Context {
+	fake.listTaskTemplatesMutex.RLock()
+	defer fake.listTaskTemplatesMutex.RUnlock()
+	argsForCall := fake.listTaskTemplatesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStorage) ListTaskTemplatesReturns(result1 []*service.Task, result2 error) {
+	fake.listTaskTemplatesMutex.Lock()
+	defer fake.listTaskTemplatesMutex.Unlock()
+	fake.ListTaskTemplatesStub = nil
+	fake.listTaskTemplatesReturns = struct {
+		result1 []*service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ListTaskTemplatesReturnsOnCall(i int, result1 []*service.Task, result2 error) {
+	fake.listTaskTemplatesMutex.Lock()
+	defer fake.listTaskTemplatesMutex.Unlock()
+	fake.ListTaskTemplatesStub = nil
+	if fake.listTaskTemplatesReturnsOnCall == nil {
+		fake.listTaskTemplatesReturnsOnCall = make(map[int]struct {
+			result1 []*service.Task
+			result2 error
+		})
+	}
+	fake.listTaskTemplatesReturnsOnCall[i] = struct {
+		result1 []*service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) PollWebhookDelivery(arg1 context.Context, arg2 time.Duration) (*service.WebhookDelivery, error) {
+	fake.pollWebhookDeliveryMutex.Lock()
+	ret, specificReturn := fake.pollWebhookDeliveryReturnsOnCall[len(fake.pollWebhookDeliveryArgsForCall)]
+	fake.pollWebhookDeliveryArgsForCall = append(fake.pollWebhookDeliveryArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.PollWebhookDeliveryStub
+	fakeReturns := fake.pollWebhookDeliveryReturns
+	fake.recordInvocation("PollWebhookDelivery", []interface{}{arg1, arg2})
+	fake.pollWebhookDeliveryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) PollWebhookDeliveryCallCount() int {
+	fake.pollWebhookDeliveryMutex.RLock()
+	defer fake.pollWebhookDeliveryMutex.RUnlock()
+	return len(fake.pollWebhookDeliveryArgsForCall)
 }
 
-func (fake *FakeStorage) EventTaskArgsForCall(i int) (context.Context, string, string, string) {
-	fake.eventTaskMutex.RLock()
-	defer fake.eventTaskMutex.RUnlock()
-	argsForCall := fake.eventTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStorage) PollWebhookDeliveryCalls(stub func(context.Context, time.Duration) (*service.WebhookDelivery, error)) {
+	fake.pollWebhookDeliveryMutex.Lock()
+	defer fake.pollWebhookDeliveryMutex.Unlock()
+	fake.PollWebhookDeliveryStub = stub
 }
 
-func (fake *FakeStorage) EventTaskReturns(result1 *service.EventTask, result2 error) {
-	fake.eventTaskMutex.Lock()
-	defer fake.eventTaskMutex.Unlock()
-	fake.EventTaskStub = nil
-	fake.eventTaskReturns = struct {
-		result1 *service.EventTask
+func (fake *FakeStorage) PollWebhookDeliveryArgsForCall(i int) (context.Context, time.Duration) {
+	fake.pollWebhookDeliveryMutex.RLock()
+	defer fake.pollWebhookDeliveryMutex.RUnlock()
+	argsForCall := fake.pollWebhookDeliveryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) PollWebhookDeliveryReturns(result1 *service.WebhookDelivery, result2 error) {
+	fake.pollWebhookDeliveryMutex.Lock()
+	defer fake.pollWebhookDeliveryMutex.Unlock()
+	fake.PollWebhookDeliveryStub = nil
+	fake.pollWebhookDeliveryReturns = struct {
+		result1 *service.WebhookDelivery
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStorage) EventTaskReturnsOnCall(i int, result1 *service.EventTask, result2 error) {
-	fake.eventTaskMutex.Lock()
-	defer fake.eventTaskMutex.Unlock()
-	fake.EventTaskStub = nil
-	if fake.eventTaskReturnsOnCall == nil {
-		fake.eventTaskReturnsOnCall = make(map[int]struct {
-			result1 *service.EventTask
+func (fake *FakeStorage) PollWebhookDeliveryReturnsOnCall(i int, result1 *service.WebhookDelivery, result2 error) {
+	fake.pollWebhookDeliveryMutex.Lock()
+	defer fake.pollWebhookDeliveryMutex.Unlock()
+	fake.PollWebhookDeliveryStub = nil
+	if fake.pollWebhookDeliveryReturnsOnCall == nil {
+		fake.pollWebhookDeliveryReturnsOnCall = make(map[int]struct {
+			result1 *service.WebhookDelivery
 			result2 error
 		})
 	}
-	fake.eventTaskReturnsOnCall[i] = struct {
-		result1 *service.EventTask
+	fake.pollWebhookDeliveryReturnsOnCall[i] = struct {
+		result1 *service.WebhookDelivery
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStorage) GetGroupTasks(arg1 context.Context, arg2 *service.Group) ([]*service.Task, error) {
-	fake.getGroupTasksMutex.Lock()
-	ret, specificReturn := fake.getGroupTasksReturnsOnCall[len(fake.getGroupTasksArgsForCall)]
-	fake.getGroupTasksArgsForCall = append(fake.getGroupTasksArgsForCall, struct {
+func (fake *FakeStorage) PurgeDeadLetters(arg1 context.Context, arg2 string, arg3 time.Time) (int, error) {
+	fake.purgeDeadLettersMutex.Lock()
+	ret, specificReturn := fake.purgeDeadLettersReturnsOnCall[len(fake.purgeDeadLettersArgsForCall)]
+	fake.purgeDeadLettersArgsForCall = append(fake.purgeDeadLettersArgsForCall, struct {
 		arg1 context.Context
-		arg2 *service.Group
-	}{arg1, arg2})
-	stub := fake.GetGroupTasksStub
-	fakeReturns := fake.getGroupTasksReturns
-	fake.recordInvocation("GetGroupTasks", []interface{}{arg1, arg2})
-	fake.getGroupTasksMutex.Unlock()
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.PurgeDeadLettersStub
+	fakeReturns := fake.purgeDeadLettersReturns
+	fake.recordInvocation("PurgeDeadLetters", []interface{}{arg1, arg2, arg3})
+	fake.purgeDeadLettersMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -252,47 +2454,47 @@ func (fake *FakeStorage) GetGroupTasks(arg1 context.Context, arg2 *service.Group
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStorage) GetGroupTasksCallCount() int {
-	fake.getGroupTasksMutex.RLock()
-	defer fake.getGroupTasksMutex.RUnlock()
-	return len(fake.getGroupTasksArgsForCall)
+func (fake *FakeStorage) PurgeDeadLettersCallCount() int {
+	fake.purgeDeadLettersMutex.RLock()
+	defer fake.purgeDeadLettersMutex.RUnlock()
+	return len(fake.purgeDeadLettersArgsForCall)
 }
 
-func (fake *FakeStorage) GetGroupTasksCalls(stub func(context.Context, *service.Group) ([]*service.Task, error)) {
-	fake.getGroupTasksMutex.Lock()
-	defer fake.getGroupTasksMutex.Unlock()
-	fake.GetGroupTasksStub = stub
+func (fake *FakeStorage) PurgeDeadLettersCalls(stub func(context.Context, string, time.Time) (int, error)) {
+	fake.purgeDeadLettersMutex.Lock()
+	defer fake.purgeDeadLettersMutex.Unlock()
+	fake.PurgeDeadLettersStub = stub
 }
 
-func (fake *FakeStorage) GetGroupTasksArgsForCall(i int) (context.Context, *service.Group) {
-	fake.getGroupTasksMutex.RLock()
-	defer fake.getGroupTasksMutex.RUnlock()
-	argsForCall := fake.getGroupTasksArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStorage) PurgeDeadLettersArgsForCall(i int) (context.Context, string, time.Time) {
+	fake.purgeDeadLettersMutex.RLock()
+	defer fake.purgeDeadLettersMutex.RUnlock()
+	argsForCall := fake.purgeDeadLettersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStorage) GetGroupTasksReturns(result1 []*service.Task, result2 error) {
-	fake.getGroupTasksMutex.Lock()
-	defer fake.getGroupTasksMutex.Unlock()
-	fake.GetGroupTasksStub = nil
-	fake.getGroupTasksReturns = struct {
-		result1 []*service.Task
+func (fake *FakeStorage) PurgeDeadLettersReturns(result1 int, result2 error) {
+	fake.purgeDeadLettersMutex.Lock()
+	defer fake.purgeDeadLettersMutex.Unlock()
+	fake.PurgeDeadLettersStub = nil
+	fake.purgeDeadLettersReturns = struct {
+		result1 int
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStorage) GetGroupTasksReturnsOnCall(i int, result1 []*service.Task, result2 error) {
-	fake.getGroupTasksMutex.Lock()
-	defer fake.getGroupTasksMutex.Unlock()
-	fake.GetGroupTasksStub = nil
-	if fake.getGroupTasksReturnsOnCall == nil {
-		fake.getGroupTasksReturnsOnCall = make(map[int]struct {
-			result1 []*service.Task
+func (fake *FakeStorage) PurgeDeadLettersReturnsOnCall(i int, result1 int, result2 error) {
+	fake.purgeDeadLettersMutex.Lock()
+	defer fake.purgeDeadLettersMutex.Unlock()
+	fake.PurgeDeadLettersStub = nil
+	if fake.purgeDeadLettersReturnsOnCall == nil {
+		fake.purgeDeadLettersReturnsOnCall = make(map[int]struct {
+			result1 int
 			result2 error
 		})
 	}
-	fake.getGroupTasksReturnsOnCall[i] = struct {
-		result1 []*service.Task
+	fake.purgeDeadLettersReturnsOnCall[i] = struct {
+		result1 int
 		result2 error
 	}{result1, result2}
 }
@@ -421,6 +2623,71 @@ func (fake *FakeStorage) SaveTaskListHistoryReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeStorage) Schedule(arg1 context.Context, arg2 string) (*service.Schedule, error) {
+	fake.scheduleMutex.Lock()
+	ret, specificReturn := fake.scheduleReturnsOnCall[len(fake.scheduleArgsForCall)]
+	fake.scheduleArgsForCall = append(fake.scheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ScheduleStub
+	fakeReturns := fake.scheduleReturns
+	fake.recordInvocation("Schedule", []interface{}{arg1, arg2})
+	fake.scheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ScheduleCallCount() int {
+	fake.scheduleMutex.RLock()
+	defer fake.scheduleMutex.RUnlock()
+	return len(fake.scheduleArgsForCall)
+}
+
+func (fake *FakeStorage) ScheduleCalls(stub func(context.Context, string) (*service.Schedule, error)) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = stub
+}
+
+func (fake *FakeStorage) ScheduleArgsForCall(i int) (context.Context, string) {
+	fake.scheduleMutex.RLock()
+	defer fake.scheduleMutex.RUnlock()
+	argsForCall := fake.scheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) ScheduleReturns(result1 *service.Schedule, result2 error) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = nil
+	fake.scheduleReturns = struct {
+		result1 *service.Schedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ScheduleReturnsOnCall(i int, result1 *service.Schedule, result2 error) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = nil
+	if fake.scheduleReturnsOnCall == nil {
+		fake.scheduleReturnsOnCall = make(map[int]struct {
+			result1 *service.Schedule
+			result2 error
+		})
+	}
+	fake.scheduleReturnsOnCall[i] = struct {
+		result1 *service.Schedule
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStorage) Task(arg1 context.Context, arg2 string) (*service.Task, error) {
 	fake.taskMutex.Lock()
 	ret, specificReturn := fake.taskReturnsOnCall[len(fake.taskArgsForCall)]
@@ -610,23 +2877,219 @@ func (fake *FakeStorage) TaskListReturnsOnCall(i int, result1 *service.TaskList,
 			result2 error
 		})
 	}
-	fake.taskListReturnsOnCall[i] = struct {
-		result1 *service.TaskList
+	fake.taskListReturnsOnCall[i] = struct {
+		result1 *service.TaskList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) TaskListHistory(arg1 context.Context, arg2 string) (*service.TaskList, error) {
+	fake.taskListHistoryMutex.Lock()
+	ret, specificReturn := fake.taskListHistoryReturnsOnCall[len(fake.taskListHistoryArgsForCall)]
+	fake.taskListHistoryArgsForCall = append(fake.taskListHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TaskListHistoryStub
+	fakeReturns := fake.taskListHistoryReturns
+	fake.recordInvocation("TaskListHistory", []interface{}{arg1, arg2})
+	fake.taskListHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) TaskListHistoryCallCount() int {
+	fake.taskListHistoryMutex.RLock()
+	defer fake.taskListHistoryMutex.RUnlock()
+	return len(fake.taskListHistoryArgsForCall)
+}
+
+func (fake *FakeStorage) TaskListHistoryCalls(stub func(context.Context, string) (*service.TaskList, error)) {
+	fake.taskListHistoryMutex.Lock()
+	defer fake.taskListHistoryMutex.Unlock()
+	fake.TaskListHistoryStub = stub
+}
+
+func (fake *FakeStorage) TaskListHistoryArgsForCall(i int) (context.Context, string) {
+	fake.taskListHistoryMutex.RLock()
+	defer fake.taskListHistoryMutex.RUnlock()
+	argsForCall := fake.taskListHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) TaskListHistoryReturns(result1 *service.TaskList, result2 error) {
+	fake.taskListHistoryMutex.Lock()
+	defer fake.taskListHistoryMutex.Unlock()
+	fake.TaskListHistoryStub = nil
+	fake.taskListHistoryReturns = struct {
+		result1 *service.TaskList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) TaskListHistoryReturnsOnCall(i int, result1 *service.TaskList, result2 error) {
+	fake.taskListHistoryMutex.Lock()
+	defer fake.taskListHistoryMutex.Unlock()
+	fake.TaskListHistoryStub = nil
+	if fake.taskListHistoryReturnsOnCall == nil {
+		fake.taskListHistoryReturnsOnCall = make(map[int]struct {
+			result1 *service.TaskList
+			result2 error
+		})
+	}
+	fake.taskListHistoryReturnsOnCall[i] = struct {
+		result1 *service.TaskList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) TaskListTemplate(arg1 context.Context, arg2 string) (*service.Template, error) {
+	fake.taskListTemplateMutex.Lock()
+	ret, specificReturn := fake.taskListTemplateReturnsOnCall[len(fake.taskListTemplateArgsForCall)]
+	fake.taskListTemplateArgsForCall = append(fake.taskListTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TaskListTemplateStub
+	fakeReturns := fake.taskListTemplateReturns
+	fake.recordInvocation("TaskListTemplate", []interface{}{arg1, arg2})
+	fake.taskListTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) TaskListTemplateCallCount() int {
+	fake.taskListTemplateMutex.RLock()
+	defer fake.taskListTemplateMutex.RUnlock()
+	return len(fake.taskListTemplateArgsForCall)
+}
+
+func (fake *FakeStorage) TaskListTemplateCalls(stub func(context.Context, string) (*service.Template, error)) {
+	fake.taskListTemplateMutex.Lock()
+	defer fake.taskListTemplateMutex.Unlock()
+	fake.TaskListTemplateStub = stub
+}
+
+func (fake *FakeStorage) TaskListTemplateArgsForCall(i int) (context.Context, string) {
+	fake.taskListTemplateMutex.RLock()
+	defer fake.taskListTemplateMutex.RUnlock()
+	argsForCall := fake.taskListTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) TaskListTemplateReturns(result1 *service.Template, result2 error) {
+	fake.taskListTemplateMutex.Lock()
+	defer fake.taskListTemplateMutex.Unlock()
+	fake.TaskListTemplateStub = nil
+	fake.taskListTemplateReturns = struct {
+		result1 *service.Template
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) TaskListTemplateReturnsOnCall(i int, result1 *service.Template, result2 error) {
+	fake.taskListTemplateMutex.Lock()
+	defer fake.taskListTemplateMutex.Unlock()
+	fake.TaskListTemplateStub = nil
+	if fake.taskListTemplateReturnsOnCall == nil {
+		fake.taskListTemplateReturnsOnCall = make(map[int]struct {
+			result1 *service.Template
+			result2 error
+		})
+	}
+	fake.taskListTemplateReturnsOnCall[i] = struct {
+		result1 *service.Template
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) TaskListTemplateVersion(arg1 context.Context, arg2 string, arg3 int) (*service.Template, error) {
+	fake.taskListTemplateVersionMutex.Lock()
+	ret, specificReturn := fake.taskListTemplateVersionReturnsOnCall[len(fake.taskListTemplateVersionArgsForCall)]
+	fake.taskListTemplateVersionArgsForCall = append(fake.taskListTemplateVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.TaskListTemplateVersionStub
+	fakeReturns := fake.taskListTemplateVersionReturns
+	fake.recordInvocation("TaskListTemplateVersion", []interface{}{arg1, arg2, arg3})
+	fake.taskListTemplateVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) TaskListTemplateVersionCallCount() int {
+	fake.taskListTemplateVersionMutex.RLock()
+	defer fake.taskListTemplateVersionMutex.RUnlock()
+	return len(fake.taskListTemplateVersionArgsForCall)
+}
+
+func (fake *FakeStorage) TaskListTemplateVersionCalls(stub func(context.Context, string, int) (*service.Template, error)) {
+	fake.taskListTemplateVersionMutex.Lock()
+	defer fake.taskListTemplateVersionMutex.Unlock()
+	fake.TaskListTemplateVersionStub = stub
+}
+
+func (fake *FakeStorage) TaskListTemplateVersionArgsForCall(i int) (context.Context, string, int) {
+	fake.taskListTemplateVersionMutex.RLock()
+	defer fake.taskListTemplateVersionMutex.RUnlock()
+	argsForCall := fake.taskListTemplateVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) TaskListTemplateVersionReturns(result1 *service.Template, result2 error) {
+	fake.taskListTemplateVersionMutex.Lock()
+	defer fake.taskListTemplateVersionMutex.Unlock()
+	fake.TaskListTemplateVersionStub = nil
+	fake.taskListTemplateVersionReturns = struct {
+		result1 *service.Template
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) TaskListTemplateVersionReturnsOnCall(i int, result1 *service.Template, result2 error) {
+	fake.taskListTemplateVersionMutex.Lock()
+	defer fake.taskListTemplateVersionMutex.Unlock()
+	fake.TaskListTemplateVersionStub = nil
+	if fake.taskListTemplateVersionReturnsOnCall == nil {
+		fake.taskListTemplateVersionReturnsOnCall = make(map[int]struct {
+			result1 *service.Template
+			result2 error
+		})
+	}
+	fake.taskListTemplateVersionReturnsOnCall[i] = struct {
+		result1 *service.Template
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStorage) TaskListHistory(arg1 context.Context, arg2 string) (*service.TaskList, error) {
-	fake.taskListHistoryMutex.Lock()
-	ret, specificReturn := fake.taskListHistoryReturnsOnCall[len(fake.taskListHistoryArgsForCall)]
-	fake.taskListHistoryArgsForCall = append(fake.taskListHistoryArgsForCall, struct {
+func (fake *FakeStorage) TaskListTemplatesByTask(arg1 context.Context, arg2 string) ([]*service.Template, error) {
+	fake.taskListTemplatesByTaskMutex.Lock()
+	ret, specificReturn := fake.taskListTemplatesByTaskReturnsOnCall[len(fake.taskListTemplatesByTaskArgsForCall)]
+	fake.taskListTemplatesByTaskArgsForCall = append(fake.taskListTemplatesByTaskArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.TaskListHistoryStub
-	fakeReturns := fake.taskListHistoryReturns
-	fake.recordInvocation("TaskListHistory", []interface{}{arg1, arg2})
-	fake.taskListHistoryMutex.Unlock()
+	stub := fake.TaskListTemplatesByTaskStub
+	fakeReturns := fake.taskListTemplatesByTaskReturns
+	fake.recordInvocation("TaskListTemplatesByTask", []interface{}{arg1, arg2})
+	fake.taskListTemplatesByTaskMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -636,62 +3099,62 @@ func (fake *FakeStorage) TaskListHistory(arg1 context.Context, arg2 string) (*se
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStorage) TaskListHistoryCallCount() int {
-	fake.taskListHistoryMutex.RLock()
-	defer fake.taskListHistoryMutex.RUnlock()
-	return len(fake.taskListHistoryArgsForCall)
+func (fake *FakeStorage) TaskListTemplatesByTaskCallCount() int {
+	fake.taskListTemplatesByTaskMutex.RLock()
+	defer fake.taskListTemplatesByTaskMutex.RUnlock()
+	return len(fake.taskListTemplatesByTaskArgsForCall)
 }
 
-func (fake *FakeStorage) TaskListHistoryCalls(stub func(context.Context, string) (*service.TaskList, error)) {
-	fake.taskListHistoryMutex.Lock()
-	defer fake.taskListHistoryMutex.Unlock()
-	fake.TaskListHistoryStub = stub
+func (fake *FakeStorage) TaskListTemplatesByTaskCalls(stub func(context.Context, string) ([]*service.Template, error)) {
+	fake.taskListTemplatesByTaskMutex.Lock()
+	defer fake.taskListTemplatesByTaskMutex.Unlock()
+	fake.TaskListTemplatesByTaskStub = stub
 }
 
-func (fake *FakeStorage) TaskListHistoryArgsForCall(i int) (context.Context, string) {
-	fake.taskListHistoryMutex.RLock()
-	defer fake.taskListHistoryMutex.RUnlock()
-	argsForCall := fake.taskListHistoryArgsForCall[i]
+func (fake *FakeStorage) TaskListTemplatesByTaskArgsForCall(i int) (context.Context, string) {
+	fake.taskListTemplatesByTaskMutex.RLock()
+	defer fake.taskListTemplatesByTaskMutex.RUnlock()
+	argsForCall := fake.taskListTemplatesByTaskArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStorage) TaskListHistoryReturns(result1 *service.TaskList, result2 error) {
-	fake.taskListHistoryMutex.Lock()
-	defer fake.taskListHistoryMutex.Unlock()
-	fake.TaskListHistoryStub = nil
-	fake.taskListHistoryReturns = struct {
-		result1 *service.TaskList
+func (fake *FakeStorage) TaskListTemplatesByTaskReturns(result1 []*service.Template, result2 error) {
+	fake.taskListTemplatesByTaskMutex.Lock()
+	defer fake.taskListTemplatesByTaskMutex.Unlock()
+	fake.TaskListTemplatesByTaskStub = nil
+	fake.taskListTemplatesByTaskReturns = struct {
+		result1 []*service.Template
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStorage) TaskListHistoryReturnsOnCall(i int, result1 *service.TaskList, result2 error) {
-	fake.taskListHistoryMutex.Lock()
-	defer fake.taskListHistoryMutex.Unlock()
-	fake.TaskListHistoryStub = nil
-	if fake.taskListHistoryReturnsOnCall == nil {
-		fake.taskListHistoryReturnsOnCall = make(map[int]struct {
-			result1 *service.TaskList
+func (fake *FakeStorage) TaskListTemplatesByTaskReturnsOnCall(i int, result1 []*service.Template, result2 error) {
+	fake.taskListTemplatesByTaskMutex.Lock()
+	defer fake.taskListTemplatesByTaskMutex.Unlock()
+	fake.TaskListTemplatesByTaskStub = nil
+	if fake.taskListTemplatesByTaskReturnsOnCall == nil {
+		fake.taskListTemplatesByTaskReturnsOnCall = make(map[int]struct {
+			result1 []*service.Template
 			result2 error
 		})
 	}
-	fake.taskListHistoryReturnsOnCall[i] = struct {
-		result1 *service.TaskList
+	fake.taskListTemplatesByTaskReturnsOnCall[i] = struct {
+		result1 []*service.Template
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStorage) TaskListTemplate(arg1 context.Context, arg2 string) (*service.Template, error) {
-	fake.taskListTemplateMutex.Lock()
-	ret, specificReturn := fake.taskListTemplateReturnsOnCall[len(fake.taskListTemplateArgsForCall)]
-	fake.taskListTemplateArgsForCall = append(fake.taskListTemplateArgsForCall, struct {
+func (fake *FakeStorage) TaskLists(arg1 context.Context, arg2 *service.Filter) ([]*service.TaskList, error) {
+	fake.taskListsMutex.Lock()
+	ret, specificReturn := fake.taskListsReturnsOnCall[len(fake.taskListsArgsForCall)]
+	fake.taskListsArgsForCall = append(fake.taskListsArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
+		arg2 *service.Filter
 	}{arg1, arg2})
-	stub := fake.TaskListTemplateStub
-	fakeReturns := fake.taskListTemplateReturns
-	fake.recordInvocation("TaskListTemplate", []interface{}{arg1, arg2})
-	fake.taskListTemplateMutex.Unlock()
+	stub := fake.TaskListsStub
+	fakeReturns := fake.taskListsReturns
+	fake.recordInvocation("TaskLists", []interface{}{arg1, arg2})
+	fake.taskListsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -701,47 +3164,47 @@ func (fake *FakeStorage) TaskListTemplate(arg1 context.Context, arg2 string) (*s
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStorage) TaskListTemplateCallCount() int {
-	fake.taskListTemplateMutex.RLock()
-	defer fake.taskListTemplateMutex.RUnlock()
-	return len(fake.taskListTemplateArgsForCall)
+func (fake *FakeStorage) TaskListsCallCount() int {
+	fake.taskListsMutex.RLock()
+	defer fake.taskListsMutex.RUnlock()
+	return len(fake.taskListsArgsForCall)
 }
 
-func (fake *FakeStorage) TaskListTemplateCalls(stub func(context.Context, string) (*service.Template, error)) {
-	fake.taskListTemplateMutex.Lock()
-	defer fake.taskListTemplateMutex.Unlock()
-	fake.TaskListTemplateStub = stub
+func (fake *FakeStorage) TaskListsCalls(stub func(context.Context, *service.Filter) ([]*service.TaskList, error)) {
+	fake.taskListsMutex.Lock()
+	defer fake.taskListsMutex.Unlock()
+	fake.TaskListsStub = stub
 }
 
-func (fake *FakeStorage) TaskListTemplateArgsForCall(i int) (context.Context, string) {
-	fake.taskListTemplateMutex.RLock()
-	defer fake.taskListTemplateMutex.RUnlock()
-	argsForCall := fake.taskListTemplateArgsForCall[i]
+func (fake *FakeStorage) TaskListsArgsForCall(i int) (context.Context, *service.Filter) {
+	fake.taskListsMutex.RLock()
+	defer fake.taskListsMutex.RUnlock()
+	argsForCall := fake.taskListsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStorage) TaskListTemplateReturns(result1 *service.Template, result2 error) {
-	fake.taskListTemplateMutex.Lock()
-	defer fake.taskListTemplateMutex.Unlock()
-	fake.TaskListTemplateStub = nil
-	fake.taskListTemplateReturns = struct {
-		result1 *service.Template
+func (fake *FakeStorage) TaskListsReturns(result1 []*service.TaskList, result2 error) {
+	fake.taskListsMutex.Lock()
+	defer fake.taskListsMutex.Unlock()
+	fake.TaskListsStub = nil
+	fake.taskListsReturns = struct {
+		result1 []*service.TaskList
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStorage) TaskListTemplateReturnsOnCall(i int, result1 *service.Template, result2 error) {
-	fake.taskListTemplateMutex.Lock()
-	defer fake.taskListTemplateMutex.Unlock()
-	fake.TaskListTemplateStub = nil
-	if fake.taskListTemplateReturnsOnCall == nil {
-		fake.taskListTemplateReturnsOnCall = make(map[int]struct {
-			result1 *service.Template
+func (fake *FakeStorage) TaskListsReturnsOnCall(i int, result1 []*service.TaskList, result2 error) {
+	fake.taskListsMutex.Lock()
+	defer fake.taskListsMutex.Unlock()
+	fake.TaskListsStub = nil
+	if fake.taskListsReturnsOnCall == nil {
+		fake.taskListsReturnsOnCall = make(map[int]struct {
+			result1 []*service.TaskList
 			result2 error
 		})
 	}
-	fake.taskListTemplateReturnsOnCall[i] = struct {
-		result1 *service.Template
+	fake.taskListsReturnsOnCall[i] = struct {
+		result1 []*service.TaskList
 		result2 error
 	}{result1, result2}
 }
@@ -811,6 +3274,72 @@ func (fake *FakeStorage) TaskTemplateReturnsOnCall(i int, result1 *service.Task,
 	}{result1, result2}
 }
 
+func (fake *FakeStorage) TaskTemplateVersion(arg1 context.Context, arg2 string, arg3 int) (*service.Task, error) {
+	fake.taskTemplateVersionMutex.Lock()
+	ret, specificReturn := fake.taskTemplateVersionReturnsOnCall[len(fake.taskTemplateVersionArgsForCall)]
+	fake.taskTemplateVersionArgsForCall = append(fake.taskTemplateVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.TaskTemplateVersionStub
+	fakeReturns := fake.taskTemplateVersionReturns
+	fake.recordInvocation("TaskTemplateVersion", []interface{}{arg1, arg2, arg3})
+	fake.taskTemplateVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) TaskTemplateVersionCallCount() int {
+	fake.taskTemplateVersionMutex.RLock()
+	defer fake.taskTemplateVersionMutex.RUnlock()
+	return len(fake.taskTemplateVersionArgsForCall)
+}
+
+func (fake *FakeStorage) TaskTemplateVersionCalls(stub func(context.Context, string, int) (*service.Task, error)) {
+	fake.taskTemplateVersionMutex.Lock()
+	defer fake.taskTemplateVersionMutex.Unlock()
+	fake.TaskTemplateVersionStub = stub
+}
+
+func (fake *FakeStorage) TaskTemplateVersionArgsForCall(i int) (context.Context, string, int) {
+	fake.taskTemplateVersionMutex.RLock()
+	defer fake.taskTemplateVersionMutex.RUnlock()
+	argsForCall := fake.taskTemplateVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) TaskTemplateVersionReturns(result1 *service.Task, result2 error) {
+	fake.taskTemplateVersionMutex.Lock()
+	defer fake.taskTemplateVersionMutex.Unlock()
+	fake.TaskTemplateVersionStub = nil
+	fake.taskTemplateVersionReturns = struct {
+		result1 *service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) TaskTemplateVersionReturnsOnCall(i int, result1 *service.Task, result2 error) {
+	fake.taskTemplateVersionMutex.Lock()
+	defer fake.taskTemplateVersionMutex.Unlock()
+	fake.TaskTemplateVersionStub = nil
+	if fake.taskTemplateVersionReturnsOnCall == nil {
+		fake.taskTemplateVersionReturnsOnCall = make(map[int]struct {
+			result1 *service.Task
+			result2 error
+		})
+	}
+	fake.taskTemplateVersionReturnsOnCall[i] = struct {
+		result1 *service.Task
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStorage) TaskTemplates(arg1 context.Context, arg2 []string) (map[string]*service.Task, error) {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -881,17 +3410,389 @@ func (fake *FakeStorage) TaskTemplatesReturnsOnCall(i int, result1 map[string]*s
 	}{result1, result2}
 }
 
+func (fake *FakeStorage) Tasks(arg1 context.Context, arg2 *service.Filter) ([]*service.Task, error) {
+	fake.tasksMutex.Lock()
+	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
+	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.Filter
+	}{arg1, arg2})
+	stub := fake.TasksStub
+	fakeReturns := fake.tasksReturns
+	fake.recordInvocation("Tasks", []interface{}{arg1, arg2})
+	fake.tasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) TasksCallCount() int {
+	fake.tasksMutex.RLock()
+	defer fake.tasksMutex.RUnlock()
+	return len(fake.tasksArgsForCall)
+}
+
+func (fake *FakeStorage) TasksCalls(stub func(context.Context, *service.Filter) ([]*service.Task, error)) {
+	fake.tasksMutex.Lock()
+	defer fake.tasksMutex.Unlock()
+	fake.TasksStub = stub
+}
+
+func (fake *FakeStorage) TasksArgsForCall(i int) (context.Context, *service.Filter) {
+	fake.tasksMutex.RLock()
+	defer fake.tasksMutex.RUnlock()
+	argsForCall := fake.tasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) TasksReturns(result1 []*service.Task, result2 error) {
+	fake.tasksMutex.Lock()
+	defer fake.tasksMutex.Unlock()
+	fake.TasksStub = nil
+	fake.tasksReturns = struct {
+		result1 []*service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) TasksReturnsOnCall(i int, result1 []*service.Task, result2 error) {
+	fake.tasksMutex.Lock()
+	defer fake.tasksMutex.Unlock()
+	fake.TasksStub = nil
+	if fake.tasksReturnsOnCall == nil {
+		fake.tasksReturnsOnCall = make(map[int]struct {
+			result1 []*service.Task
+			result2 error
+		})
+	}
+	fake.tasksReturnsOnCall[i] = struct {
+		result1 []*service.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) UpdateTaskListTemplate(arg1 context.Context, arg2 *service.Template) error {
+	fake.updateTaskListTemplateMutex.Lock()
+	ret, specificReturn := fake.updateTaskListTemplateReturnsOnCall[len(fake.updateTaskListTemplateArgsForCall)]
+	fake.updateTaskListTemplateArgsForCall = append(fake.updateTaskListTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.Template
+	}{arg1, arg2})
+	stub := fake.UpdateTaskListTemplateStub
+	fakeReturns := fake.updateTaskListTemplateReturns
+	fake.recordInvocation("UpdateTaskListTemplate", []interface{}{arg1, arg2})
+	fake.updateTaskListTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) UpdateTaskListTemplateCallCount() int {
+	fake.updateTaskListTemplateMutex.RLock()
+	defer fake.updateTaskListTemplateMutex.RUnlock()
+	return len(fake.updateTaskListTemplateArgsForCall)
+}
+
+func (fake *FakeStorage) UpdateTaskListTemplateCalls(stub func(context.Context, *service.Template) error) {
+	fake.updateTaskListTemplateMutex.Lock()
+	defer fake.updateTaskListTemplateMutex.Unlock()
+	fake.UpdateTaskListTemplateStub = stub
+}
+
+func (fake *FakeStorage) UpdateTaskListTemplateArgsForCall(i int) (context.Context, *service.Template) {
+	fake.updateTaskListTemplateMutex.RLock()
+	defer fake.updateTaskListTemplateMutex.RUnlock()
+	argsForCall := fake.updateTaskListTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) UpdateTaskListTemplateReturns(result1 error) {
+	fake.updateTaskListTemplateMutex.Lock()
+	defer fake.updateTaskListTemplateMutex.Unlock()
+	fake.UpdateTaskListTemplateStub = nil
+	fake.updateTaskListTemplateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateTaskListTemplateReturnsOnCall(i int, result1 error) {
+	fake.updateTaskListTemplateMutex.Lock()
+	defer fake.updateTaskListTemplateMutex.Unlock()
+	fake.UpdateTaskListTemplateStub = nil
+	if fake.updateTaskListTemplateReturnsOnCall == nil {
+		fake.updateTaskListTemplateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateTaskListTemplateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateTaskTemplate(arg1 context.Context, arg2 *service.Task) error {
+	fake.updateTaskTemplateMutex.Lock()
+	ret, specificReturn := fake.updateTaskTemplateReturnsOnCall[len(fake.updateTaskTemplateArgsForCall)]
+	fake.updateTaskTemplateArgsForCall = append(fake.updateTaskTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.Task
+	}{arg1, arg2})
+	stub := fake.UpdateTaskTemplateStub
+	fakeReturns := fake.updateTaskTemplateReturns
+	fake.recordInvocation("UpdateTaskTemplate", []interface{}{arg1, arg2})
+	fake.updateTaskTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) UpdateTaskTemplateCallCount() int {
+	fake.updateTaskTemplateMutex.RLock()
+	defer fake.updateTaskTemplateMutex.RUnlock()
+	return len(fake.updateTaskTemplateArgsForCall)
+}
+
+func (fake *FakeStorage) UpdateTaskTemplateCalls(stub func(context.Context, *service.Task) error) {
+	fake.updateTaskTemplateMutex.Lock()
+	defer fake.updateTaskTemplateMutex.Unlock()
+	fake.UpdateTaskTemplateStub = stub
+}
+
+func (fake *FakeStorage) UpdateTaskTemplateArgsForCall(i int) (context.Context, *service.Task) {
+	fake.updateTaskTemplateMutex.RLock()
+	defer fake.updateTaskTemplateMutex.RUnlock()
+	argsForCall := fake.updateTaskTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) UpdateTaskTemplateReturns(result1 error) {
+	fake.updateTaskTemplateMutex.Lock()
+	defer fake.updateTaskTemplateMutex.Unlock()
+	fake.UpdateTaskTemplateStub = nil
+	fake.updateTaskTemplateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateTaskTemplateReturnsOnCall(i int, result1 error) {
+	fake.updateTaskTemplateMutex.Lock()
+	defer fake.updateTaskTemplateMutex.Unlock()
+	fake.UpdateTaskTemplateStub = nil
+	if fake.updateTaskTemplateReturnsOnCall == nil {
+		fake.updateTaskTemplateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateTaskTemplateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateWebhookDelivery(arg1 context.Context, arg2 *service.WebhookDelivery) error {
+	fake.updateWebhookDeliveryMutex.Lock()
+	ret, specificReturn := fake.updateWebhookDeliveryReturnsOnCall[len(fake.updateWebhookDeliveryArgsForCall)]
+	fake.updateWebhookDeliveryArgsForCall = append(fake.updateWebhookDeliveryArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.WebhookDelivery
+	}{arg1, arg2})
+	stub := fake.UpdateWebhookDeliveryStub
+	fakeReturns := fake.updateWebhookDeliveryReturns
+	fake.recordInvocation("UpdateWebhookDelivery", []interface{}{arg1, arg2})
+	fake.updateWebhookDeliveryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) UpdateWebhookDeliveryCallCount() int {
+	fake.updateWebhookDeliveryMutex.RLock()
+	defer fake.updateWebhookDeliveryMutex.RUnlock()
+	return len(fake.updateWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeStorage) UpdateWebhookDeliveryCalls(stub func(context.Context, *service.WebhookDelivery) error) {
+	fake.updateWebhookDeliveryMutex.Lock()
+	defer fake.updateWebhookDeliveryMutex.Unlock()
+	fake.UpdateWebhookDeliveryStub = stub
+}
+
+func (fake *FakeStorage) UpdateWebhookDeliveryArgsForCall(i int) (context.Context, *service.WebhookDelivery) {
+	fake.updateWebhookDeliveryMutex.RLock()
+	defer fake.updateWebhookDeliveryMutex.RUnlock()
+	argsForCall := fake.updateWebhookDeliveryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) UpdateWebhookDeliveryReturns(result1 error) {
+	fake.updateWebhookDeliveryMutex.Lock()
+	defer fake.updateWebhookDeliveryMutex.Unlock()
+	fake.UpdateWebhookDeliveryStub = nil
+	fake.updateWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateWebhookDeliveryReturnsOnCall(i int, result1 error) {
+	fake.updateWebhookDeliveryMutex.Lock()
+	defer fake.updateWebhookDeliveryMutex.Unlock()
+	fake.UpdateWebhookDeliveryStub = nil
+	if fake.updateWebhookDeliveryReturnsOnCall == nil {
+		fake.updateWebhookDeliveryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateWebhookDeliveryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) WebhookDeliveries(arg1 context.Context, arg2 string) ([]*service.WebhookDelivery, error) {
+	fake.webhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.webhookDeliveriesReturnsOnCall[len(fake.webhookDeliveriesArgsForCall)]
+	fake.webhookDeliveriesArgsForCall = append(fake.webhookDeliveriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.WebhookDeliveriesStub
+	fakeReturns := fake.webhookDeliveriesReturns
+	fake.recordInvocation("WebhookDeliveries", []interface{}{arg1, arg2})
+	fake.webhookDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) WebhookDeliveriesCallCount() int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	return len(fake.webhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeStorage) WebhookDeliveriesCalls(stub func(context.Context, string) ([]*service.WebhookDelivery, error)) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = stub
+}
+
+func (fake *FakeStorage) WebhookDeliveriesArgsForCall(i int) (context.Context, string) {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.webhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) WebhookDeliveriesReturns(result1 []*service.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	fake.webhookDeliveriesReturns = struct {
+		result1 []*service.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) WebhookDeliveriesReturnsOnCall(i int, result1 []*service.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	if fake.webhookDeliveriesReturnsOnCall == nil {
+		fake.webhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []*service.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.webhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []*service.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStorage) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addDeadLetterMutex.RLock()
+	defer fake.addDeadLetterMutex.RUnlock()
+	fake.addEventTaskMutex.RLock()
+	defer fake.addEventTaskMutex.RUnlock()
+	fake.addIdempotencyKeyMutex.RLock()
+	defer fake.addIdempotencyKeyMutex.RUnlock()
+	fake.addScheduleMutex.RLock()
+	defer fake.addScheduleMutex.RUnlock()
+	fake.addTaskListTemplateMutex.RLock()
+	defer fake.addTaskListTemplateMutex.RUnlock()
+	fake.addTaskTemplateMutex.RLock()
+	defer fake.addTaskTemplateMutex.RUnlock()
+	fake.addWebhookDeliveryMutex.RLock()
+	defer fake.addWebhookDeliveryMutex.RUnlock()
+	fake.claimPendingRunMutex.RLock()
+	defer fake.claimPendingRunMutex.RUnlock()
+	fake.claimScheduleMutex.RLock()
+	defer fake.claimScheduleMutex.RUnlock()
+	fake.completeScheduleRunMutex.RLock()
+	defer fake.completeScheduleRunMutex.RUnlock()
+	fake.deadLetterMutex.RLock()
+	defer fake.deadLetterMutex.RUnlock()
+	fake.deadLettersMutex.RLock()
+	defer fake.deadLettersMutex.RUnlock()
+	fake.deleteDeadLetterMutex.RLock()
+	defer fake.deleteDeadLetterMutex.RUnlock()
+	fake.deleteEventTaskMutex.RLock()
+	defer fake.deleteEventTaskMutex.RUnlock()
+	fake.deleteIdempotencyKeyMutex.RLock()
+	defer fake.deleteIdempotencyKeyMutex.RUnlock()
+	fake.deleteScheduleMutex.RLock()
+	defer fake.deleteScheduleMutex.RUnlock()
+	fake.deleteTaskListTemplateMutex.RLock()
+	defer fake.deleteTaskListTemplateMutex.RUnlock()
+	fake.deleteTaskTemplateMutex.RLock()
+	defer fake.deleteTaskTemplateMutex.RUnlock()
+	fake.discardScheduleRunMutex.RLock()
+	defer fake.discardScheduleRunMutex.RUnlock()
+	fake.dueSchedulesMutex.RLock()
+	defer fake.dueSchedulesMutex.RUnlock()
 	fake.eventTaskMutex.RLock()
 	defer fake.eventTaskMutex.RUnlock()
 	fake.getGroupTasksMutex.RLock()
 	defer fake.getGroupTasksMutex.RUnlock()
+	fake.idempotencyKeyMutex.RLock()
+	defer fake.idempotencyKeyMutex.RUnlock()
+	fake.listEventTasksMutex.RLock()
+	defer fake.listEventTasksMutex.RUnlock()
+	fake.listSchedulesMutex.RLock()
+	defer fake.listSchedulesMutex.RUnlock()
+	fake.listTaskListTemplatesMutex.RLock()
+	defer fake.listTaskListTemplatesMutex.RUnlock()
+	fake.listTaskTemplatesMutex.RLock()
+	defer fake.listTaskTemplatesMutex.RUnlock()
+	fake.pollWebhookDeliveryMutex.RLock()
+	defer fake.pollWebhookDeliveryMutex.RUnlock()
+	fake.purgeDeadLettersMutex.RLock()
+	defer fake.purgeDeadLettersMutex.RUnlock()
 	fake.saveTaskHistoryMutex.RLock()
 	defer fake.saveTaskHistoryMutex.RUnlock()
 	fake.saveTaskListHistoryMutex.RLock()
 	defer fake.saveTaskListHistoryMutex.RUnlock()
+	fake.scheduleMutex.RLock()
+	defer fake.scheduleMutex.RUnlock()
 	fake.taskMutex.RLock()
 	defer fake.taskMutex.RUnlock()
 	fake.taskHistoryMutex.RLock()
@@ -902,10 +3803,28 @@ func (fake *FakeStorage) Invocations() map[string][][]interface{} {
 	defer fake.taskListHistoryMutex.RUnlock()
 	fake.taskListTemplateMutex.RLock()
 	defer fake.taskListTemplateMutex.RUnlock()
+	fake.taskListTemplateVersionMutex.RLock()
+	defer fake.taskListTemplateVersionMutex.RUnlock()
+	fake.taskListTemplatesByTaskMutex.RLock()
+	defer fake.taskListTemplatesByTaskMutex.RUnlock()
+	fake.taskListsMutex.RLock()
+	defer fake.taskListsMutex.RUnlock()
 	fake.taskTemplateMutex.RLock()
 	defer fake.taskTemplateMutex.RUnlock()
+	fake.taskTemplateVersionMutex.RLock()
+	defer fake.taskTemplateVersionMutex.RUnlock()
 	fake.taskTemplatesMutex.RLock()
 	defer fake.taskTemplatesMutex.RUnlock()
+	fake.tasksMutex.RLock()
+	defer fake.tasksMutex.RUnlock()
+	fake.updateTaskListTemplateMutex.RLock()
+	defer fake.updateTaskListTemplateMutex.RUnlock()
+	fake.updateTaskTemplateMutex.RLock()
+	defer fake.updateTaskTemplateMutex.RUnlock()
+	fake.updateWebhookDeliveryMutex.RLock()
+	defer fake.updateWebhookDeliveryMutex.RUnlock()
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
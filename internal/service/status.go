@@ -0,0 +1,85 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// MaxFailedResponseSize is the maximum size of the response body
+// which is kept for diagnosis when a task fails.
+const MaxFailedResponseSize = 64 << 10
+
+// StatusAccepted reports whether the HTTP status code matches one of the accepted
+// status codes. A status code is either a number (e.g. "404") or a class of status
+// codes (e.g. "2xx"). If there are no accepted status codes, 2xx codes are accepted.
+func StatusAccepted(accepted []string, status int) bool {
+	if len(accepted) == 0 {
+		return status >= 200 && status <= 299
+	}
+
+	code := strconv.Itoa(status)
+	for _, a := range accepted {
+		if a == code || (strings.HasSuffix(a, "xx") && len(code) == 3 && a[0] == code[0]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateStatusCodes checks that the accepted status codes are valid
+// HTTP status codes or classes of status codes (1xx to 5xx).
+func ValidateStatusCodes(codes []string) error {
+	for _, code := range codes {
+		if len(code) == 3 && code[1:] == "xx" && code[0] >= '1' && code[0] <= '5' {
+			continue
+		}
+		if n, err := strconv.Atoi(code); err == nil && n >= 100 && n <= 599 {
+			continue
+		}
+		return errors.New(errors.BadRequest, "invalid status code: "+code)
+	}
+	return nil
+}
+
+// StatusError returns the error of an HTTP task which response status code is not
+// accepted. Server errors, 408 (Request Timeout) and 429 (Too Many Requests) are
+// temporary and can be retried, not earlier than the time specified by the Retry-After
+// header. Other status codes are permanent failures, because retrying the same request
+// will not change them.
+func StatusError(status int, header http.Header) *ExecutionError {
+	e := &ExecutionError{
+		Reason: ReasonStatus,
+		Err:    errors.New(fmt.Sprintf("unexpected response status code: %d", status)),
+	}
+
+	if status >= 500 || status == http.StatusRequestTimeout || status == http.StatusTooManyRequests {
+		e.RetryAfter = retryAfter(header.Get("Retry-After"), time.Now())
+	} else {
+		e.Permanent = true
+	}
+
+	return e
+}
+
+// retryAfter parses the value of a Retry-After header, which
+// is either a number of seconds or an HTTP date.
+func retryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusAccepted(t *testing.T) {
+	tests := []struct {
+		name     string
+		accepted []string
+		status   int
+
+		result bool
+	}{
+		{
+			name:   "2xx is accepted by default",
+			status: http.StatusNoContent,
+			result: true,
+		},
+		{
+			name:   "3xx is not accepted by default",
+			status: http.StatusFound,
+		},
+		{
+			name:   "4xx is not accepted by default",
+			status: http.StatusNotFound,
+		},
+		{
+			name:     "accepted status code",
+			accepted: []string{"200", "404"},
+			status:   http.StatusNotFound,
+			result:   true,
+		},
+		{
+			name:     "accepted class of status codes",
+			accepted: []string{"2xx", "3xx"},
+			status:   http.StatusMovedPermanently,
+			result:   true,
+		},
+		{
+			name:     "status code which is not accepted",
+			accepted: []string{"201", "4xx"},
+			status:   http.StatusOK,
+		},
+		{
+			name:     "5xx is not accepted by other classes",
+			accepted: []string{"2xx", "4xx"},
+			status:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.result, StatusAccepted(test.accepted, test.status))
+		})
+	}
+}
+
+func TestValidateStatusCodes(t *testing.T) {
+	tests := []struct {
+		name  string
+		codes []string
+
+		errtext string
+	}{
+		{
+			name: "no status codes",
+		},
+		{
+			name:  "valid status codes and classes",
+			codes: []string{"100", "200", "404", "599", "1xx", "2xx", "5xx"},
+		},
+		{
+			name:    "status code out of range",
+			codes:   []string{"200", "600"},
+			errtext: "invalid status code: 600",
+		},
+		{
+			name:    "status code below range",
+			codes:   []string{"99"},
+			errtext: "invalid status code: 99",
+		},
+		{
+			name:    "unknown class",
+			codes:   []string{"6xx"},
+			errtext: "invalid status code: 6xx",
+		},
+		{
+			name:    "invalid class",
+			codes:   []string{"2x"},
+			errtext: "invalid status code: 2x",
+		},
+		{
+			name:    "not a number",
+			codes:   []string{"ok"},
+			errtext: "invalid status code: ok",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateStatusCodes(test.codes)
+			if test.errtext != "" {
+				assert.ErrorContains(t, err, test.errtext)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		header http.Header
+
+		permanent  bool
+		retryAfter time.Duration
+	}{
+		{
+			name:   "server error is temporary",
+			status: http.StatusInternalServerError,
+		},
+		{
+			name:       "service unavailable is retried after the requested time",
+			status:     http.StatusServiceUnavailable,
+			header:     http.Header{"Retry-After": []string{"120"}},
+			retryAfter: 2 * time.Minute,
+		},
+		{
+			name:       "too many requests is retried after the requested time",
+			status:     http.StatusTooManyRequests,
+			header:     http.Header{"Retry-After": []string{"30"}},
+			retryAfter: 30 * time.Second,
+		},
+		{
+			name:   "request timeout is temporary",
+			status: http.StatusRequestTimeout,
+		},
+		{
+			name:      "client error is permanent",
+			status:    http.StatusBadRequest,
+			permanent: true,
+		},
+		{
+			name:      "Retry-After of a permanent failure is ignored",
+			status:    http.StatusNotFound,
+			header:    http.Header{"Retry-After": []string{"30"}},
+			permanent: true,
+		},
+		{
+			name:      "redirect is permanent",
+			status:    http.StatusFound,
+			permanent: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := test.header
+			if header == nil {
+				header = http.Header{}
+			}
+
+			err := StatusError(test.status, header)
+			assert.Equal(t, ReasonStatus, err.Reason)
+			assert.Equal(t, test.permanent, err.Permanent)
+			assert.Equal(t, test.retryAfter, err.RetryAfter)
+			assert.Contains(t, err.Error(), "unexpected response status code")
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+
+		result time.Duration
+	}{
+		{
+			name: "no header",
+		},
+		{
+			name:   "seconds",
+			value:  "90",
+			result: 90 * time.Second,
+		},
+		{
+			name:  "zero seconds",
+			value: "0",
+		},
+		{
+			name:  "negative seconds",
+			value: "-10",
+		},
+		{
+			name:   "HTTP date",
+			value:  "Sun, 01 Jan 2023 10:05:00 GMT",
+			result: 5 * time.Minute,
+		},
+		{
+			name:  "HTTP date in the past",
+			value: "Sun, 01 Jan 2023 09:55:00 GMT",
+		},
+		{
+			name:  "invalid value",
+			value: "tomorrow",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.result, retryAfter(test.value, now))
+		})
+	}
+}
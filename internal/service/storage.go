@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 )
 
 //go:generate counterfeiter . Storage
@@ -10,17 +11,66 @@ type Storage interface {
 	// Task related methods
 	Task(ctx context.Context, taskID string) (*Task, error)
 	TaskTemplate(ctx context.Context, taskName string) (*Task, error)
+	TaskTemplateVersion(ctx context.Context, taskName string, version int) (*Task, error)
 	TaskHistory(ctx context.Context, taskID string) (*Task, error)
 	SaveTaskHistory(ctx context.Context, task *Task) error
+	Tasks(ctx context.Context, filter *Filter) ([]*Task, error)
+
+	// TaskTemplate management methods
+	AddTaskTemplate(ctx context.Context, template *Task) error
+	UpdateTaskTemplate(ctx context.Context, template *Task) error
+	DeleteTaskTemplate(ctx context.Context, taskName string) error
+	ListTaskTemplates(ctx context.Context) ([]*Task, error)
 
 	// TaskList related methods
 	TaskList(ctx context.Context, taskListID string) (*TaskList, error)
 	TaskListTemplate(ctx context.Context, taskListName string) (*Template, error)
+	TaskListTemplateVersion(ctx context.Context, taskListName string, version int) (*Template, error)
 	TaskTemplates(ctx context.Context, names []string) (map[string]*Task, error)
 	TaskListHistory(ctx context.Context, taskListID string) (*TaskList, error)
 	GetGroupTasks(ctx context.Context, group *Group) ([]*Task, error)
 	SaveTaskListHistory(ctx context.Context, task *TaskList) error
+	TaskLists(ctx context.Context, filter *Filter) ([]*TaskList, error)
+
+	// TaskListTemplate management methods
+	AddTaskListTemplate(ctx context.Context, template *Template) error
+	UpdateTaskListTemplate(ctx context.Context, template *Template) error
+	DeleteTaskListTemplate(ctx context.Context, taskListName string) error
+	ListTaskListTemplates(ctx context.Context) ([]*Template, error)
+	TaskListTemplatesByTask(ctx context.Context, taskName string) ([]*Template, error)
 
 	// EventTask related methods
 	EventTask(ctx context.Context, key, namespace, scope string) (*EventTask, error)
+	AddEventTask(ctx context.Context, eventTask *EventTask) error
+	DeleteEventTask(ctx context.Context, key, namespace, scope string) error
+	ListEventTasks(ctx context.Context) ([]*EventTask, error)
+
+	// Schedule related methods
+	AddSchedule(ctx context.Context, schedule *Schedule) error
+	Schedule(ctx context.Context, scheduleID string) (*Schedule, error)
+	DeleteSchedule(ctx context.Context, scheduleID string) error
+	ListSchedules(ctx context.Context) ([]*Schedule, error)
+	DueSchedules(ctx context.Context, now time.Time) ([]*Schedule, error)
+	ClaimSchedule(ctx context.Context, scheduleID string, nextRunAt time.Time, run *ScheduleRun) error
+	ClaimPendingRun(ctx context.Context, scheduleID string, run *ScheduleRun, claimedAt time.Time) error
+	CompleteScheduleRun(ctx context.Context, scheduleID string, run *ScheduleRun) error
+	DiscardScheduleRun(ctx context.Context, scheduleID string, runID string) error
+
+	// Idempotency key related methods
+	AddIdempotencyKey(ctx context.Context, key *IdempotencyKey) error
+	IdempotencyKey(ctx context.Context, key string) (*IdempotencyKey, error)
+	DeleteIdempotencyKey(ctx context.Context, key string) error
+
+	// Webhook related methods
+	AddWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error
+	PollWebhookDelivery(ctx context.Context, lease time.Duration) (*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error
+	WebhookDeliveries(ctx context.Context, resourceID string) ([]*WebhookDelivery, error)
+
+	// Dead letter related methods
+	AddDeadLetter(ctx context.Context, deadLetter *DeadLetter) error
+	DeadLetters(ctx context.Context, taskName string, limit int) ([]*DeadLetter, error)
+	DeadLetter(ctx context.Context, taskID string) (*DeadLetter, error)
+	DeleteDeadLetter(ctx context.Context, taskID string) (*DeadLetter, error)
+	PurgeDeadLetters(ctx context.Context, taskName string, before time.Time) (int, error)
 }
@@ -22,6 +22,10 @@ const (
 	// Failed state is when the task execution failed but the task is part
 	// of a group and later execution is not possible, so it could not be "Unack"-ed
 	Failed = "failed"
+
+	// Cancelled state is when the task was cancelled by a client before
+	// or during its execution.
+	Cancelled = "cancelled"
 )
 
 type Task struct {
@@ -40,9 +44,81 @@ type Task struct {
 	CacheNamespace string    `json:"cacheNamespace"` // CacheNamespace if set, is used for constructing cache key.
 	CacheScope     string    `json:"cacheScope"`     // CacheScope if set, is used for constructing cache key.
 	Retries        int       `json:"retries"`        // Retries is the number of failed attempts to execute this task
+	Error          string    `json:"error"`          // Error contains the last error that occurred during task execution.
 	CreatedAt      time.Time `json:"createdAt"`      // CreatedAt specifies task creation time.
 	StartedAt      time.Time `json:"startedAt"`      // StartedAt specifies task execution start time.
 	FinishedAt     time.Time `json:"finishedAt"`     // FinishedAt specifies the time when the task is done.
+
+	// Position is the position of a group task in its group, which
+	// orders the execution of the tasks of sequential groups.
+	Position int `json:"position"`
+
+	// TemplateVersion is the version of the task template. When the task is
+	// created from a template, it records the version that was used.
+	TemplateVersion int `json:"templateVersion"`
+
+	// RunAt if set, specifies the time before which the task must not be executed.
+	RunAt time.Time `json:"runAt"`
+
+	// Priority of the task from 0 to MaxPriority. Tasks with higher
+	// priority are executed before tasks with lower priority.
+	Priority int `json:"priority"`
+
+	// Rank orders the tasks in the queue. It's calculated from the
+	// priority when the task is added to the queue (see Rank).
+	Rank time.Time `json:"rank"`
+
+	// CallbackURL if set, is notified when the task execution is finished.
+	CallbackURL string `json:"callbackURL"`
+
+	// Errors contains the errors of the most recent failed execution
+	// attempts (at most MaxTaskErrors), the last one at the end.
+	Errors []string `json:"errors"`
+
+	// RetryPolicy if set, overrides the default retry policy of the task executor.
+	RetryPolicy *RetryPolicy `json:"retryPolicy"`
+
+	// NextAttemptAt if set, specifies the time before which a failed
+	// task must not be executed again.
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+
+	// AcceptedStatusCodes contains the status codes (e.g. "404") and classes
+	// of status codes (e.g. "2xx") of successful responses to the HTTP request
+	// of the task. Only 2xx status codes are accepted if it's empty.
+	AcceptedStatusCodes []string `json:"acceptedStatusCodes"`
+
+	// Timeout if set, limits the duration of a task execution. Tasks without
+	// a timeout are limited by the default task timeout of the executors.
+	Timeout time.Duration `json:"timeout"`
+
+	// FailureReason is the reason of the last failed execution of the task
+	// (e.g. "timeout"), see the reasons of ExecutionError.
+	FailureReason string `json:"failureReason"`
+
+	// LeaseOwner is the instance of the service executing the task. It holds
+	// the task until LeaseExpiresAt and extends the lease with heartbeats.
+	// Pending tasks with an expired lease are returned to the queue.
+	LeaseOwner     string    `json:"leaseOwner"`
+	LeaseExpiresAt time.Time `json:"leaseExpiresAt"`
+}
+
+// ExecutionTimeout returns the timeout of the task execution, which is the
+// timeout of the task or the given default timeout if the task has none.
+func (t *Task) ExecutionTimeout(defaultTimeout time.Duration) time.Duration {
+	if t.Timeout > 0 {
+		return t.Timeout
+	}
+	return defaultTimeout
+}
+
+// AppendError appends an execution error to the most recent errors
+// of a task keeping at most MaxTaskErrors.
+func AppendError(errs []string, err string) []string {
+	errs = append(errs, err)
+	if len(errs) > MaxTaskErrors {
+		errs = errs[len(errs)-MaxTaskErrors:]
+	}
+	return errs
 }
 
 type EventTask struct {
@@ -65,3 +141,43 @@ func (t *Task) CacheKey() string {
 	}
 	return key
 }
+
+// CreateOptions contains the values given when a task or taskList is created
+// from a template. Priority, callback URL and cache key namespace and scope
+// override the values of the template when they're set and not empty.
+type CreateOptions struct {
+	ID             string
+	Request        []byte
+	CreatedAt      time.Time
+	RunAt          time.Time
+	Priority       *int
+	CallbackURL    *string
+	CacheNamespace *string
+	CacheScope     *string
+}
+
+// NewTask creates a task from a task template.
+func NewTask(template *Task, opts CreateOptions) *Task {
+	task := *template
+	task.ID = opts.ID
+	task.State = Created
+	task.CreatedAt = opts.CreatedAt
+	task.Request = opts.Request
+	task.RunAt = opts.RunAt
+
+	if opts.Priority != nil {
+		task.Priority = *opts.Priority
+	}
+	override(&task.CallbackURL, opts.CallbackURL)
+	override(&task.CacheNamespace, opts.CacheNamespace)
+	override(&task.CacheScope, opts.CacheScope)
+
+	return &task
+}
+
+// override sets the value of a template field if the given value is not empty.
+func override(field *string, value *string) {
+	if value != nil && *value != "" {
+		*field = *value
+	}
+}
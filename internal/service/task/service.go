@@ -10,10 +10,17 @@ import (
 	"go.uber.org/zap"
 
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
 	goatask "github.com/eclipse-xfsc/task-sheduler/gen/task"
 	"github.com/eclipse-xfsc/task-sheduler/internal/service"
 )
 
+const (
+	// waitInterval specifies how often the state of a task is checked
+	// while a create request is waiting for the task to complete.
+	waitInterval = 100 * time.Millisecond
+)
+
 //go:generate counterfeiter . Cache
 
 type Cache interface {
@@ -25,15 +32,29 @@ type Service struct {
 	queue   service.Queue
 	cache   Cache
 	logger  *zap.Logger
+
+	// idempotencyWindow is the time during which repeated
+	// requests with the same idempotency key return the same task.
+	idempotencyWindow time.Duration
+
+	// maxWait is the maximum time a create request
+	// can wait for the created task to complete.
+	maxWait time.Duration
+
+	// webhooks reports whether the callback URLs of tasks are notified.
+	webhooks bool
 }
 
 // New creates the task service.
-func New(template service.Storage, queue service.Queue, cache Cache, logger *zap.Logger) *Service {
+func New(template service.Storage, queue service.Queue, cache Cache, idempotencyWindow, maxWait time.Duration, webhooks bool, logger *zap.Logger) *Service {
 	return &Service{
-		storage: template,
-		queue:   queue,
-		cache:   cache,
-		logger:  logger,
+		storage:           template,
+		queue:             queue,
+		cache:             cache,
+		logger:            logger,
+		idempotencyWindow: idempotencyWindow,
+		maxWait:           maxWait,
+		webhooks:          webhooks,
 	}
 }
 
@@ -43,10 +64,32 @@ func (s *Service) Create(ctx context.Context, req *goatask.CreateTaskRequest) (r
 		return nil, errors.New(errors.BadRequest, "missing taskName")
 	}
 
+	now := time.Now()
+	runAt, err := service.ParseRunAt(req.RunAt, req.Delay, now)
+	if err != nil {
+		return nil, err
+	}
+
+	wait, err := service.ParseWait(req.Wait, s.maxWait)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CallbackURL != nil && *req.CallbackURL != "" {
+		if err := service.ValidateCallbackURL(*req.CallbackURL); err != nil {
+			return nil, err
+		}
+	}
+
 	logger := s.logger.With(zap.String("taskName", req.TaskName))
 
 	// get predefined task definition from storage
-	task, err := s.storage.TaskTemplate(ctx, req.TaskName)
+	var template *service.Task
+	if req.TemplateVersion != nil {
+		template, err = s.storage.TaskTemplateVersion(ctx, req.TaskName, *req.TemplateVersion)
+	} else {
+		template, err = s.storage.TaskTemplate(ctx, req.TaskName)
+	}
 	if err != nil {
 		logger.Error("error getting task template from storage", zap.Error(err))
 		return nil, err
@@ -58,28 +101,133 @@ func (s *Service) Create(ctx context.Context, req *goatask.CreateTaskRequest) (r
 		return nil, errors.New(errors.BadRequest, "error marshaling request data to JSON", err)
 	}
 
-	task.ID = uuid.NewString()
-	task.State = service.Created
-	task.CreatedAt = time.Now()
-	task.Request = taskRequest
+	task := service.NewTask(template, service.CreateOptions{
+		ID:             uuid.NewString(),
+		Request:        taskRequest,
+		CreatedAt:      now,
+		RunAt:          runAt,
+		Priority:       req.Priority,
+		CallbackURL:    req.CallbackURL,
+		CacheNamespace: req.CacheNamespace,
+		CacheScope:     req.CacheScope,
+	})
 
-	// if cache key namespace and scope are given, use them instead of the defaults
-	if req.CacheNamespace != nil && *req.CacheNamespace != "" {
-		task.CacheNamespace = *req.CacheNamespace
+	if err := service.ValidateCallback(task.CallbackURL, s.webhooks); err != nil {
+		return nil, err
 	}
-	if req.CacheScope != nil && *req.CacheScope != "" {
-		task.CacheScope = *req.CacheScope
+
+	if req.IdempotencyKey != nil && *req.IdempotencyKey != "" {
+		taskID, reserved, err := s.reserveIdempotencyKey(ctx, req, task.ID, now)
+		if err != nil {
+			if !errors.Is(errors.Exist, err) {
+				logger.Error("error reserving idempotency key", zap.Error(err))
+			}
+			return nil, err
+		}
+		if !reserved {
+			// the task is already created by a previous request with the same key
+			return s.createResult(ctx, taskID, wait), nil
+		}
 	}
 
 	if err := s.queue.Add(ctx, task); err != nil {
 		logger.Error("error adding task to queue", zap.Error(err))
+		if req.IdempotencyKey != nil && *req.IdempotencyKey != "" {
+			if err := s.storage.DeleteIdempotencyKey(ctx, *req.IdempotencyKey); err != nil {
+				logger.Error("error deleting idempotency key", zap.Error(err))
+			}
+		}
 		return nil, errors.New("failed to create task", err)
 	}
 
-	return &goatask.CreateTaskResult{TaskID: task.ID}, nil
+	return s.createResult(ctx, task.ID, wait), nil
 }
 
-// TaskResult retrieves task result from the Cache service.
+// createResult waits up to the given time for the task to complete and returns
+// its state and result. If the task is not completed in time, the result is
+// not set and the client can retrieve it later with the task ID.
+func (s *Service) createResult(ctx context.Context, taskID string, wait time.Duration) *goatask.CreateTaskResult {
+	res := &goatask.CreateTaskResult{TaskID: taskID}
+	if wait == 0 {
+		return res
+	}
+
+	logger := s.logger.With(zap.String("taskID", taskID))
+
+	task, err := s.waitTask(ctx, taskID, wait)
+	if err != nil {
+		if !errors.Is(errors.NotFound, err) && ctx.Err() == nil {
+			logger.Error("error waiting for task to complete", zap.Error(err))
+		}
+		return res
+	}
+
+	res.State = ptr.String(string(task.State))
+	if task.State == service.Done {
+		result, err := s.TaskResult(ctx, &goatask.TaskResultRequest{TaskID: taskID})
+		if err != nil {
+			logger.Error("error getting result of completed task", zap.Error(err))
+			return res
+		}
+		res.Result = result
+	}
+
+	return res
+}
+
+// waitTask waits until the task is moved to the history of executed tasks
+// or to the dead letters, or the wait time expires, and returns the last
+// known state of the task.
+func (s *Service) waitTask(ctx context.Context, taskID string, wait time.Duration) (*service.Task, error) {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(waitInterval)
+	defer ticker.Stop()
+
+	for {
+		task, err := s.finishedTask(ctx, taskID)
+		if err == nil {
+			return task, nil
+		}
+		if !errors.Is(errors.NotFound, err) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return s.storage.Task(ctx, taskID)
+		case <-ticker.C:
+		}
+	}
+}
+
+// reserveIdempotencyKey reserves the idempotency key of the request for the
+// task being created. If the key was already used by the same request, the ID
+// of the originally created task is returned and reserved is false.
+func (s *Service) reserveIdempotencyKey(ctx context.Context, req *goatask.CreateTaskRequest, taskID string, now time.Time) (id string, reserved bool, err error) {
+	// the wait time and the key don't change what is created by the request
+	hashed := *req
+	hashed.Wait = nil
+	hashed.IdempotencyKey = nil
+	hash, err := service.RequestHash("task", &hashed)
+	if err != nil {
+		return "", false, err
+	}
+
+	return service.ReserveIdempotencyKey(ctx, s.storage, &service.IdempotencyKey{
+		Key:         *req.IdempotencyKey,
+		RequestHash: hash,
+		ResourceID:  taskID,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.idempotencyWindow),
+	})
+}
+
+// TaskResult retrieves task result from the Cache service. For a failed
+// or dead-lettered task it returns the error of its last execution.
 func (s *Service) TaskResult(ctx context.Context, req *goatask.TaskResultRequest) (res interface{}, err error) {
 	if req.TaskID == "" {
 		return nil, errors.New(errors.BadRequest, "missing taskID")
@@ -88,9 +236,9 @@ func (s *Service) TaskResult(ctx context.Context, req *goatask.TaskResultRequest
 	logger := s.logger.With(zap.String("taskID", req.TaskID))
 
 	var task *service.Task
-	task, err = s.storage.TaskHistory(ctx, req.TaskID)
+	task, err = s.finishedTask(ctx, req.TaskID)
 	if err != nil && !errors.Is(errors.NotFound, err) {
-		logger.Error("error getting task from history collection", zap.Error(err))
+		logger.Error("error getting finished task from storage", zap.Error(err))
 		return nil, err
 	}
 
@@ -100,12 +248,26 @@ func (s *Service) TaskResult(ctx context.Context, req *goatask.TaskResultRequest
 			if errors.Is(errors.NotFound, err) {
 				return nil, errors.New("task is not found", err)
 			}
-			logger.Error("error getting task from history collection", zap.Error(err))
+			logger.Error("error getting task from tasks collection", zap.Error(err))
 			return nil, err
 		}
 	}
 
-	if task.State != service.Done && task.State != service.Failed {
+	if task.State == service.Cancelled {
+		return nil, errors.New(errors.NotFound, "no result, task is cancelled")
+	}
+
+	// the result of a failed task is not stored in the cache,
+	// the error of its last execution is returned instead
+	if task.State == service.Failed {
+		msg := "no result, task failed"
+		if task.Error != "" {
+			msg += ": " + task.Error
+		}
+		return nil, errors.New(errors.NotFound, msg)
+	}
+
+	if task.State != service.Done {
 		return nil, errors.New(errors.NotFound, "no result, task is not completed")
 	}
 
@@ -123,3 +285,181 @@ func (s *Service) TaskResult(ctx context.Context, req *goatask.TaskResultRequest
 
 	return result, nil
 }
+
+// TaskStatus retrieves the current state of a task from the queue, the
+// history of executed tasks or the dead letters. Unlike TaskResult, it doesn't require the task
+// to be completed and doesn't query the Cache service.
+func (s *Service) TaskStatus(ctx context.Context, req *goatask.TaskStatusRequest) (*goatask.TaskStatusResponse, error) {
+	if req.TaskID == "" {
+		return nil, errors.New(errors.BadRequest, "missing taskID")
+	}
+
+	logger := s.logger.With(zap.String("taskID", req.TaskID))
+
+	task, err := s.finishedTask(ctx, req.TaskID)
+	if err != nil && !errors.Is(errors.NotFound, err) {
+		logger.Error("error getting finished task from storage", zap.Error(err))
+		return nil, err
+	}
+
+	if task == nil {
+		task, err = s.storage.Task(ctx, req.TaskID)
+		if err != nil {
+			if errors.Is(errors.NotFound, err) {
+				return nil, errors.New("task is not found", err)
+			}
+			logger.Error("error getting task from tasks collection", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	return taskStatus(task), nil
+}
+
+// Search tasks in the queue, the history of executed tasks and the dead letters
+// filtered by the given criteria.
+func (s *Service) Search(ctx context.Context, req *goatask.SearchTasksRequest) (*goatask.SearchTasksResponse, error) {
+	filter, err := service.SearchFilter((*service.SearchRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	limit := filter.Limit
+	// request one more task to find out if there is a next page
+	filter.Limit++
+
+	tasks, err := s.storage.Tasks(ctx, filter)
+	if err != nil {
+		s.logger.Error("error getting tasks from storage", zap.Error(err))
+		return nil, err
+	}
+
+	res := &goatask.SearchTasksResponse{Tasks: []*goatask.TaskStatusResponse{}}
+	if len(tasks) > limit {
+		tasks = tasks[:limit]
+		last := tasks[len(tasks)-1]
+		cursor := service.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+		res.Next = ptr.String(cursor.Encode())
+	}
+
+	for _, task := range tasks {
+		res.Tasks = append(res.Tasks, taskStatus(task))
+	}
+
+	return res, nil
+}
+
+// Cancel a task which is waiting in the queue or is currently being executed.
+// Tasks waiting in the queue are moved to the history collection by the queue,
+// while the execution of pending tasks is interrupted by the executor.
+func (s *Service) Cancel(ctx context.Context, req *goatask.CancelTaskRequest) error {
+	if req.TaskID == "" {
+		return errors.New(errors.BadRequest, "missing taskID")
+	}
+
+	if _, err := s.queue.Cancel(ctx, req.TaskID); err != nil {
+		if errors.Is(errors.NotFound, err) {
+			return s.notCancellable(ctx, req.TaskID)
+		}
+		s.logger.Error("error cancelling task in queue", zap.String("taskID", req.TaskID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// notCancellable determines why a task could not be cancelled in the queue.
+// Repeated cancellations of the same task are not considered an error.
+func (s *Service) notCancellable(ctx context.Context, taskID string) error {
+	task, err := s.storage.Task(ctx, taskID)
+	if err != nil && !errors.Is(errors.NotFound, err) {
+		s.logger.Error("error getting task from tasks collection", zap.String("taskID", taskID), zap.Error(err))
+		return err
+	}
+
+	if task != nil {
+		if task.GroupID != "" {
+			return errors.New(errors.BadRequest, "task is part of a taskList and cannot be cancelled")
+		}
+		// task is already cancelled and its execution is being interrupted
+		return nil
+	}
+
+	task, err = s.finishedTask(ctx, taskID)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			return errors.New("task is not found", err)
+		}
+		s.logger.Error("error getting finished task from storage", zap.String("taskID", taskID), zap.Error(err))
+		return err
+	}
+
+	if task.State != service.Cancelled {
+		return errors.New(errors.BadRequest, "task is already completed")
+	}
+
+	return nil
+}
+
+// finishedTask retrieves a task from the history of executed tasks or, if
+// its executions failed too many times, from the dead letters.
+func (s *Service) finishedTask(ctx context.Context, taskID string) (*service.Task, error) {
+	task, err := s.storage.TaskHistory(ctx, taskID)
+	if !errors.Is(errors.NotFound, err) {
+		return task, err
+	}
+
+	letter, err := s.storage.DeadLetter(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return letter.Task, nil
+}
+
+// taskStatus converts a task to its status representation,
+// omitting the time fields which are not yet set.
+func taskStatus(task *service.Task) *goatask.TaskStatusResponse {
+	status := &goatask.TaskStatusResponse{
+		ID:        task.ID,
+		Name:      task.Name,
+		State:     string(task.State),
+		Retries:   task.Retries,
+		CreatedAt: task.CreatedAt.Format(time.RFC3339),
+	}
+
+	if !task.StartedAt.IsZero() {
+		status.StartedAt = ptr.String(task.StartedAt.Format(time.RFC3339))
+	}
+	if !task.FinishedAt.IsZero() {
+		status.FinishedAt = ptr.String(task.FinishedAt.Format(time.RFC3339))
+	}
+	if task.ResponseCode != 0 {
+		status.ResponseCode = &task.ResponseCode
+	}
+	if task.Error != "" {
+		status.Error = &task.Error
+	}
+	if task.FailureReason != "" {
+		status.FailureReason = &task.FailureReason
+	}
+	if task.CacheNamespace != "" {
+		status.CacheNamespace = &task.CacheNamespace
+	}
+	if task.CacheScope != "" {
+		status.CacheScope = &task.CacheScope
+	}
+	if task.TemplateVersion != 0 {
+		status.TemplateVersion = &task.TemplateVersion
+	}
+	if !task.RunAt.IsZero() {
+		status.RunAt = ptr.String(task.RunAt.Format(time.RFC3339))
+	}
+	if task.Priority != 0 {
+		status.Priority = &task.Priority
+	}
+	if !task.NextAttemptAt.IsZero() && task.State == service.Created {
+		status.NextAttemptAt = ptr.String(task.NextAttemptAt.Format(time.RFC3339))
+	}
+
+	return status
+}
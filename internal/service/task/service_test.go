@@ -3,6 +3,7 @@ package task_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
@@ -17,7 +18,7 @@ import (
 )
 
 func TestNew(t *testing.T) {
-	svc := task.New(nil, nil, nil, zap.NewNop())
+	svc := task.New(nil, nil, nil, time.Hour, time.Second, true, zap.NewNop())
 	assert.Implements(t, (*goatask.Service)(nil), svc)
 }
 
@@ -29,6 +30,9 @@ func TestService_Create(t *testing.T) {
 		queue   *servicefakes.FakeQueue
 		cache   *taskfakes.FakeCache
 
+		// noWebhooks disables the webhooks of the service
+		noWebhooks bool
+
 		errkind errors.Kind
 		errtext string
 	}{
@@ -79,6 +83,173 @@ func TestService_Create(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "callbackURL is given when webhooks are disabled",
+			req:        &goatask.CreateTaskRequest{TaskName: "taskname", CallbackURL: ptr.String("https://example.com/callback")},
+			noWebhooks: true,
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "webhooks are disabled",
+		},
+		{
+			name:       "template has a callbackURL when webhooks are disabled",
+			req:        &goatask.CreateTaskRequest{TaskName: "taskname"},
+			noWebhooks: true,
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{CallbackURL: "https://example.com/callback"}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "webhooks are disabled",
+		},
+		{
+			name:    "both runAt and delay are given",
+			req:     &goatask.CreateTaskRequest{TaskName: "taskname", RunAt: ptr.String("2023-01-01T10:00:00Z"), Delay: ptr.String("10m")},
+			errkind: errors.BadRequest,
+			errtext: "runAt and delay cannot be used together",
+		},
+		{
+			name:    "invalid delay",
+			req:     &goatask.CreateTaskRequest{TaskName: "taskname", Delay: ptr.String("10 minutes")},
+			errkind: errors.BadRequest,
+			errtext: "invalid delay format",
+		},
+		{
+			name:    "negative delay",
+			req:     &goatask.CreateTaskRequest{TaskName: "taskname", Delay: ptr.String("-10m")},
+			errkind: errors.BadRequest,
+			errtext: "delay cannot be negative",
+		},
+		{
+			name:    "invalid runAt",
+			req:     &goatask.CreateTaskRequest{TaskName: "taskname", RunAt: ptr.String("tomorrow")},
+			errkind: errors.BadRequest,
+			errtext: "invalid time format",
+		},
+		{
+			name: "successfully add scheduled task to queue",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", RunAt: ptr.String("2030-01-01T02:00:00Z")},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, t *service.Task) error {
+					if !t.RunAt.Equal(time.Date(2030, 1, 1, 2, 0, 0, 0, time.UTC)) {
+						return errors.New("unexpected runAt")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully add delayed task to queue",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", Delay: ptr.String("10m")},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, t *service.Task) error {
+					if t.RunAt.Sub(t.CreatedAt) < 10*time.Minute {
+						return errors.New("unexpected runAt")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully add task with template priority to queue",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname"},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{Priority: 5}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, t *service.Task) error {
+					if t.Priority != 5 {
+						return errors.New("unexpected priority")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully add task with priority override to queue",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", Priority: ptr.Int(0)},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{Priority: 5}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, t *service.Task) error {
+					if t.Priority != 0 {
+						return errors.New("unexpected priority")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name:    "invalid callbackURL",
+			req:     &goatask.CreateTaskRequest{TaskName: "taskname", CallbackURL: ptr.String("example.com/hook")},
+			errkind: errors.BadRequest,
+			errtext: "invalid callbackURL",
+		},
+		{
+			name: "successfully add task with callbackURL override to queue",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", CallbackURL: ptr.String("https://example.com/hook")},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{CallbackURL: "https://template.example.com/hook"}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, t *service.Task) error {
+					if t.CallbackURL != "https://example.com/hook" {
+						return errors.New("unexpected callbackURL")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "task template version not found",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", TemplateVersion: ptr.Int(2)},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateVersionStub: func(ctx context.Context, taskName string, version int) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound, "task template version not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "task template version not found",
+		},
+		{
+			name: "successfully add task to queue with template version",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", TemplateVersion: ptr.Int(2)},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateVersionStub: func(ctx context.Context, taskName string, version int) (*service.Task, error) {
+					return &service.Task{Name: taskName, TemplateVersion: version}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, t *service.Task) error {
+					if t.TemplateVersion != 2 {
+						return errors.New("unexpected template version")
+					}
+					return nil
+				},
+			},
+		},
 		{
 			name: "successfully add task to queue with namespace and scope",
 			req: &goatask.CreateTaskRequest{
@@ -101,7 +272,7 @@ func TestService_Create(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			svc := task.New(test.storage, test.queue, test.cache, zap.NewNop())
+			svc := task.New(test.storage, test.queue, test.cache, time.Hour, time.Second, !test.noWebhooks, zap.NewNop())
 			res, err := svc.Create(context.Background(), test.req)
 			if err != nil {
 				assert.NotEmpty(t, test.errtext)
@@ -119,6 +290,251 @@ func TestService_Create(t *testing.T) {
 	}
 }
 
+func TestService_CreateIdempotent(t *testing.T) {
+	req := &goatask.CreateTaskRequest{
+		TaskName:       "taskname",
+		Data:           map[string]interface{}{"key": "value"},
+		IdempotencyKey: ptr.String("key-1"),
+	}
+	// the idempotency key and the wait time are not part of the hash
+	hash, err := service.RequestHash("task", &goatask.CreateTaskRequest{TaskName: req.TaskName, Data: req.Data})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		req     *goatask.CreateTaskRequest
+		storage *servicefakes.FakeStorage
+		queue   *servicefakes.FakeQueue
+
+		taskID  string
+		added   int
+		deleted int
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "new idempotency key",
+			req:  req,
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					if key.Key != "key-1" || key.RequestHash != hash || !key.ExpiresAt.Equal(key.CreatedAt.Add(time.Hour)) {
+						return errors.New("unexpected idempotency key")
+					}
+					return nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{},
+			added: 1,
+		},
+		{
+			name: "repeated request with the same idempotency key",
+			req:  req,
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					return errors.New(errors.Exist, "idempotency key already exists")
+				},
+				IdempotencyKeyStub: func(ctx context.Context, key string) (*service.IdempotencyKey, error) {
+					return &service.IdempotencyKey{Key: key, RequestHash: hash, ResourceID: "123"}, nil
+				},
+			},
+			queue:  &servicefakes.FakeQueue{},
+			taskID: "123",
+		},
+		{
+			name: "repeated request with the same idempotency key and a different wait time",
+			req: &goatask.CreateTaskRequest{
+				TaskName:       "taskname",
+				Data:           map[string]interface{}{"key": "value"},
+				Wait:           ptr.String("0s"),
+				IdempotencyKey: ptr.String("key-1"),
+			},
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					return errors.New(errors.Exist, "idempotency key already exists")
+				},
+				IdempotencyKeyStub: func(ctx context.Context, key string) (*service.IdempotencyKey, error) {
+					return &service.IdempotencyKey{Key: key, RequestHash: hash, ResourceID: "123"}, nil
+				},
+			},
+			queue:  &servicefakes.FakeQueue{},
+			taskID: "123",
+		},
+		{
+			name: "idempotency key is used with a different request",
+			req: &goatask.CreateTaskRequest{
+				TaskName:       "taskname",
+				Data:           map[string]interface{}{"key": "another value"},
+				IdempotencyKey: ptr.String("key-1"),
+			},
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					return errors.New(errors.Exist, "idempotency key already exists")
+				},
+				IdempotencyKeyStub: func(ctx context.Context, key string) (*service.IdempotencyKey, error) {
+					return &service.IdempotencyKey{Key: key, RequestHash: hash, ResourceID: "123"}, nil
+				},
+			},
+			queue:   &servicefakes.FakeQueue{},
+			errkind: errors.Exist,
+			errtext: "idempotency key is already used with a different request",
+		},
+		{
+			name: "idempotency key is deleted when adding the task to queue fails",
+			req:  req,
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					return nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddStub: func(ctx context.Context, task *service.Task) error {
+					return errors.New("some error")
+				},
+			},
+			added:   1,
+			deleted: 1,
+			errkind: errors.Unknown,
+			errtext: "failed to create task",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.storage.TaskTemplateStub = func(ctx context.Context, taskName string) (*service.Task, error) {
+				return &service.Task{Name: taskName}, nil
+			}
+
+			svc := task.New(test.storage, test.queue, nil, time.Hour, time.Second, true, zap.NewNop())
+			res, err := svc.Create(context.Background(), test.req)
+			assert.Equal(t, test.added, test.queue.AddCallCount())
+			assert.Equal(t, test.deleted, test.storage.DeleteIdempotencyKeyCallCount())
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.NotEmpty(t, res.TaskID)
+				if test.taskID != "" {
+					assert.Equal(t, test.taskID, res.TaskID)
+				}
+			}
+		})
+	}
+}
+
+func TestService_CreateWait(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goatask.CreateTaskRequest
+		storage *servicefakes.FakeStorage
+		cache   *taskfakes.FakeCache
+
+		res     *goatask.CreateTaskResult
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "invalid wait",
+			req:     &goatask.CreateTaskRequest{TaskName: "taskname", Wait: ptr.String("soon")},
+			storage: &servicefakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: "invalid wait format",
+		},
+		{
+			name:    "negative wait",
+			req:     &goatask.CreateTaskRequest{TaskName: "taskname", Wait: ptr.String("-1s")},
+			storage: &servicefakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: "wait cannot be negative",
+		},
+		{
+			name: "task is completed within the wait time",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", Wait: ptr.String("1m")},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{ID: taskID, State: service.Done}, nil
+				},
+			},
+			cache: &taskfakes.FakeCache{
+				GetStub: func(ctx context.Context, key, namespace, scope string) ([]byte, error) {
+					return []byte(`{"allow":true}`), nil
+				},
+			},
+			res: &goatask.CreateTaskResult{
+				State:  ptr.String("done"),
+				Result: map[string]interface{}{"allow": true},
+			},
+		},
+		{
+			name: "task is not completed within the wait time",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", Wait: ptr.String("10ms")},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound, "task not found in history")
+				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
+				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{ID: taskID, State: service.Pending}, nil
+				},
+			},
+			res: &goatask.CreateTaskResult{State: ptr.String("pending")},
+		},
+		{
+			name: "task is moved to dead letters within the wait time",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", Wait: ptr.String("1m")},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound, "task not found in history")
+				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return &service.DeadLetter{ID: taskID, Task: &service.Task{ID: taskID, State: service.Failed, Error: "unavailable"}}, nil
+				},
+			},
+			res: &goatask.CreateTaskResult{State: ptr.String("failed")},
+		},
+		{
+			name: "error getting task result falls back to asynchronous response",
+			req:  &goatask.CreateTaskRequest{TaskName: "taskname", Wait: ptr.String("1m")},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			res: &goatask.CreateTaskResult{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.storage.TaskTemplateStub = func(ctx context.Context, taskName string) (*service.Task, error) {
+				return &service.Task{Name: taskName}, nil
+			}
+
+			svc := task.New(test.storage, &servicefakes.FakeQueue{}, test.cache, time.Hour, time.Second, true, zap.NewNop())
+			res, err := svc.Create(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.NotEmpty(t, res.TaskID)
+				assert.Equal(t, test.res.State, res.State)
+				assert.Equal(t, test.res.Result, res.Result)
+			}
+		})
+	}
+}
+
 func TestService_TaskResult(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -154,6 +570,9 @@ func TestService_TaskResult(t *testing.T) {
 				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return nil, errors.New(errors.NotFound)
 				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
 				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return nil, errors.New("another error")
 				},
@@ -168,6 +587,9 @@ func TestService_TaskResult(t *testing.T) {
 				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return nil, errors.New(errors.NotFound)
 				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
 				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return nil, errors.New(errors.NotFound)
 				},
@@ -182,6 +604,9 @@ func TestService_TaskResult(t *testing.T) {
 				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return nil, errors.New(errors.NotFound)
 				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
 				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return &service.Task{State: service.Pending}, nil
 				},
@@ -190,14 +615,28 @@ func TestService_TaskResult(t *testing.T) {
 			errtext: "no result, task is not completed",
 		},
 		{
-			name: "error getting task result from cache",
+			name: "task is cancelled",
 			req:  &goatask.TaskResultRequest{TaskID: "123"},
 			storage: &servicefakes.FakeStorage{
 				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
-					return nil, errors.New(errors.NotFound)
+					return &service.Task{State: service.Cancelled}, nil
 				},
-				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
-					return &service.Task{State: service.Done}, nil
+			},
+			errkind: errors.NotFound,
+			errtext: "no result, task is cancelled",
+		},
+		{
+			name: "error getting task result from cache",
+			req:  &goatask.TaskResultRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
+				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{State: service.Done}, nil
 				},
 			},
 			cache: &taskfakes.FakeCache{
@@ -215,6 +654,9 @@ func TestService_TaskResult(t *testing.T) {
 				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return nil, errors.New(errors.NotFound)
 				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
 				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return &service.Task{State: service.Done}, nil
 				},
@@ -234,6 +676,9 @@ func TestService_TaskResult(t *testing.T) {
 				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return nil, errors.New(errors.NotFound)
 				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
 				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
 					return &service.Task{State: service.Done}, nil
 				},
@@ -245,11 +690,36 @@ func TestService_TaskResult(t *testing.T) {
 			},
 			res: map[string]interface{}{"result": "success"},
 		},
+		{
+			name: "get result of a failed task",
+			req:  &goatask.TaskResultRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{ID: taskID, State: service.Failed, Error: "unexpected response code: 500"}, nil
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "no result, task failed: unexpected response code: 500",
+		},
+		{
+			name: "get result of a dead-lettered task",
+			req:  &goatask.TaskResultRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return &service.DeadLetter{ID: taskID, Task: &service.Task{ID: taskID, State: service.Failed, Error: "unexpected response code: 503"}}, nil
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "no result, task failed: unexpected response code: 503",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			svc := task.New(test.storage, nil, test.cache, zap.NewNop())
+			svc := task.New(test.storage, nil, test.cache, time.Hour, time.Second, true, zap.NewNop())
 			res, err := svc.TaskResult(context.Background(), test.req)
 			if err != nil {
 				assert.NotEmpty(t, test.errtext)
@@ -266,3 +736,441 @@ func TestService_TaskResult(t *testing.T) {
 		})
 	}
 }
+
+func TestService_TaskStatus(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		req     *goatask.TaskStatusRequest
+		storage *servicefakes.FakeStorage
+
+		res     *goatask.TaskStatusResponse
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing taskID",
+			req:     &goatask.TaskStatusRequest{},
+			errkind: errors.BadRequest,
+			errtext: "missing taskID",
+		},
+		{
+			name: "error getting task history from storage",
+			req:  &goatask.TaskStatusRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "task not found neither in history nor in tasks queue collection",
+			req:  &goatask.TaskStatusRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
+				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "task is not found",
+		},
+		{
+			name: "status of a queued task which failed previously",
+			req:  &goatask.TaskStatusRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
+				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{
+						ID:            "123",
+						Name:          "taskname",
+						State:         service.Created,
+						Retries:       2,
+						Error:         "error executing http request",
+						FailureReason: service.ReasonHTTP,
+						CreatedAt:     createdAt,
+						NextAttemptAt: createdAt.Add(4 * time.Second),
+					}, nil
+				},
+			},
+			res: &goatask.TaskStatusResponse{
+				ID:            "123",
+				Name:          "taskname",
+				State:         service.Created,
+				Retries:       2,
+				CreatedAt:     "2023-01-01T10:00:00Z",
+				Error:         ptr.String("error executing http request"),
+				FailureReason: ptr.String(service.ReasonHTTP),
+				NextAttemptAt: ptr.String("2023-01-01T10:00:04Z"),
+			},
+		},
+		{
+			name: "status of a scheduled task",
+			req:  &goatask.TaskStatusRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
+				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{
+						ID:        "123",
+						Name:      "taskname",
+						State:     service.Created,
+						CreatedAt: createdAt,
+						RunAt:     createdAt.Add(time.Hour),
+					}, nil
+				},
+			},
+			res: &goatask.TaskStatusResponse{
+				ID:        "123",
+				Name:      "taskname",
+				State:     service.Created,
+				CreatedAt: "2023-01-01T10:00:00Z",
+				RunAt:     ptr.String("2023-01-01T11:00:00Z"),
+			},
+		},
+		{
+			name: "status of a completed task",
+			req:  &goatask.TaskStatusRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{
+						ID:           "123",
+						Name:         "taskname",
+						State:        service.Done,
+						ResponseCode: 200,
+						CreatedAt:    createdAt,
+						StartedAt:    createdAt.Add(time.Second),
+						FinishedAt:   createdAt.Add(2 * time.Second),
+					}, nil
+				},
+			},
+			res: &goatask.TaskStatusResponse{
+				ID:           "123",
+				Name:         "taskname",
+				State:        service.Done,
+				CreatedAt:    "2023-01-01T10:00:00Z",
+				StartedAt:    ptr.String("2023-01-01T10:00:01Z"),
+				FinishedAt:   ptr.String("2023-01-01T10:00:02Z"),
+				ResponseCode: ptr.Int(200),
+			},
+		},
+		{
+			name: "status of a dead-lettered task",
+			req:  &goatask.TaskStatusRequest{TaskID: "123"},
+			storage: &servicefakes.FakeStorage{
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return &service.DeadLetter{
+						ID: "123",
+						Task: &service.Task{
+							ID:            "123",
+							Name:          "taskname",
+							State:         service.Failed,
+							Retries:       3,
+							Error:         "error executing http request",
+							FailureReason: service.ReasonHTTP,
+							CreatedAt:     createdAt,
+							FinishedAt:    createdAt.Add(time.Minute),
+						},
+						DeadLetteredAt: createdAt.Add(time.Minute),
+					}, nil
+				},
+			},
+			res: &goatask.TaskStatusResponse{
+				ID:            "123",
+				Name:          "taskname",
+				State:         service.Failed,
+				Retries:       3,
+				CreatedAt:     "2023-01-01T10:00:00Z",
+				FinishedAt:    ptr.String("2023-01-01T10:01:00Z"),
+				Error:         ptr.String("error executing http request"),
+				FailureReason: ptr.String(service.ReasonHTTP),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := task.New(test.storage, nil, nil, time.Hour, time.Second, true, zap.NewNop())
+			res, err := svc.TaskStatus(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestService_Search(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	cursor := service.Cursor{CreatedAt: createdAt, ID: "2"}
+
+	tests := []struct {
+		name    string
+		req     *goatask.SearchTasksRequest
+		storage *servicefakes.FakeStorage
+
+		ids     []string
+		next    *string
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "invalid createdAfter time",
+			req:     &goatask.SearchTasksRequest{CreatedAfter: ptr.String("yesterday")},
+			errkind: errors.BadRequest,
+			errtext: "invalid time format",
+		},
+		{
+			name:    "invalid cursor",
+			req:     &goatask.SearchTasksRequest{Cursor: ptr.String("not a cursor")},
+			errkind: errors.BadRequest,
+			errtext: "invalid cursor",
+		},
+		{
+			name: "error getting tasks from storage",
+			req:  &goatask.SearchTasksRequest{Limit: 2},
+			storage: &servicefakes.FakeStorage{
+				TasksStub: func(ctx context.Context, filter *service.Filter) ([]*service.Task, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "no tasks found",
+			req:  &goatask.SearchTasksRequest{Limit: 2},
+			storage: &servicefakes.FakeStorage{
+				TasksStub: func(ctx context.Context, filter *service.Filter) ([]*service.Task, error) {
+					return nil, nil
+				},
+			},
+			ids: []string{},
+		},
+		{
+			name: "last page of tasks",
+			req:  &goatask.SearchTasksRequest{Limit: 2, Cursor: ptr.String(cursor.Encode())},
+			storage: &servicefakes.FakeStorage{
+				TasksStub: func(ctx context.Context, filter *service.Filter) ([]*service.Task, error) {
+					if filter.After == nil || filter.After.ID != "2" {
+						return nil, errors.New("cursor is not passed to storage")
+					}
+					return []*service.Task{{ID: "3", CreatedAt: createdAt}}, nil
+				},
+			},
+			ids: []string{"3"},
+		},
+		{
+			name: "tasks with next page",
+			req: &goatask.SearchTasksRequest{
+				Name:          ptr.String("taskname"),
+				State:         ptr.String(service.Done),
+				CreatedBefore: ptr.String("2023-01-02T00:00:00Z"),
+				Sort:          "-createdAt",
+				Limit:         2,
+			},
+			storage: &servicefakes.FakeStorage{
+				TasksStub: func(ctx context.Context, filter *service.Filter) ([]*service.Task, error) {
+					if filter.Limit != 3 || !filter.Descending || filter.Name != "taskname" || filter.State != service.Done {
+						return nil, errors.New("unexpected filter")
+					}
+					return []*service.Task{
+						{ID: "1", CreatedAt: createdAt},
+						{ID: "2", CreatedAt: createdAt},
+						{ID: "3", CreatedAt: createdAt},
+					}, nil
+				},
+			},
+			ids:  []string{"1", "2"},
+			next: ptr.String(cursor.Encode()),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := task.New(test.storage, nil, nil, time.Hour, time.Second, true, zap.NewNop())
+			res, err := svc.Search(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				ids := []string{}
+				for _, task := range res.Tasks {
+					ids = append(ids, task.ID)
+				}
+				assert.Equal(t, test.ids, ids)
+				assert.Equal(t, test.next, res.Next)
+			}
+		})
+	}
+}
+
+func TestService_Cancel(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goatask.CancelTaskRequest
+		storage *servicefakes.FakeStorage
+		queue   *servicefakes.FakeQueue
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing taskID",
+			req:     &goatask.CancelTaskRequest{},
+			errkind: errors.BadRequest,
+			errtext: "missing taskID",
+		},
+		{
+			name: "error cancelling task in queue",
+			req:  &goatask.CancelTaskRequest{TaskID: "123"},
+			queue: &servicefakes.FakeQueue{
+				CancelStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "task not found neither in queue nor in history",
+			req:  &goatask.CancelTaskRequest{TaskID: "123"},
+			queue: &servicefakes.FakeQueue{
+				CancelStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				DeadLetterStub: func(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+					return nil, errors.New(errors.NotFound, "dead letter not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "task is not found",
+		},
+		{
+			name: "task is part of a taskList",
+			req:  &goatask.CancelTaskRequest{TaskID: "123"},
+			queue: &servicefakes.FakeQueue{
+				CancelStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{GroupID: "456", State: service.Created}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task is part of a taskList",
+		},
+		{
+			name: "task is already completed",
+			req:  &goatask.CancelTaskRequest{TaskID: "123"},
+			queue: &servicefakes.FakeQueue{
+				CancelStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{State: service.Done}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task is already completed",
+		},
+		{
+			name: "task is already cancelled",
+			req:  &goatask.CancelTaskRequest{TaskID: "123"},
+			queue: &servicefakes.FakeQueue{
+				CancelStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+				TaskHistoryStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{State: service.Cancelled}, nil
+				},
+			},
+		},
+		{
+			name: "cancel pending task",
+			req:  &goatask.CancelTaskRequest{TaskID: "123"},
+			queue: &servicefakes.FakeQueue{
+				CancelStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{ID: taskID, State: service.Pending}, nil
+				},
+			},
+		},
+		{
+			name: "cancel created task",
+			req:  &goatask.CancelTaskRequest{TaskID: "123"},
+			queue: &servicefakes.FakeQueue{
+				CancelStub: func(ctx context.Context, taskID string) (*service.Task, error) {
+					return &service.Task{ID: taskID, State: service.Created}, nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := task.New(test.storage, test.queue, nil, time.Hour, time.Second, true, zap.NewNop())
+			err := svc.Cancel(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			} else {
+				assert.Empty(t, test.errtext)
+			}
+		})
+	}
+}
@@ -2,6 +2,19 @@ package service
 
 import (
 	"time"
+
+	"github.com/google/uuid"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const (
+	// Sequential group execution runs the tasks of a group one after another
+	// passing the result of each task as an input to the next one.
+	Sequential = "sequential"
+
+	// Parallel group execution runs the tasks of a group concurrently.
+	Parallel = "parallel"
 )
 
 type Template struct {
@@ -9,12 +22,24 @@ type Template struct {
 	CacheNamespace string          `json:"cacheNamespace"`
 	CacheScope     string          `json:"cacheScope"`
 	Groups         []GroupTemplate `json:"groups"`
+	Priority       int             `json:"priority"`    // Priority of the taskLists created from the template.
+	CallbackURL    string          `json:"callbackURL"` // CallbackURL is notified when the taskLists are finished.
+	Timeout        time.Duration   `json:"timeout"`     // Timeout is the deadline of the taskLists execution.
+
+	// TemplateVersion is the version of the template, incremented on every update.
+	TemplateVersion int `json:"templateVersion"`
+
+	// TaskVersions contains the versions of the task templates which were the
+	// latest ones when this version of the template was saved. The tasks of
+	// taskLists created from a pinned template version use these versions.
+	TaskVersions map[string]int `json:"taskVersions"`
 }
 
 type GroupTemplate struct {
-	Execution   string   `json:"execution"`
-	FinalPolicy string   `json:"finalPolicy"`
-	Tasks       []string `json:"tasks"`
+	Execution   string        `json:"execution"`
+	FinalPolicy string        `json:"finalPolicy"`
+	Tasks       []string      `json:"tasks"`
+	Timeout     time.Duration `json:"timeout"` // Timeout is the deadline of the group execution.
 }
 
 type TaskList struct {
@@ -28,13 +53,147 @@ type TaskList struct {
 	CreatedAt      time.Time `json:"createdAt"`
 	StartedAt      time.Time `json:"startedAt"`
 	FinishedAt     time.Time `json:"finishedAt"`
+
+	// TemplateVersion is the version of the taskList template
+	// from which the taskList was created.
+	TemplateVersion int `json:"templateVersion"`
+
+	// RunAt if set, specifies the time before which the taskList must not be executed.
+	RunAt time.Time `json:"runAt"`
+
+	// Priority of the taskList from 0 to MaxPriority. TaskLists with higher
+	// priority are executed before taskLists with lower priority.
+	Priority int `json:"priority"`
+
+	// Rank orders the taskLists in the queue. It's calculated from the
+	// priority when the taskList is added to the queue (see Rank).
+	Rank time.Time `json:"rank"`
+
+	// CallbackURL if set, is notified when the taskList execution is finished.
+	CallbackURL string `json:"callbackURL"`
+
+	// Timeout if set, is the deadline for the execution of all groups of the
+	// taskList. Tasks which are still running when it's exceeded are failed.
+	Timeout time.Duration `json:"timeout"`
+
+	// LeaseOwner is the instance of the service executing the taskList. It holds
+	// the taskList until LeaseExpiresAt and extends the lease with heartbeats.
+	// Pending taskLists with an expired lease are returned to the queue.
+	LeaseOwner     string    `json:"leaseOwner"`
+	LeaseExpiresAt time.Time `json:"leaseExpiresAt"`
+
+	// Retries is the number of times the taskList was returned to the
+	// queue, because its execution was interrupted.
+	Retries int `json:"retries"`
 }
 
 type Group struct {
-	ID          string   `json:"id"`
-	Execution   string   `json:"execution"`
-	Tasks       []string `json:"tasks"`
-	State       State    `json:"state"`
-	Request     []byte   `json:"request"`
-	FinalPolicy string   `json:"finalPolicy"`
+	ID          string        `json:"id"`
+	Execution   string        `json:"execution"`
+	Tasks       []string      `json:"tasks"`
+	State       State         `json:"state"`
+	Request     []byte        `json:"request"`
+	FinalPolicy string        `json:"finalPolicy"`
+	Timeout     time.Duration `json:"timeout"` // Timeout if set, is the deadline for the execution of the group tasks.
+}
+
+// NewGroups creates the groups of a taskList from the groups of its template.
+// The request is used as input for the groups.
+func NewGroups(t *Template, req []byte) []Group {
+	var groups []Group
+	for _, group := range t.Groups {
+		g := Group{
+			ID:          uuid.NewString(),
+			Execution:   group.Execution,
+			Tasks:       group.Tasks,
+			State:       Created,
+			Request:     req,
+			FinalPolicy: group.FinalPolicy,
+			Timeout:     group.Timeout,
+		}
+		groups = append(groups, g)
+	}
+
+	return groups
+}
+
+// NewGroupTasks creates the tasks for all groups of a taskList
+// from the given task templates.
+func NewGroupTasks(t *TaskList, templates map[string]*Task) ([]*Task, error) {
+	var tasks []*Task
+	for _, group := range t.Groups {
+		for i, taskName := range group.Tasks {
+			template, ok := templates[taskName]
+			if !ok {
+				return nil, errors.New(errors.NotFound, "failed to find task template")
+			}
+
+			task := Task{
+				ID:             uuid.NewString(),
+				GroupID:        group.ID,
+				Position:       i,
+				Name:           taskName,
+				State:          Created,
+				URL:            template.URL,
+				Method:         template.Method,
+				RequestPolicy:  template.RequestPolicy,
+				ResponsePolicy: template.ResponsePolicy,
+				FinalPolicy:    template.FinalPolicy,
+				CacheNamespace: template.CacheNamespace,
+				CacheScope:     template.CacheScope,
+				CreatedAt:      time.Now(),
+
+				TemplateVersion:     template.TemplateVersion,
+				AcceptedStatusCodes: template.AcceptedStatusCodes,
+				Timeout:             template.Timeout,
+			}
+
+			// if cache namespace and scope are set in the taskList, use them instead of the defaults
+			if t.CacheNamespace != "" {
+				task.CacheNamespace = t.CacheNamespace
+			}
+			if t.CacheScope != "" {
+				task.CacheScope = t.CacheScope
+			}
+
+			tasks = append(tasks, &task)
+		}
+	}
+
+	return tasks, nil
+}
+
+// NewTaskList creates a taskList from a taskList template together with
+// the tasks of its groups, which are created from the given task templates.
+func NewTaskList(template *Template, taskTemplates map[string]*Task, opts CreateOptions) (*TaskList, []*Task, error) {
+	taskList := &TaskList{
+		ID:             opts.ID,
+		Groups:         NewGroups(template, opts.Request),
+		Name:           template.Name,
+		Request:        opts.Request,
+		CacheScope:     template.CacheScope,
+		CacheNamespace: template.CacheNamespace,
+		State:          Created,
+		CreatedAt:      opts.CreatedAt,
+
+		TemplateVersion: template.TemplateVersion,
+		RunAt:           opts.RunAt,
+		Priority:        template.Priority,
+		CallbackURL:     template.CallbackURL,
+		Timeout:         template.Timeout,
+	}
+
+	if opts.Priority != nil {
+		taskList.Priority = *opts.Priority
+	}
+	override(&taskList.CallbackURL, opts.CallbackURL)
+	override(&taskList.CacheNamespace, opts.CacheNamespace)
+	override(&taskList.CacheScope, opts.CacheScope)
+
+	tasks, err := NewGroupTasks(taskList, taskTemplates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return taskList, tasks, nil
 }
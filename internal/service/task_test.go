@@ -0,0 +1,49 @@
+package service_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+func TestAppendError(t *testing.T) {
+	var full []string
+	for i := 0; i < service.MaxTaskErrors; i++ {
+		full = append(full, fmt.Sprintf("error %d", i))
+	}
+
+	tests := []struct {
+		name string
+		errs []string
+		err  string
+
+		result []string
+	}{
+		{
+			name:   "first error",
+			err:    "some error",
+			result: []string{"some error"},
+		},
+		{
+			name:   "error is appended",
+			errs:   []string{"first error"},
+			err:    "second error",
+			result: []string{"first error", "second error"},
+		},
+		{
+			name:   "oldest error is dropped",
+			errs:   full,
+			err:    "new error",
+			result: append(append([]string{}, full[1:]...), "new error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.result, service.AppendError(test.errs, test.err))
+		})
+	}
+}
@@ -15,6 +15,12 @@ import (
 	"github.com/eclipse-xfsc/task-sheduler/internal/service"
 )
 
+const (
+	// waitInterval specifies how often the state of a taskList is checked
+	// while a create request is waiting for the taskList to complete.
+	waitInterval = 100 * time.Millisecond
+)
+
 //go:generate counterfeiter . Cache
 
 type Queue interface {
@@ -30,15 +36,29 @@ type Service struct {
 	queue   Queue
 	cache   Cache
 
+	// idempotencyWindow is the time during which repeated requests
+	// with the same idempotency key return the same taskList.
+	idempotencyWindow time.Duration
+
+	// maxWait is the maximum time a create request can
+	// wait for the created taskList to complete.
+	maxWait time.Duration
+
+	// webhooks reports whether the callback URLs of taskLists are notified.
+	webhooks bool
+
 	logger *zap.Logger
 }
 
-func New(template service.Storage, queue Queue, cache Cache, logger *zap.Logger) *Service {
+func New(template service.Storage, queue Queue, cache Cache, idempotencyWindow, maxWait time.Duration, webhooks bool, logger *zap.Logger) *Service {
 	return &Service{
-		storage: template,
-		queue:   queue,
-		cache:   cache,
-		logger:  logger,
+		storage:           template,
+		queue:             queue,
+		cache:             cache,
+		idempotencyWindow: idempotencyWindow,
+		maxWait:           maxWait,
+		webhooks:          webhooks,
+		logger:            logger,
 	}
 }
 
@@ -49,19 +69,41 @@ func (s *Service) Create(ctx context.Context, req *goatasklist.CreateTaskListReq
 		return nil, errors.New(errors.BadRequest, "missing taskListName")
 	}
 
+	now := time.Now()
+	runAt, err := service.ParseRunAt(req.RunAt, req.Delay, now)
+	if err != nil {
+		return nil, err
+	}
+
+	wait, err := service.ParseWait(req.Wait, s.maxWait)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CallbackURL != nil && *req.CallbackURL != "" {
+		if err := service.ValidateCallbackURL(*req.CallbackURL); err != nil {
+			return nil, err
+		}
+	}
+
 	logger := s.logger.With(zap.String("taskListName", req.TaskListName))
 
 	// get predefined taskList definition from storage
-	template, err := s.storage.TaskListTemplate(ctx, req.TaskListName)
+	var template *service.Template
+	if req.TemplateVersion != nil {
+		template, err = s.storage.TaskListTemplateVersion(ctx, req.TaskListName, *req.TemplateVersion)
+	} else {
+		template, err = s.storage.TaskListTemplate(ctx, req.TaskListName)
+	}
 	if err != nil {
 		logger.Error("error getting taskList template from storage", zap.Error(err))
 		return nil, err
 	}
 
 	// get predefined task definitions from storage
-	taskTemplates, err := s.storage.TaskTemplates(ctx, taskNamesFromTaskListTemplate(template))
+	taskTemplates, err := s.taskTemplates(ctx, template, req.TemplateVersion != nil)
 	if err != nil {
-		logger.Error("error getting task templates from storage")
+		logger.Error("error getting task templates from storage", zap.Error(err))
 		return nil, err
 	}
 
@@ -71,39 +113,132 @@ func (s *Service) Create(ctx context.Context, req *goatasklist.CreateTaskListReq
 		return nil, errors.New(errors.BadRequest, "error marshaling request data to JSON", err)
 	}
 
-	taskList := &service.TaskList{
+	taskList, tasks, err := service.NewTaskList(template, taskTemplates, service.CreateOptions{
 		ID:             uuid.NewString(),
-		Groups:         createGroups(template, taskListRequest),
-		Name:           template.Name,
 		Request:        taskListRequest,
-		CacheScope:     template.CacheScope,
-		CacheNamespace: template.CacheNamespace,
-		State:          service.Created,
-		CreatedAt:      time.Now(),
+		CreatedAt:      now,
+		RunAt:          runAt,
+		Priority:       req.Priority,
+		CallbackURL:    req.CallbackURL,
+		CacheNamespace: req.CacheNamespace,
+		CacheScope:     req.CacheScope,
+	})
+	if err != nil {
+		logger.Error("failed to create tasks for taskList", zap.Error(err))
+		return nil, errors.New("failed to create tasks for taskList", err)
 	}
 
-	// if cache namespace and scope are given, use them instead of the defaults
-	if req.CacheNamespace != nil && *req.CacheNamespace != "" {
-		taskList.CacheNamespace = *req.CacheNamespace
-	}
-	if req.CacheScope != nil && *req.CacheScope != "" {
-		taskList.CacheScope = *req.CacheScope
+	if err := service.ValidateCallback(taskList.CallbackURL, s.webhooks); err != nil {
+		return nil, err
 	}
 
-	tasks, err := createTasks(taskList, taskTemplates)
-	if err != nil {
-		logger.Error("failed to create tasks for taskList", zap.Error(err))
-		return nil, errors.New("failed to create tasks for taskList", err)
+	if req.IdempotencyKey != nil && *req.IdempotencyKey != "" {
+		taskListID, reserved, err := s.reserveIdempotencyKey(ctx, req, taskList.ID, now)
+		if err != nil {
+			if !errors.Is(errors.Exist, err) {
+				logger.Error("error reserving idempotency key", zap.Error(err))
+			}
+			return nil, err
+		}
+		if !reserved {
+			// the taskList is already created by a previous request with the same key
+			return s.createResult(ctx, taskListID, wait), nil
+		}
 	}
 
 	if err := s.queue.AddTaskList(ctx, taskList, tasks); err != nil {
 		logger.Error("error adding taskList to queue", zap.Error(err))
+		if req.IdempotencyKey != nil && *req.IdempotencyKey != "" {
+			if err := s.storage.DeleteIdempotencyKey(ctx, *req.IdempotencyKey); err != nil {
+				logger.Error("error deleting idempotency key", zap.Error(err))
+			}
+		}
 		return nil, errors.New("error adding taskList to queue", err)
 	}
 
-	return &goatasklist.CreateTaskListResult{
-		TaskListID: taskList.ID,
-	}, nil
+	return s.createResult(ctx, taskList.ID, wait), nil
+}
+
+// createResult waits up to the given time for the taskList to complete and
+// returns its state and result. If the taskList is not completed in time, the
+// result is not set and the client can retrieve it later with the taskList ID.
+func (s *Service) createResult(ctx context.Context, taskListID string, wait time.Duration) *goatasklist.CreateTaskListResult {
+	res := &goatasklist.CreateTaskListResult{TaskListID: taskListID}
+	if wait == 0 {
+		return res
+	}
+
+	logger := s.logger.With(zap.String("taskListID", taskListID))
+
+	list, err := s.waitTaskList(ctx, taskListID, wait)
+	if err != nil {
+		if !errors.Is(errors.NotFound, err) && ctx.Err() == nil {
+			logger.Error("error waiting for taskList to complete", zap.Error(err))
+		}
+		return res
+	}
+
+	res.State = ptr.String(string(list.State))
+	if list.State == service.Done || list.State == service.Failed {
+		result, err := s.TaskListStatus(ctx, &goatasklist.TaskListStatusRequest{TaskListID: taskListID})
+		if err != nil {
+			logger.Error("error getting result of completed taskList", zap.Error(err))
+			return res
+		}
+		res.Result = result
+	}
+
+	return res
+}
+
+// waitTaskList waits until the taskList is moved to the history of executed
+// taskLists or the wait time expires, and returns the last known state of the taskList.
+func (s *Service) waitTaskList(ctx context.Context, taskListID string, wait time.Duration) (*service.TaskList, error) {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(waitInterval)
+	defer ticker.Stop()
+
+	for {
+		list, err := s.storage.TaskListHistory(ctx, taskListID)
+		if err == nil {
+			return list, nil
+		}
+		if !errors.Is(errors.NotFound, err) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return s.storage.TaskList(ctx, taskListID)
+		case <-ticker.C:
+		}
+	}
+}
+
+// reserveIdempotencyKey reserves the idempotency key of the request for the
+// taskList being created. If the key was already used by the same request,
+// the ID of the originally created taskList is returned and reserved is false.
+func (s *Service) reserveIdempotencyKey(ctx context.Context, req *goatasklist.CreateTaskListRequest, taskListID string, now time.Time) (id string, reserved bool, err error) {
+	// the wait time and the key don't change what is created by the request
+	hashed := *req
+	hashed.Wait = nil
+	hashed.IdempotencyKey = nil
+	hash, err := service.RequestHash("taskList", &hashed)
+	if err != nil {
+		return "", false, err
+	}
+
+	return service.ReserveIdempotencyKey(ctx, s.storage, &service.IdempotencyKey{
+		Key:         *req.IdempotencyKey,
+		RequestHash: hash,
+		ResourceID:  taskListID,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.idempotencyWindow),
+	})
 }
 
 // TaskListStatus retrieves a taskList result containing all tasks' unique IDs
@@ -159,62 +294,37 @@ func (s *Service) TaskListStatus(ctx context.Context, req *goatasklist.TaskListS
 	return result, nil
 }
 
-func createGroups(t *service.Template, req []byte) []service.Group {
-	var groups []service.Group
-	for _, group := range t.Groups {
-		g := service.Group{
-			ID:          uuid.NewString(),
-			Execution:   group.Execution,
-			Tasks:       group.Tasks,
-			State:       service.Created,
-			Request:     req,
-			FinalPolicy: group.FinalPolicy,
-		}
-		groups = append(groups, g)
+// Search taskLists in the queue and the history of executed taskLists
+// filtered by the given criteria.
+func (s *Service) Search(ctx context.Context, req *goatasklist.SearchTaskListsRequest) (*goatasklist.SearchTaskListsResponse, error) {
+	filter, err := service.SearchFilter((*service.SearchRequest)(req))
+	if err != nil {
+		return nil, err
 	}
 
-	return groups
-}
-
-// createTasks creates task.Task instances out of task templates
-// in order to be added to queue for execution
-func createTasks(t *service.TaskList, templates map[string]*service.Task) ([]*service.Task, error) {
-	var tasks []*service.Task
-	for _, group := range t.Groups {
-		for _, taskName := range group.Tasks {
-			template, ok := templates[taskName]
-			if !ok {
-				return nil, errors.New(errors.NotFound, "failed to find task template")
-			}
+	limit := filter.Limit
+	// request one more taskList to find out if there is a next page
+	filter.Limit++
 
-			task := service.Task{
-				ID:             uuid.NewString(),
-				GroupID:        group.ID,
-				Name:           taskName,
-				State:          service.Created,
-				URL:            template.URL,
-				Method:         template.Method,
-				RequestPolicy:  template.RequestPolicy,
-				ResponsePolicy: template.ResponsePolicy,
-				FinalPolicy:    template.FinalPolicy,
-				CacheNamespace: template.CacheNamespace,
-				CacheScope:     template.CacheScope,
-				CreatedAt:      time.Now(),
-			}
+	lists, err := s.storage.TaskLists(ctx, filter)
+	if err != nil {
+		s.logger.Error("error getting taskLists from storage", zap.Error(err))
+		return nil, err
+	}
 
-			// if cache namespace and scope are set in the taskList, use them instead of the defaults
-			if t.CacheNamespace != "" {
-				task.CacheNamespace = t.CacheNamespace
-			}
-			if t.CacheScope != "" {
-				task.CacheScope = t.CacheScope
-			}
+	res := &goatasklist.SearchTaskListsResponse{TaskLists: []*goatasklist.TaskListSummary{}}
+	if len(lists) > limit {
+		lists = lists[:limit]
+		last := lists[len(lists)-1]
+		cursor := service.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+		res.Next = ptr.String(cursor.Encode())
+	}
 
-			tasks = append(tasks, &task)
-		}
+	for _, list := range lists {
+		res.TaskLists = append(res.TaskLists, taskListSummary(list))
 	}
 
-	return tasks, nil
+	return res, nil
 }
 
 func (s *Service) calculateState(ctx context.Context, list *service.TaskList) (*goatasklist.TaskListStatusResponse, error) {
@@ -222,6 +332,9 @@ func (s *Service) calculateState(ctx context.Context, list *service.TaskList) (*
 		ID:     list.ID,
 		Status: string(list.State),
 	}
+	if !list.RunAt.IsZero() {
+		result.RunAt = ptr.String(list.RunAt.Format(time.RFC3339))
+	}
 
 	for i := range list.Groups {
 		groupState := goatasklist.GroupStatus{
@@ -247,6 +360,35 @@ func (s *Service) calculateState(ctx context.Context, list *service.TaskList) (*
 	return result, nil
 }
 
+// taskTemplates retrieves the task templates of the tasks of a taskList
+// template. A pinned version of the taskList template uses the versions of
+// the task templates which were recorded with it, while the latest version
+// uses the latest task templates.
+func (s *Service) taskTemplates(ctx context.Context, template *service.Template, pinned bool) (map[string]*service.Task, error) {
+	names := taskNamesFromTaskListTemplate(template)
+	if !pinned || len(template.TaskVersions) == 0 {
+		return s.storage.TaskTemplates(ctx, names)
+	}
+
+	templates := make(map[string]*service.Task, len(names))
+	for _, name := range names {
+		if _, ok := templates[name]; ok {
+			continue
+		}
+		version, ok := template.TaskVersions[name]
+		if !ok {
+			return nil, errors.New(errors.NotFound, "task template version not found: "+name)
+		}
+		task, err := s.storage.TaskTemplateVersion(ctx, name, version)
+		if err != nil {
+			return nil, err
+		}
+		templates[name] = task
+	}
+
+	return templates, nil
+}
+
 // taskNamesFromTaskListTemplate returns the names of all tasks within
 // one taskList template
 func taskNamesFromTaskListTemplate(template *service.Template) []string {
@@ -257,3 +399,38 @@ func taskNamesFromTaskListTemplate(template *service.Template) []string {
 
 	return names
 }
+
+// taskListSummary converts a taskList to its summary representation,
+// omitting the fields which are not set.
+func taskListSummary(list *service.TaskList) *goatasklist.TaskListSummary {
+	summary := &goatasklist.TaskListSummary{
+		ID:        list.ID,
+		Name:      list.Name,
+		State:     string(list.State),
+		CreatedAt: list.CreatedAt.Format(time.RFC3339),
+	}
+
+	if list.CacheNamespace != "" {
+		summary.CacheNamespace = &list.CacheNamespace
+	}
+	if list.CacheScope != "" {
+		summary.CacheScope = &list.CacheScope
+	}
+	if list.TemplateVersion != 0 {
+		summary.TemplateVersion = &list.TemplateVersion
+	}
+	if !list.RunAt.IsZero() {
+		summary.RunAt = ptr.String(list.RunAt.Format(time.RFC3339))
+	}
+	if list.Priority != 0 {
+		summary.Priority = &list.Priority
+	}
+	if !list.StartedAt.IsZero() {
+		summary.StartedAt = ptr.String(list.StartedAt.Format(time.RFC3339))
+	}
+	if !list.FinishedAt.IsZero() {
+		summary.FinishedAt = ptr.String(list.FinishedAt.Format(time.RFC3339))
+	}
+
+	return summary
+}
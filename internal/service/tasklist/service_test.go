@@ -3,11 +3,13 @@ package tasklist_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
 	goatasklist "github.com/eclipse-xfsc/task-sheduler/gen/task_list"
 	"github.com/eclipse-xfsc/task-sheduler/internal/service"
 	"github.com/eclipse-xfsc/task-sheduler/internal/service/servicefakes"
@@ -16,7 +18,7 @@ import (
 )
 
 func TestNew(t *testing.T) {
-	svc := tasklist.New(nil, nil, nil, zap.NewNop())
+	svc := tasklist.New(nil, nil, nil, time.Hour, time.Second, true, zap.NewNop())
 	assert.Implements(t, (*goatasklist.Service)(nil), svc)
 }
 
@@ -27,6 +29,9 @@ func Test_Create(t *testing.T) {
 		storage *servicefakes.FakeStorage
 		queue   *servicefakes.FakeQueue
 
+		// noWebhooks disables the webhooks of the service
+		noWebhooks bool
+
 		errkind errors.Kind
 		errtext string
 	}{
@@ -47,6 +52,21 @@ func Test_Create(t *testing.T) {
 			errkind: errors.NotFound,
 			errtext: "not found",
 		},
+		{
+			name:       "template has a callbackURL when webhooks are disabled",
+			req:        &goatasklist.CreateTaskListRequest{TaskListName: "taskList name"},
+			noWebhooks: true,
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateStub: func(ctx context.Context, s string) (*service.Template, error) {
+					return &service.Template{CallbackURL: "https://example.com/hook"}, nil
+				},
+				TaskTemplatesStub: func(ctx context.Context, strings []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "webhooks are disabled",
+		},
 		{
 			name: "error getting task templates form storage",
 			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name"},
@@ -100,6 +120,177 @@ func Test_Create(t *testing.T) {
 			errkind: errors.Unknown,
 			errtext: "storage error",
 		},
+		{
+			name:    "both runAt and delay are given",
+			req:     &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", RunAt: ptr.String("2023-01-01T10:00:00Z"), Delay: ptr.String("10m")},
+			errkind: errors.BadRequest,
+			errtext: "runAt and delay cannot be used together",
+		},
+		{
+			name: "successfully add delayed taskList and tasks to queue",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", Delay: ptr.String("1h")},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateStub: func(ctx context.Context, s string) (*service.Template, error) {
+					return &service.Template{}, nil
+				},
+				TaskTemplatesStub: func(ctx context.Context, strings []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddTaskListStub: func(ctx context.Context, list *service.TaskList, tasks []*service.Task) error {
+					if list.RunAt.Sub(list.CreatedAt) < time.Hour {
+						return errors.New("unexpected runAt")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully add taskList with template priority to queue",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name"},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateStub: func(ctx context.Context, s string) (*service.Template, error) {
+					return &service.Template{Priority: 3}, nil
+				},
+				TaskTemplatesStub: func(ctx context.Context, strings []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddTaskListStub: func(ctx context.Context, list *service.TaskList, tasks []*service.Task) error {
+					if list.Priority != 3 {
+						return errors.New("unexpected priority")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully add taskList with priority override to queue",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", Priority: ptr.Int(9)},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateStub: func(ctx context.Context, s string) (*service.Template, error) {
+					return &service.Template{Priority: 3}, nil
+				},
+				TaskTemplatesStub: func(ctx context.Context, strings []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddTaskListStub: func(ctx context.Context, list *service.TaskList, tasks []*service.Task) error {
+					if list.Priority != 9 {
+						return errors.New("unexpected priority")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name:    "invalid callbackURL",
+			req:     &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", CallbackURL: ptr.String("ftp://example.com/hook")},
+			errkind: errors.BadRequest,
+			errtext: "invalid callbackURL",
+		},
+		{
+			name: "successfully add taskList with template callbackURL to queue",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name"},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateStub: func(ctx context.Context, s string) (*service.Template, error) {
+					return &service.Template{CallbackURL: "https://example.com/hook"}, nil
+				},
+				TaskTemplatesStub: func(ctx context.Context, strings []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddTaskListStub: func(ctx context.Context, list *service.TaskList, tasks []*service.Task) error {
+					if list.CallbackURL != "https://example.com/hook" {
+						return errors.New("unexpected callbackURL")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "taskList template version not found",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", TemplateVersion: ptr.Int(2)},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateVersionStub: func(ctx context.Context, s string, version int) (*service.Template, error) {
+					return nil, errors.New(errors.NotFound, "taskList template version not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "taskList template version not found",
+		},
+		{
+			name: "successfully add taskList and tasks to queue with template version",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", TemplateVersion: ptr.Int(2)},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateVersionStub: func(ctx context.Context, s string, version int) (*service.Template, error) {
+					return &service.Template{
+						Groups:          []service.GroupTemplate{{Tasks: []string{"template"}}},
+						TemplateVersion: version,
+					}, nil
+				},
+				TaskTemplatesStub: func(ctx context.Context, strings []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{"template": {TemplateVersion: 3}}, nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddTaskListStub: func(ctx context.Context, list *service.TaskList, tasks []*service.Task) error {
+					if list.TemplateVersion != 2 || tasks[0].TemplateVersion != 3 {
+						return errors.New("unexpected template version")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "task template version of pinned taskList template not found",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", TemplateVersion: ptr.Int(2)},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateVersionStub: func(ctx context.Context, s string, version int) (*service.Template, error) {
+					return &service.Template{
+						Groups:          []service.GroupTemplate{{Tasks: []string{"template"}}},
+						TemplateVersion: version,
+						TaskVersions:    map[string]int{"template": 1},
+					}, nil
+				},
+				TaskTemplateVersionStub: func(ctx context.Context, s string, version int) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound, "task template version not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "task template version not found",
+		},
+		{
+			name: "successfully add taskList and tasks to queue with pinned task template versions",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", TemplateVersion: ptr.Int(2)},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplateVersionStub: func(ctx context.Context, s string, version int) (*service.Template, error) {
+					return &service.Template{
+						Groups:          []service.GroupTemplate{{Tasks: []string{"template"}}},
+						TemplateVersion: version,
+						TaskVersions:    map[string]int{"template": 1},
+					}, nil
+				},
+				TaskTemplateVersionStub: func(ctx context.Context, s string, version int) (*service.Task, error) {
+					return &service.Task{Name: s, TemplateVersion: version}, nil
+				},
+				TaskTemplatesStub: func(ctx context.Context, strings []string) (map[string]*service.Task, error) {
+					return nil, errors.New("latest task templates must not be used")
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddTaskListStub: func(ctx context.Context, list *service.TaskList, tasks []*service.Task) error {
+					if list.TemplateVersion != 2 || tasks[0].TemplateVersion != 1 {
+						return errors.New("unexpected template version")
+					}
+					return nil
+				},
+			},
+		},
 		{
 			name: "successfully add taskList and tasks to queue",
 			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name"},
@@ -121,7 +312,7 @@ func Test_Create(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			svc := tasklist.New(test.storage, test.queue, nil, zap.NewNop())
+			svc := tasklist.New(test.storage, test.queue, nil, time.Hour, time.Second, !test.noWebhooks, zap.NewNop())
 			res, err := svc.Create(context.Background(), test.req)
 			if err != nil {
 				assert.NotEmpty(t, test.errtext)
@@ -140,6 +331,230 @@ func Test_Create(t *testing.T) {
 	}
 }
 
+func Test_CreateIdempotent(t *testing.T) {
+	req := &goatasklist.CreateTaskListRequest{
+		TaskListName:   "taskList name",
+		Data:           map[string]interface{}{"key": "value"},
+		IdempotencyKey: ptr.String("key-1"),
+	}
+	// the idempotency key and the wait time are not part of the hash
+	hash, err := service.RequestHash("taskList", &goatasklist.CreateTaskListRequest{TaskListName: req.TaskListName, Data: req.Data})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		req     *goatasklist.CreateTaskListRequest
+		storage *servicefakes.FakeStorage
+		queue   *servicefakes.FakeQueue
+
+		taskListID string
+		added      int
+		deleted    int
+		errkind    errors.Kind
+		errtext    string
+	}{
+		{
+			name: "new idempotency key",
+			req:  req,
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					if key.Key != "key-1" || key.RequestHash != hash {
+						return errors.New("unexpected idempotency key")
+					}
+					return nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{},
+			added: 1,
+		},
+		{
+			name: "repeated request with the same idempotency key",
+			req:  req,
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					return errors.New(errors.Exist, "idempotency key already exists")
+				},
+				IdempotencyKeyStub: func(ctx context.Context, key string) (*service.IdempotencyKey, error) {
+					return &service.IdempotencyKey{Key: key, RequestHash: hash, ResourceID: "123"}, nil
+				},
+			},
+			queue:      &servicefakes.FakeQueue{},
+			taskListID: "123",
+		},
+		{
+			name: "repeated request with the same idempotency key and a different wait time",
+			req: &goatasklist.CreateTaskListRequest{
+				TaskListName:   "taskList name",
+				Data:           map[string]interface{}{"key": "value"},
+				Wait:           ptr.String("0s"),
+				IdempotencyKey: ptr.String("key-1"),
+			},
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					return errors.New(errors.Exist, "idempotency key already exists")
+				},
+				IdempotencyKeyStub: func(ctx context.Context, key string) (*service.IdempotencyKey, error) {
+					return &service.IdempotencyKey{Key: key, RequestHash: hash, ResourceID: "123"}, nil
+				},
+			},
+			queue:      &servicefakes.FakeQueue{},
+			taskListID: "123",
+		},
+		{
+			name: "idempotency key is used with a different request",
+			req: &goatasklist.CreateTaskListRequest{
+				TaskListName:   "taskList name",
+				Data:           map[string]interface{}{"key": "value"},
+				Priority:       ptr.Int(9),
+				IdempotencyKey: ptr.String("key-1"),
+			},
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					return errors.New(errors.Exist, "idempotency key already exists")
+				},
+				IdempotencyKeyStub: func(ctx context.Context, key string) (*service.IdempotencyKey, error) {
+					return &service.IdempotencyKey{Key: key, RequestHash: hash, ResourceID: "123"}, nil
+				},
+			},
+			queue:   &servicefakes.FakeQueue{},
+			errkind: errors.Exist,
+			errtext: "idempotency key is already used with a different request",
+		},
+		{
+			name: "idempotency key is deleted when adding the taskList to queue fails",
+			req:  req,
+			storage: &servicefakes.FakeStorage{
+				AddIdempotencyKeyStub: func(ctx context.Context, key *service.IdempotencyKey) error {
+					return nil
+				},
+			},
+			queue: &servicefakes.FakeQueue{
+				AddTaskListStub: func(ctx context.Context, list *service.TaskList, tasks []*service.Task) error {
+					return errors.New("some error")
+				},
+			},
+			added:   1,
+			deleted: 1,
+			errkind: errors.Unknown,
+			errtext: "error adding taskList to queue",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.storage.TaskListTemplateStub = func(ctx context.Context, s string) (*service.Template, error) {
+				return &service.Template{}, nil
+			}
+			test.storage.TaskTemplatesStub = func(ctx context.Context, strings []string) (map[string]*service.Task, error) {
+				return map[string]*service.Task{}, nil
+			}
+
+			svc := tasklist.New(test.storage, test.queue, nil, time.Hour, time.Second, true, zap.NewNop())
+			res, err := svc.Create(context.Background(), test.req)
+			assert.Equal(t, test.added, test.queue.AddTaskListCallCount())
+			assert.Equal(t, test.deleted, test.storage.DeleteIdempotencyKeyCallCount())
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.NotEmpty(t, res.TaskListID)
+				if test.taskListID != "" {
+					assert.Equal(t, test.taskListID, res.TaskListID)
+				}
+			}
+		})
+	}
+}
+
+func Test_CreateWait(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goatasklist.CreateTaskListRequest
+		storage *servicefakes.FakeStorage
+		cache   *tasklistfakes.FakeCache
+
+		res     *goatasklist.CreateTaskListResult
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "invalid wait",
+			req:     &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", Wait: ptr.String("soon")},
+			storage: &servicefakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: "invalid wait format",
+		},
+		{
+			name: "taskList is completed within the wait time",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", Wait: ptr.String("1m")},
+			storage: &servicefakes.FakeStorage{
+				TaskListHistoryStub: func(ctx context.Context, taskListID string) (*service.TaskList, error) {
+					return &service.TaskList{ID: taskListID, State: service.Done}, nil
+				},
+			},
+			cache: &tasklistfakes.FakeCache{
+				GetStub: func(ctx context.Context, key, namespace, scope string) ([]byte, error) {
+					return []byte(`{"id":"` + key + `","status":"done"}`), nil
+				},
+			},
+			res: &goatasklist.CreateTaskListResult{
+				State:  ptr.String("done"),
+				Result: &goatasklist.TaskListStatusResponse{Status: "done"},
+			},
+		},
+		{
+			name: "taskList is not completed within the wait time",
+			req:  &goatasklist.CreateTaskListRequest{TaskListName: "taskList name", Wait: ptr.String("10ms")},
+			storage: &servicefakes.FakeStorage{
+				TaskListHistoryStub: func(ctx context.Context, taskListID string) (*service.TaskList, error) {
+					return nil, errors.New(errors.NotFound, "taskList not found in history")
+				},
+				TaskListStub: func(ctx context.Context, taskListID string) (*service.TaskList, error) {
+					return &service.TaskList{ID: taskListID, State: service.Created}, nil
+				},
+			},
+			res: &goatasklist.CreateTaskListResult{State: ptr.String("created")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.storage.TaskListTemplateStub = func(ctx context.Context, s string) (*service.Template, error) {
+				return &service.Template{}, nil
+			}
+			test.storage.TaskTemplatesStub = func(ctx context.Context, strings []string) (map[string]*service.Task, error) {
+				return map[string]*service.Task{}, nil
+			}
+
+			svc := tasklist.New(test.storage, &servicefakes.FakeQueue{}, test.cache, time.Hour, time.Second, true, zap.NewNop())
+			res, err := svc.Create(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.NotEmpty(t, res.TaskListID)
+				assert.Equal(t, test.res.State, res.State)
+				if test.res.Result != nil {
+					assert.Equal(t, res.TaskListID, res.Result.ID)
+					assert.Equal(t, test.res.Result.Status, res.Result.Status)
+				} else {
+					assert.Nil(t, res.Result)
+				}
+			}
+		})
+	}
+}
+
 func Test_TaskListStatus(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -256,7 +671,7 @@ func Test_TaskListStatus(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			svc := tasklist.New(test.storage, test.queue, test.cache, zap.NewNop())
+			svc := tasklist.New(test.storage, test.queue, test.cache, time.Hour, time.Second, true, zap.NewNop())
 			res, err := svc.TaskListStatus(context.Background(), test.req)
 			if err != nil {
 				assert.NotEmpty(t, test.errtext)
@@ -276,6 +691,94 @@ func Test_TaskListStatus(t *testing.T) {
 	}
 }
 
+func Test_Search(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	cursor := service.Cursor{CreatedAt: createdAt, ID: "2"}
+
+	tests := []struct {
+		name    string
+		req     *goatasklist.SearchTaskListsRequest
+		storage *servicefakes.FakeStorage
+
+		ids     []string
+		next    *string
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "invalid createdBefore time",
+			req:     &goatasklist.SearchTaskListsRequest{CreatedBefore: ptr.String("tomorrow")},
+			errkind: errors.BadRequest,
+			errtext: "invalid time format",
+		},
+		{
+			name: "error getting taskLists from storage",
+			req:  &goatasklist.SearchTaskListsRequest{Limit: 2},
+			storage: &servicefakes.FakeStorage{
+				TaskListsStub: func(ctx context.Context, filter *service.Filter) ([]*service.TaskList, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "last page of taskLists",
+			req:  &goatasklist.SearchTaskListsRequest{Limit: 2, Sort: "createdAt"},
+			storage: &servicefakes.FakeStorage{
+				TaskListsStub: func(ctx context.Context, filter *service.Filter) ([]*service.TaskList, error) {
+					if filter.Descending {
+						return nil, errors.New("unexpected sort order")
+					}
+					return []*service.TaskList{{ID: "1", CreatedAt: createdAt}}, nil
+				},
+			},
+			ids: []string{"1"},
+		},
+		{
+			name: "taskLists with next page",
+			req:  &goatasklist.SearchTaskListsRequest{Limit: 2, CacheNamespace: ptr.String("login")},
+			storage: &servicefakes.FakeStorage{
+				TaskListsStub: func(ctx context.Context, filter *service.Filter) ([]*service.TaskList, error) {
+					if filter.Limit != 3 || filter.CacheNamespace != "login" {
+						return nil, errors.New("unexpected filter")
+					}
+					return []*service.TaskList{
+						{ID: "1", CreatedAt: createdAt},
+						{ID: "2", CreatedAt: createdAt},
+						{ID: "3", CreatedAt: createdAt},
+					}, nil
+				},
+			},
+			ids:  []string{"1", "2"},
+			next: ptr.String(cursor.Encode()),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := tasklist.New(test.storage, nil, nil, time.Hour, time.Second, true, zap.NewNop())
+			res, err := svc.Search(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				ids := []string{}
+				for _, list := range res.TaskLists {
+					ids = append(ids, list.ID)
+				}
+				assert.Equal(t, test.ids, ids)
+				assert.Equal(t, test.next, res.Next)
+			}
+		})
+	}
+}
+
 //nolint:gosec
 var pendingTaskList = &service.TaskList{
 	ID:    "16996cd-1977-42a9-90b2-b4548a35c1b4",
@@ -0,0 +1,282 @@
+package tasklisttemplate
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goatasklisttemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_list_template"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+type Service struct {
+	storage service.Storage
+	logger  *zap.Logger
+
+	// webhooks reports whether callback URLs are notified.
+	webhooks bool
+}
+
+// New creates the taskListTemplate service.
+func New(storage service.Storage, webhooks bool, logger *zap.Logger) *Service {
+	return &Service{
+		storage:  storage,
+		logger:   logger,
+		webhooks: webhooks,
+	}
+}
+
+// Create a taskList template.
+func (s *Service) Create(ctx context.Context, req *goatasklisttemplate.TaskListTemplate) (*goatasklisttemplate.TaskListTemplate, error) {
+	template, err := fromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.AddTaskListTemplate(ctx, template); err != nil {
+		if !errors.Is(errors.Exist, err) {
+			s.logger.Error("error adding taskList template to storage", zap.String("taskListName", template.Name), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return toResponse(template), nil
+}
+
+// Get a taskList template by name. The latest version of the template
+// is returned, unless a specific version is requested.
+func (s *Service) Get(ctx context.Context, req *goatasklisttemplate.TaskListTemplateVersionRequest) (*goatasklisttemplate.TaskListTemplate, error) {
+	if req.Name == "" {
+		return nil, errors.New(errors.BadRequest, "missing name")
+	}
+
+	var template *service.Template
+	var err error
+	if req.Version != nil {
+		template, err = s.storage.TaskListTemplateVersion(ctx, req.Name, *req.Version)
+	} else {
+		template, err = s.storage.TaskListTemplate(ctx, req.Name)
+	}
+	if err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			s.logger.Error("error getting taskList template from storage", zap.String("taskListName", req.Name), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return toResponse(template), nil
+}
+
+// Update an existing taskList template. The updated template is saved
+// as a new version, which becomes the latest version of the template.
+func (s *Service) Update(ctx context.Context, req *goatasklisttemplate.TaskListTemplate) (*goatasklisttemplate.TaskListTemplate, error) {
+	template, err := fromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.UpdateTaskListTemplate(ctx, template); err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			s.logger.Error("error updating taskList template in storage", zap.String("taskListName", template.Name), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return toResponse(template), nil
+}
+
+// Delete a taskList template by name.
+func (s *Service) Delete(ctx context.Context, req *goatasklisttemplate.TaskListTemplateRequest) error {
+	if req.Name == "" {
+		return errors.New(errors.BadRequest, "missing name")
+	}
+
+	if err := s.storage.DeleteTaskListTemplate(ctx, req.Name); err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			s.logger.Error("error deleting taskList template from storage", zap.String("taskListName", req.Name), zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// List all taskList templates.
+func (s *Service) List(ctx context.Context) (*goatasklisttemplate.TaskListTemplatesResponse, error) {
+	templates, err := s.storage.ListTaskListTemplates(ctx)
+	if err != nil {
+		s.logger.Error("error getting taskList templates from storage", zap.Error(err))
+		return nil, err
+	}
+
+	res := &goatasklisttemplate.TaskListTemplatesResponse{Templates: []*goatasklisttemplate.TaskListTemplate{}}
+	for _, template := range templates {
+		res.Templates = append(res.Templates, toResponse(template))
+	}
+
+	return res, nil
+}
+
+// validate checks that a taskList template can be instantiated and executed.
+// Every group must have a known execution mode and every task in the groups
+// must refer to an existing task template. The versions of the task templates
+// are recorded in the taskList template.
+func (s *Service) validate(ctx context.Context, template *service.Template) error {
+	if template.Name == "" {
+		return errors.New(errors.BadRequest, "missing name")
+	}
+
+	if len(template.Groups) == 0 {
+		return errors.New(errors.BadRequest, "invalid taskList template: must contain at least one group")
+	}
+
+	if template.CallbackURL != "" {
+		if err := service.ValidateCallback(template.CallbackURL, s.webhooks); err != nil {
+			return errors.New(errors.BadRequest, "invalid taskList template", err)
+		}
+	}
+
+	if template.Timeout < 0 {
+		return errors.New(errors.BadRequest, "invalid taskList template: timeout cannot be negative")
+	}
+
+	var names []string
+	for _, group := range template.Groups {
+		if group.Execution != service.Sequential && group.Execution != service.Parallel {
+			return errors.New(errors.BadRequest, "invalid taskList template: unknown group execution: "+group.Execution)
+		}
+		if len(group.Tasks) == 0 {
+			return errors.New(errors.BadRequest, "invalid taskList template: group must contain at least one task")
+		}
+		if group.Timeout < 0 {
+			return errors.New(errors.BadRequest, "invalid taskList template: group timeout cannot be negative")
+		}
+		names = append(names, group.Tasks...)
+	}
+
+	taskTemplates, err := s.storage.TaskTemplates(ctx, names)
+	if err != nil {
+		s.logger.Error("error getting task templates from storage", zap.Error(err))
+		return err
+	}
+
+	missing := make(map[string]bool)
+	for _, name := range names {
+		if _, ok := taskTemplates[name]; !ok {
+			missing[name] = true
+		}
+	}
+
+	if len(missing) > 0 {
+		var missingNames []string
+		for name := range missing {
+			missingNames = append(missingNames, name)
+		}
+		sort.Strings(missingNames)
+		return errors.New(errors.BadRequest, "invalid taskList template: task templates not found: "+strings.Join(missingNames, ", "))
+	}
+
+	// record the versions of the task templates, so that taskLists created
+	// from this version of the template use the same task templates
+	template.TaskVersions = make(map[string]int, len(taskTemplates))
+	for name, task := range taskTemplates {
+		template.TaskVersions[name] = task.TemplateVersion
+	}
+
+	return nil
+}
+
+func fromRequest(req *goatasklisttemplate.TaskListTemplate) (*service.Template, error) {
+	template := &service.Template{Name: req.Name}
+	if req.CacheNamespace != nil {
+		template.CacheNamespace = *req.CacheNamespace
+	}
+	if req.CacheScope != nil {
+		template.CacheScope = *req.CacheScope
+	}
+	if req.Priority != nil {
+		template.Priority = *req.Priority
+	}
+	if req.CallbackURL != nil {
+		template.CallbackURL = *req.CallbackURL
+	}
+	if req.Timeout != nil {
+		d, err := time.ParseDuration(*req.Timeout)
+		if err != nil {
+			return nil, errors.New(errors.BadRequest, "invalid taskList template: invalid timeout", err)
+		}
+		template.Timeout = d
+	}
+
+	for _, g := range req.Groups {
+		group := service.GroupTemplate{
+			Execution: g.Execution,
+			Tasks:     g.Tasks,
+		}
+		if g.FinalPolicy != nil {
+			group.FinalPolicy = *g.FinalPolicy
+		}
+		if g.Timeout != nil {
+			d, err := time.ParseDuration(*g.Timeout)
+			if err != nil {
+				return nil, errors.New(errors.BadRequest, "invalid taskList template: invalid group timeout", err)
+			}
+			group.Timeout = d
+		}
+		template.Groups = append(template.Groups, group)
+	}
+
+	return template, nil
+}
+
+func toResponse(template *service.Template) *goatasklisttemplate.TaskListTemplate {
+	res := &goatasklisttemplate.TaskListTemplate{
+		Name:   template.Name,
+		Groups: []*goatasklisttemplate.GroupTemplate{},
+	}
+	if template.CacheNamespace != "" {
+		res.CacheNamespace = &template.CacheNamespace
+	}
+	if template.CacheScope != "" {
+		res.CacheScope = &template.CacheScope
+	}
+	if template.TemplateVersion != 0 {
+		res.Version = &template.TemplateVersion
+	}
+	if template.Priority != 0 {
+		res.Priority = &template.Priority
+	}
+	if template.CallbackURL != "" {
+		res.CallbackURL = &template.CallbackURL
+	}
+	if template.Timeout != 0 {
+		res.Timeout = ptr.String(template.Timeout.String())
+	}
+
+	for i := range template.Groups {
+		group := &goatasklisttemplate.GroupTemplate{
+			Execution: template.Groups[i].Execution,
+			Tasks:     template.Groups[i].Tasks,
+		}
+		if template.Groups[i].FinalPolicy != "" {
+			group.FinalPolicy = &template.Groups[i].FinalPolicy
+		}
+		if template.Groups[i].Timeout != 0 {
+			group.Timeout = ptr.String(template.Groups[i].Timeout.String())
+		}
+		res.Groups = append(res.Groups, group)
+	}
+
+	return res
+}
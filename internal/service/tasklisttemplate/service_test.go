@@ -0,0 +1,358 @@
+package tasklisttemplate_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goatasklisttemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_list_template"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/servicefakes"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/tasklisttemplate"
+)
+
+func TestNew(t *testing.T) {
+	svc := tasklisttemplate.New(nil, true, zap.NewNop())
+	assert.Implements(t, (*goatasklisttemplate.Service)(nil), svc)
+}
+
+func TestService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goatasklisttemplate.TaskListTemplate
+		storage *servicefakes.FakeStorage
+
+		// noWebhooks disables the webhooks of the service
+		noWebhooks bool
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing name",
+			req:     &goatasklisttemplate.TaskListTemplate{},
+			errkind: errors.BadRequest,
+			errtext: "missing name",
+		},
+		{
+			name:    "no groups",
+			req:     &goatasklisttemplate.TaskListTemplate{Name: "list"},
+			errkind: errors.BadRequest,
+			errtext: "must contain at least one group",
+		},
+		{
+			name: "unknown group execution",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:   "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{{Execution: "random", Tasks: []string{"task1"}}},
+			},
+			errkind: errors.BadRequest,
+			errtext: "unknown group execution: random",
+		},
+		{
+			name: "group without tasks",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:   "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{{Execution: service.Parallel}},
+			},
+			errkind: errors.BadRequest,
+			errtext: "group must contain at least one task",
+		},
+		{
+			name: "invalid callbackURL",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:        "list",
+				Groups:      []*goatasklisttemplate.GroupTemplate{{Execution: service.Parallel, Tasks: []string{"task1"}}},
+				CallbackURL: ptr.String("mailto:someone@example.com"),
+			},
+			errkind: errors.BadRequest,
+			errtext: "invalid callbackURL",
+		},
+		{
+			name: "callbackURL when webhooks are disabled",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:        "list",
+				Groups:      []*goatasklisttemplate.GroupTemplate{{Execution: service.Parallel, Tasks: []string{"task1"}}},
+				CallbackURL: ptr.String("https://example.com/hook"),
+			},
+			noWebhooks: true,
+			errkind:    errors.BadRequest,
+			errtext:    "webhooks are disabled",
+		},
+		{
+			name: "invalid group timeout",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:   "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{{Execution: service.Parallel, Tasks: []string{"task1"}, Timeout: ptr.String("5 minutes")}},
+			},
+			errkind: errors.BadRequest,
+			errtext: "invalid group timeout",
+		},
+		{
+			name: "negative timeout",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:    "list",
+				Groups:  []*goatasklisttemplate.GroupTemplate{{Execution: service.Parallel, Tasks: []string{"task1"}}},
+				Timeout: ptr.String("-10m"),
+			},
+			errkind: errors.BadRequest,
+			errtext: "timeout cannot be negative",
+		},
+		{
+			name: "error getting task templates",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:   "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{{Execution: service.Sequential, Tasks: []string{"task1"}}},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplatesStub: func(ctx context.Context, names []string) (map[string]*service.Task, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "task templates not found",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name: "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{
+					{Execution: service.Sequential, Tasks: []string{"task1", "task3"}},
+					{Execution: service.Parallel, Tasks: []string{"task2", "task3"}},
+				},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplatesStub: func(ctx context.Context, names []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{"task1": {Name: "task1"}}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task templates not found: task2, task3",
+		},
+		{
+			name: "taskList template already exists",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:   "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{{Execution: service.Sequential, Tasks: []string{"task1"}}},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplatesStub: func(ctx context.Context, names []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{"task1": {Name: "task1"}}, nil
+				},
+				AddTaskListTemplateStub: func(ctx context.Context, template *service.Template) error {
+					return errors.New(errors.Exist, "taskList template already exists")
+				},
+			},
+			errkind: errors.Exist,
+			errtext: "taskList template already exists",
+		},
+		{
+			name: "successfully create taskList template",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:           "list",
+				CacheNamespace: ptr.String("login"),
+				Groups: []*goatasklisttemplate.GroupTemplate{
+					{Execution: service.Sequential, Tasks: []string{"task1"}, FinalPolicy: ptr.String("policies/example/final/1.0")},
+				},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplatesStub: func(ctx context.Context, names []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{"task1": {Name: "task1"}}, nil
+				},
+				AddTaskListTemplateStub: func(ctx context.Context, template *service.Template) error {
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully create taskList template with timeouts",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name: "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{
+					{Execution: service.Parallel, Tasks: []string{"task1"}, Timeout: ptr.String("5m0s")},
+				},
+				Timeout: ptr.String("10m0s"),
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplatesStub: func(ctx context.Context, names []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{"task1": {Name: "task1"}}, nil
+				},
+				AddTaskListTemplateStub: func(ctx context.Context, template *service.Template) error {
+					if template.Timeout != 10*time.Minute || template.Groups[0].Timeout != 5*time.Minute {
+						return errors.New("unexpected timeouts")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully create taskList template with task template versions",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:   "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{{Execution: service.Parallel, Tasks: []string{"task1", "task2"}}},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplatesStub: func(ctx context.Context, names []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{
+						"task1": {Name: "task1", TemplateVersion: 3},
+						"task2": {Name: "task2", TemplateVersion: 1},
+					}, nil
+				},
+				AddTaskListTemplateStub: func(ctx context.Context, template *service.Template) error {
+					if template.TaskVersions["task1"] != 3 || template.TaskVersions["task2"] != 1 {
+						return errors.New("unexpected task template versions")
+					}
+					return nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := tasklisttemplate.New(test.storage, !test.noWebhooks, zap.NewNop())
+			res, err := svc.Create(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.req, res)
+			}
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goatasklisttemplate.TaskListTemplate
+		storage *servicefakes.FakeStorage
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "task template not found",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:   "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{{Execution: service.Sequential, Tasks: []string{"task1"}}},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplatesStub: func(ctx context.Context, names []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task templates not found: task1",
+		},
+		{
+			name: "taskList template not found",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:   "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{{Execution: service.Sequential, Tasks: []string{"task1"}}},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplatesStub: func(ctx context.Context, names []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{"task1": {Name: "task1"}}, nil
+				},
+				UpdateTaskListTemplateStub: func(ctx context.Context, template *service.Template) error {
+					return errors.New(errors.NotFound, "taskList template not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "taskList template not found",
+		},
+		{
+			name: "successfully update taskList template",
+			req: &goatasklisttemplate.TaskListTemplate{
+				Name:   "list",
+				Groups: []*goatasklisttemplate.GroupTemplate{{Execution: service.Parallel, Tasks: []string{"task1"}}},
+			},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplatesStub: func(ctx context.Context, names []string) (map[string]*service.Task, error) {
+					return map[string]*service.Task{"task1": {Name: "task1"}}, nil
+				},
+				UpdateTaskListTemplateStub: func(ctx context.Context, template *service.Template) error {
+					return nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := tasklisttemplate.New(test.storage, true, zap.NewNop())
+			res, err := svc.Update(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.req, res)
+			}
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	storage := &servicefakes.FakeStorage{
+		TaskListTemplateStub: func(ctx context.Context, name string) (*service.Template, error) {
+			return &service.Template{
+				Name:            name,
+				Groups:          []service.GroupTemplate{{Execution: service.Sequential, Tasks: []string{"task1"}}},
+				TemplateVersion: 2,
+			}, nil
+		},
+		TaskListTemplateVersionStub: func(ctx context.Context, name string, version int) (*service.Template, error) {
+			return &service.Template{
+				Name:            name,
+				Groups:          []service.GroupTemplate{{Execution: service.Parallel, Tasks: []string{"task1"}}},
+				TemplateVersion: version,
+			}, nil
+		},
+	}
+
+	svc := tasklisttemplate.New(storage, true, zap.NewNop())
+	res, err := svc.Get(context.Background(), &goatasklisttemplate.TaskListTemplateVersionRequest{Name: "list"})
+	assert.NoError(t, err)
+	assert.Equal(t, &goatasklisttemplate.TaskListTemplate{
+		Name:    "list",
+		Groups:  []*goatasklisttemplate.GroupTemplate{{Execution: service.Sequential, Tasks: []string{"task1"}}},
+		Version: ptr.Int(2),
+	}, res)
+
+	res, err = svc.Get(context.Background(), &goatasklisttemplate.TaskListTemplateVersionRequest{Name: "list", Version: ptr.Int(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, &goatasklisttemplate.TaskListTemplate{
+		Name:    "list",
+		Groups:  []*goatasklisttemplate.GroupTemplate{{Execution: service.Parallel, Tasks: []string{"task1"}}},
+		Version: ptr.Int(1),
+	}, res)
+}
+
+func TestService_Delete(t *testing.T) {
+	storage := &servicefakes.FakeStorage{
+		DeleteTaskListTemplateStub: func(ctx context.Context, name string) error {
+			return errors.New(errors.NotFound, "taskList template not found")
+		},
+	}
+
+	svc := tasklisttemplate.New(storage, true, zap.NewNop())
+	err := svc.Delete(context.Background(), &goatasklisttemplate.TaskListTemplateRequest{Name: "list"})
+	assert.Error(t, err)
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
@@ -0,0 +1,373 @@
+package tasktemplate
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goatasktemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_template"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+// httpMethods contains the HTTP methods which can be used by tasks.
+var httpMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+	http.MethodConnect: true,
+	http.MethodOptions: true,
+	http.MethodTrace:   true,
+}
+
+type Service struct {
+	storage service.Storage
+	logger  *zap.Logger
+
+	// webhooks reports whether callback URLs are notified.
+	webhooks bool
+}
+
+// New creates the taskTemplate service.
+func New(storage service.Storage, webhooks bool, logger *zap.Logger) *Service {
+	return &Service{
+		storage:  storage,
+		logger:   logger,
+		webhooks: webhooks,
+	}
+}
+
+// Create a task template.
+func (s *Service) Create(ctx context.Context, req *goatasktemplate.TaskTemplate) (*goatasktemplate.TaskTemplate, error) {
+	template, err := fromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validate(template); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.AddTaskTemplate(ctx, template); err != nil {
+		if !errors.Is(errors.Exist, err) {
+			s.logger.Error("error adding task template to storage", zap.String("taskName", template.Name), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return toResponse(template), nil
+}
+
+// Get a task template by name. The latest version of the template
+// is returned, unless a specific version is requested.
+func (s *Service) Get(ctx context.Context, req *goatasktemplate.TaskTemplateVersionRequest) (*goatasktemplate.TaskTemplate, error) {
+	if req.Name == "" {
+		return nil, errors.New(errors.BadRequest, "missing name")
+	}
+
+	var template *service.Task
+	var err error
+	if req.Version != nil {
+		template, err = s.storage.TaskTemplateVersion(ctx, req.Name, *req.Version)
+	} else {
+		template, err = s.storage.TaskTemplate(ctx, req.Name)
+	}
+	if err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			s.logger.Error("error getting task template from storage", zap.String("taskName", req.Name), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return toResponse(template), nil
+}
+
+// Update an existing task template. The updated template is saved
+// as a new version, which becomes the latest version of the template.
+func (s *Service) Update(ctx context.Context, req *goatasktemplate.TaskTemplate) (*goatasktemplate.TaskTemplate, error) {
+	template, err := fromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validate(template); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.UpdateTaskTemplate(ctx, template); err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			s.logger.Error("error updating task template in storage", zap.String("taskName", template.Name), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return toResponse(template), nil
+}
+
+// Delete a task template by name.
+func (s *Service) Delete(ctx context.Context, req *goatasktemplate.TaskTemplateRequest) error {
+	if req.Name == "" {
+		return errors.New(errors.BadRequest, "missing name")
+	}
+
+	// task templates used by taskList templates, eventTasks or schedules cannot be deleted
+	if err := s.checkUnused(ctx, req.Name); err != nil {
+		return err
+	}
+
+	if err := s.storage.DeleteTaskTemplate(ctx, req.Name); err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			s.logger.Error("error deleting task template from storage", zap.String("taskName", req.Name), zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// checkUnused returns an error if the task template is used by taskList
+// templates, eventTasks or schedules.
+func (s *Service) checkUnused(ctx context.Context, name string) error {
+	logger := s.logger.With(zap.String("taskName", name))
+
+	listTemplates, err := s.storage.TaskListTemplatesByTask(ctx, name)
+	if err != nil {
+		logger.Error("error getting taskList templates from storage", zap.Error(err))
+		return err
+	}
+
+	if len(listTemplates) > 0 {
+		var names []string
+		for _, t := range listTemplates {
+			names = append(names, t.Name)
+		}
+		return errors.New(errors.BadRequest, "task template is used by taskList templates: "+strings.Join(names, ", "))
+	}
+
+	eventTasks, err := s.storage.ListEventTasks(ctx)
+	if err != nil {
+		logger.Error("error getting eventTasks from storage", zap.Error(err))
+		return err
+	}
+
+	var keys []string
+	for _, e := range eventTasks {
+		if e.TaskName == name {
+			keys = append(keys, e.Key)
+		}
+	}
+	if len(keys) > 0 {
+		return errors.New(errors.BadRequest, "task template is used by eventTasks: "+strings.Join(keys, ", "))
+	}
+
+	schedules, err := s.storage.ListSchedules(ctx)
+	if err != nil {
+		logger.Error("error getting schedules from storage", zap.Error(err))
+		return err
+	}
+
+	var ids []string
+	for _, sched := range schedules {
+		if sched.TaskName == name {
+			ids = append(ids, sched.ID)
+		}
+	}
+	if len(ids) > 0 {
+		return errors.New(errors.BadRequest, "task template is used by schedules: "+strings.Join(ids, ", "))
+	}
+
+	return nil
+}
+
+// List all task templates.
+func (s *Service) List(ctx context.Context) (*goatasktemplate.TaskTemplatesResponse, error) {
+	templates, err := s.storage.ListTaskTemplates(ctx)
+	if err != nil {
+		s.logger.Error("error getting task templates from storage", zap.Error(err))
+		return nil, err
+	}
+
+	res := &goatasktemplate.TaskTemplatesResponse{Templates: []*goatasktemplate.TaskTemplate{}}
+	for _, template := range templates {
+		res.Templates = append(res.Templates, toResponse(template))
+	}
+
+	return res, nil
+}
+
+// validate checks that a task template can be executed by the task executor.
+// The template must define either a request policy, or a valid URL and
+// HTTP method.
+func (s *Service) validate(template *service.Task) error {
+	if template.Name == "" {
+		return errors.New(errors.BadRequest, "missing name")
+	}
+
+	if template.RequestPolicy == "" && (template.URL == "" || template.Method == "") {
+		return errors.New(errors.BadRequest, "invalid task template: must define either request policy or url and method")
+	}
+
+	if template.Method != "" && !httpMethods[template.Method] {
+		return errors.New(errors.BadRequest, "invalid task template: unknown http method")
+	}
+
+	if template.URL != "" {
+		u, err := url.ParseRequestURI(template.URL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New(errors.BadRequest, "invalid task template: invalid url", err)
+		}
+	}
+
+	if template.CallbackURL != "" {
+		if err := service.ValidateCallback(template.CallbackURL, s.webhooks); err != nil {
+			return errors.New(errors.BadRequest, "invalid task template", err)
+		}
+	}
+
+	if err := service.ValidateStatusCodes(template.AcceptedStatusCodes); err != nil {
+		return errors.New(errors.BadRequest, "invalid task template", err)
+	}
+
+	if template.Timeout < 0 {
+		return errors.New(errors.BadRequest, "invalid task template: timeout cannot be negative")
+	}
+
+	if p := template.RetryPolicy; p != nil {
+		if p.BaseDelay < 0 || p.MaxDelay < 0 {
+			return errors.New(errors.BadRequest, "invalid task template: retry delays cannot be negative")
+		}
+		if p.BaseDelay != 0 && p.MaxDelay != 0 && p.BaseDelay > p.MaxDelay {
+			return errors.New(errors.BadRequest, "invalid task template: retry baseDelay cannot be greater than maxDelay")
+		}
+	}
+
+	return nil
+}
+
+func fromRequest(req *goatasktemplate.TaskTemplate) (*service.Task, error) {
+	template := &service.Task{Name: req.Name}
+	if req.URL != nil {
+		template.URL = *req.URL
+	}
+	if req.Method != nil {
+		template.Method = *req.Method
+	}
+	if req.RequestPolicy != nil {
+		template.RequestPolicy = *req.RequestPolicy
+	}
+	if req.ResponsePolicy != nil {
+		template.ResponsePolicy = *req.ResponsePolicy
+	}
+	if req.FinalPolicy != nil {
+		template.FinalPolicy = *req.FinalPolicy
+	}
+	if req.CacheNamespace != nil {
+		template.CacheNamespace = *req.CacheNamespace
+	}
+	if req.CacheScope != nil {
+		template.CacheScope = *req.CacheScope
+	}
+	if req.Priority != nil {
+		template.Priority = *req.Priority
+	}
+	if req.CallbackURL != nil {
+		template.CallbackURL = *req.CallbackURL
+	}
+	template.AcceptedStatusCodes = req.AcceptedStatusCodes
+	if req.Timeout != nil {
+		d, err := time.ParseDuration(*req.Timeout)
+		if err != nil {
+			return nil, errors.New(errors.BadRequest, "invalid task template: invalid timeout", err)
+		}
+		template.Timeout = d
+	}
+	if req.RetryPolicy != nil {
+		policy, err := retryPolicy(req.RetryPolicy)
+		if err != nil {
+			return nil, err
+		}
+		template.RetryPolicy = policy
+	}
+
+	return template, nil
+}
+
+func retryPolicy(req *goatasktemplate.RetryPolicy) (*service.RetryPolicy, error) {
+	policy := &service.RetryPolicy{RetryOn: req.RetryOn}
+	if req.MaxAttempts != nil {
+		policy.MaxAttempts = *req.MaxAttempts
+	}
+	if req.BaseDelay != nil {
+		d, err := time.ParseDuration(*req.BaseDelay)
+		if err != nil {
+			return nil, errors.New(errors.BadRequest, "invalid task template: invalid retry baseDelay", err)
+		}
+		policy.BaseDelay = d
+	}
+	if req.MaxDelay != nil {
+		d, err := time.ParseDuration(*req.MaxDelay)
+		if err != nil {
+			return nil, errors.New(errors.BadRequest, "invalid task template: invalid retry maxDelay", err)
+		}
+		policy.MaxDelay = d
+	}
+	return policy, nil
+}
+
+func toResponse(template *service.Task) *goatasktemplate.TaskTemplate {
+	res := &goatasktemplate.TaskTemplate{Name: template.Name}
+	if template.URL != "" {
+		res.URL = &template.URL
+	}
+	if template.Method != "" {
+		res.Method = &template.Method
+	}
+	if template.RequestPolicy != "" {
+		res.RequestPolicy = &template.RequestPolicy
+	}
+	if template.ResponsePolicy != "" {
+		res.ResponsePolicy = &template.ResponsePolicy
+	}
+	if template.FinalPolicy != "" {
+		res.FinalPolicy = &template.FinalPolicy
+	}
+	if template.CacheNamespace != "" {
+		res.CacheNamespace = &template.CacheNamespace
+	}
+	if template.CacheScope != "" {
+		res.CacheScope = &template.CacheScope
+	}
+	if template.TemplateVersion != 0 {
+		res.Version = &template.TemplateVersion
+	}
+	if template.Priority != 0 {
+		res.Priority = &template.Priority
+	}
+	if template.CallbackURL != "" {
+		res.CallbackURL = &template.CallbackURL
+	}
+	res.AcceptedStatusCodes = template.AcceptedStatusCodes
+	if template.Timeout != 0 {
+		res.Timeout = ptr.String(template.Timeout.String())
+	}
+	if p := template.RetryPolicy; p != nil {
+		res.RetryPolicy = &goatasktemplate.RetryPolicy{RetryOn: p.RetryOn}
+		if p.MaxAttempts != 0 {
+			res.RetryPolicy.MaxAttempts = &p.MaxAttempts
+		}
+		if p.BaseDelay != 0 {
+			res.RetryPolicy.BaseDelay = ptr.String(p.BaseDelay.String())
+		}
+		if p.MaxDelay != 0 {
+			res.RetryPolicy.MaxDelay = ptr.String(p.MaxDelay.String())
+		}
+	}
+
+	return res
+}
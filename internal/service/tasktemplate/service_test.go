@@ -0,0 +1,513 @@
+package tasktemplate_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goatasktemplate "github.com/eclipse-xfsc/task-sheduler/gen/task_template"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/servicefakes"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/tasktemplate"
+)
+
+func TestNew(t *testing.T) {
+	svc := tasktemplate.New(nil, true, zap.NewNop())
+	assert.Implements(t, (*goatasktemplate.Service)(nil), svc)
+}
+
+func TestService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goatasktemplate.TaskTemplate
+		storage *servicefakes.FakeStorage
+
+		// noWebhooks disables the webhooks of the service
+		noWebhooks bool
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing name",
+			req:     &goatasktemplate.TaskTemplate{RequestPolicy: ptr.String("policies/example/example/1.0")},
+			errkind: errors.BadRequest,
+			errtext: "missing name",
+		},
+		{
+			name:    "neither request policy nor url and method",
+			req:     &goatasktemplate.TaskTemplate{Name: "task", URL: ptr.String("https://example.com")},
+			errkind: errors.BadRequest,
+			errtext: "must define either request policy or url and method",
+		},
+		{
+			name: "invalid http method",
+			req: &goatasktemplate.TaskTemplate{
+				Name:   "task",
+				URL:    ptr.String("https://example.com"),
+				Method: ptr.String("FETCH"),
+			},
+			errkind: errors.BadRequest,
+			errtext: "unknown http method",
+		},
+		{
+			name: "invalid url",
+			req: &goatasktemplate.TaskTemplate{
+				Name:   "task",
+				URL:    ptr.String("example.com/path"),
+				Method: ptr.String("GET"),
+			},
+			errkind: errors.BadRequest,
+			errtext: "invalid url",
+		},
+		{
+			name: "invalid callbackURL",
+			req: &goatasktemplate.TaskTemplate{
+				Name:          "task",
+				RequestPolicy: ptr.String("policies/example/example/1.0"),
+				CallbackURL:   ptr.String("/hook"),
+			},
+			errkind: errors.BadRequest,
+			errtext: "invalid callbackURL",
+		},
+		{
+			name: "callbackURL when webhooks are disabled",
+			req: &goatasktemplate.TaskTemplate{
+				Name:          "task",
+				RequestPolicy: ptr.String("policies/example/example/1.0"),
+				CallbackURL:   ptr.String("https://example.com/hook"),
+			},
+			noWebhooks: true,
+			errkind:    errors.BadRequest,
+			errtext:    "webhooks are disabled",
+		},
+		{
+			name: "task template already exists",
+			req: &goatasktemplate.TaskTemplate{
+				Name:          "task",
+				RequestPolicy: ptr.String("policies/example/example/1.0"),
+			},
+			storage: &servicefakes.FakeStorage{
+				AddTaskTemplateStub: func(ctx context.Context, template *service.Task) error {
+					return errors.New(errors.Exist, "task template already exists")
+				},
+			},
+			errkind: errors.Exist,
+			errtext: "task template already exists",
+		},
+		{
+			name: "successfully create task template",
+			req: &goatasktemplate.TaskTemplate{
+				Name:   "task",
+				URL:    ptr.String("https://example.com/path"),
+				Method: ptr.String("POST"),
+			},
+			storage: &servicefakes.FakeStorage{
+				AddTaskTemplateStub: func(ctx context.Context, template *service.Task) error {
+					return nil
+				},
+			},
+		},
+		{
+			name: "invalid retry baseDelay",
+			req: &goatasktemplate.TaskTemplate{
+				Name:          "task",
+				RequestPolicy: ptr.String("policies/example/example/1.0"),
+				RetryPolicy:   &goatasktemplate.RetryPolicy{BaseDelay: ptr.String("1 second")},
+			},
+			errkind: errors.BadRequest,
+			errtext: "invalid retry baseDelay",
+		},
+		{
+			name: "retry baseDelay is greater than maxDelay",
+			req: &goatasktemplate.TaskTemplate{
+				Name:          "task",
+				RequestPolicy: ptr.String("policies/example/example/1.0"),
+				RetryPolicy:   &goatasktemplate.RetryPolicy{BaseDelay: ptr.String("1m"), MaxDelay: ptr.String("10s")},
+			},
+			errkind: errors.BadRequest,
+			errtext: "retry baseDelay cannot be greater than maxDelay",
+		},
+		{
+			name: "invalid timeout",
+			req: &goatasktemplate.TaskTemplate{
+				Name:          "task",
+				RequestPolicy: ptr.String("policies/example/example/1.0"),
+				Timeout:       ptr.String("2 minutes"),
+			},
+			errkind: errors.BadRequest,
+			errtext: "invalid timeout",
+		},
+		{
+			name: "negative timeout",
+			req: &goatasktemplate.TaskTemplate{
+				Name:          "task",
+				RequestPolicy: ptr.String("policies/example/example/1.0"),
+				Timeout:       ptr.String("-1s"),
+			},
+			errkind: errors.BadRequest,
+			errtext: "timeout cannot be negative",
+		},
+		{
+			name: "invalid accepted status code",
+			req: &goatasktemplate.TaskTemplate{
+				Name:                "task",
+				URL:                 ptr.String("https://example.com/path"),
+				Method:              ptr.String("GET"),
+				AcceptedStatusCodes: []string{"2xx", "600"},
+			},
+			errkind: errors.BadRequest,
+			errtext: "invalid status code: 600",
+		},
+		{
+			name: "successfully create task template with accepted status codes",
+			req: &goatasktemplate.TaskTemplate{
+				Name:                "task",
+				URL:                 ptr.String("https://example.com/path"),
+				Method:              ptr.String("GET"),
+				AcceptedStatusCodes: []string{"2xx", "404"},
+			},
+			storage: &servicefakes.FakeStorage{
+				AddTaskTemplateStub: func(ctx context.Context, template *service.Task) error {
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully create task template with timeout",
+			req: &goatasktemplate.TaskTemplate{
+				Name:          "task",
+				RequestPolicy: ptr.String("policies/example/example/1.0"),
+				Timeout:       ptr.String("2m0s"),
+			},
+			storage: &servicefakes.FakeStorage{
+				AddTaskTemplateStub: func(ctx context.Context, template *service.Task) error {
+					if template.Timeout != 2*time.Minute {
+						return errors.New("unexpected timeout")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "successfully create task template with retry policy",
+			req: &goatasktemplate.TaskTemplate{
+				Name:          "task",
+				RequestPolicy: ptr.String("policies/example/example/1.0"),
+				RetryPolicy: &goatasktemplate.RetryPolicy{
+					MaxAttempts: ptr.Int(3),
+					BaseDelay:   ptr.String("2s"),
+					MaxDelay:    ptr.String("1m0s"),
+					RetryOn:     []string{service.ReasonHTTP},
+				},
+			},
+			storage: &servicefakes.FakeStorage{
+				AddTaskTemplateStub: func(ctx context.Context, template *service.Task) error {
+					if template.RetryPolicy.BaseDelay != 2*time.Second || template.RetryPolicy.MaxDelay != time.Minute {
+						return errors.New("unexpected retry policy")
+					}
+					return nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := tasktemplate.New(test.storage, !test.noWebhooks, zap.NewNop())
+			res, err := svc.Create(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.req, res)
+			}
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goatasktemplate.TaskTemplateVersionRequest
+		storage *servicefakes.FakeStorage
+
+		res     *goatasktemplate.TaskTemplate
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing name",
+			req:     &goatasktemplate.TaskTemplateVersionRequest{},
+			errkind: errors.BadRequest,
+			errtext: "missing name",
+		},
+		{
+			name: "task template not found",
+			req:  &goatasktemplate.TaskTemplateVersionRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound, "task template not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "task template not found",
+		},
+		{
+			name: "task template version not found",
+			req:  &goatasktemplate.TaskTemplateVersionRequest{Name: "task", Version: ptr.Int(3)},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateVersionStub: func(ctx context.Context, taskName string, version int) (*service.Task, error) {
+					return nil, errors.New(errors.NotFound, "task template version not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "task template version not found",
+		},
+		{
+			name: "successfully get task template",
+			req:  &goatasktemplate.TaskTemplateVersionRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateStub: func(ctx context.Context, taskName string) (*service.Task, error) {
+					return &service.Task{Name: taskName, RequestPolicy: "policies/example/example/1.0", TemplateVersion: 2}, nil
+				},
+			},
+			res: &goatasktemplate.TaskTemplate{Name: "task", RequestPolicy: ptr.String("policies/example/example/1.0"), Version: ptr.Int(2)},
+		},
+		{
+			name: "successfully get task template version",
+			req:  &goatasktemplate.TaskTemplateVersionRequest{Name: "task", Version: ptr.Int(1)},
+			storage: &servicefakes.FakeStorage{
+				TaskTemplateVersionStub: func(ctx context.Context, taskName string, version int) (*service.Task, error) {
+					return &service.Task{Name: taskName, RequestPolicy: "policies/example/example/1.0", TemplateVersion: version}, nil
+				},
+			},
+			res: &goatasktemplate.TaskTemplate{Name: "task", RequestPolicy: ptr.String("policies/example/example/1.0"), Version: ptr.Int(1)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := tasktemplate.New(test.storage, true, zap.NewNop())
+			res, err := svc.Get(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goatasktemplate.TaskTemplate
+		storage *servicefakes.FakeStorage
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "invalid task template",
+			req:     &goatasktemplate.TaskTemplate{Name: "task"},
+			errkind: errors.BadRequest,
+			errtext: "invalid task template",
+		},
+		{
+			name: "task template not found",
+			req:  &goatasktemplate.TaskTemplate{Name: "task", RequestPolicy: ptr.String("policies/example/example/1.0")},
+			storage: &servicefakes.FakeStorage{
+				UpdateTaskTemplateStub: func(ctx context.Context, template *service.Task) error {
+					return errors.New(errors.NotFound, "task template not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "task template not found",
+		},
+		{
+			name: "successfully update task template",
+			req:  &goatasktemplate.TaskTemplate{Name: "task", RequestPolicy: ptr.String("policies/example/example/1.0")},
+			storage: &servicefakes.FakeStorage{
+				UpdateTaskTemplateStub: func(ctx context.Context, template *service.Task) error {
+					return nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := tasktemplate.New(test.storage, true, zap.NewNop())
+			res, err := svc.Update(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.req, res)
+			}
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *goatasktemplate.TaskTemplateRequest
+		storage *servicefakes.FakeStorage
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing name",
+			req:     &goatasktemplate.TaskTemplateRequest{},
+			errkind: errors.BadRequest,
+			errtext: "missing name",
+		},
+		{
+			name: "error getting taskList templates using the task template",
+			req:  &goatasktemplate.TaskTemplateRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplatesByTaskStub: func(ctx context.Context, taskName string) ([]*service.Template, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "task template is used by taskList templates",
+			req:  &goatasktemplate.TaskTemplateRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				TaskListTemplatesByTaskStub: func(ctx context.Context, taskName string) ([]*service.Template, error) {
+					return []*service.Template{{Name: "list1"}, {Name: "list2"}}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task template is used by taskList templates: list1, list2",
+		},
+		{
+			name: "error getting eventTasks",
+			req:  &goatasktemplate.TaskTemplateRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				ListEventTasksStub: func(ctx context.Context) ([]*service.EventTask, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "task template is used by eventTasks",
+			req:  &goatasktemplate.TaskTemplateRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				ListEventTasksStub: func(ctx context.Context) ([]*service.EventTask, error) {
+					return []*service.EventTask{{Key: "event1", TaskName: "task"}, {Key: "event2", TaskName: "other"}}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task template is used by eventTasks: event1",
+		},
+		{
+			name: "error getting schedules",
+			req:  &goatasktemplate.TaskTemplateRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				ListSchedulesStub: func(ctx context.Context) ([]*service.Schedule, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "task template is used by schedules",
+			req:  &goatasktemplate.TaskTemplateRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				ListSchedulesStub: func(ctx context.Context) ([]*service.Schedule, error) {
+					return []*service.Schedule{{ID: "123", TaskName: "task"}, {ID: "456", TaskListName: "task"}}, nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "task template is used by schedules: 123",
+		},
+		{
+			name: "error deleting task template",
+			req:  &goatasktemplate.TaskTemplateRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				DeleteTaskTemplateStub: func(ctx context.Context, taskName string) error {
+					return errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "successfully delete task template",
+			req:  &goatasktemplate.TaskTemplateRequest{Name: "task"},
+			storage: &servicefakes.FakeStorage{
+				DeleteTaskTemplateStub: func(ctx context.Context, taskName string) error {
+					return nil
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := tasktemplate.New(test.storage, true, zap.NewNop())
+			err := svc.Delete(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			} else {
+				assert.Empty(t, test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	storage := &servicefakes.FakeStorage{
+		ListTaskTemplatesStub: func(ctx context.Context) ([]*service.Task, error) {
+			return []*service.Task{
+				{Name: "task1", RequestPolicy: "policies/example/example/1.0"},
+				{Name: "task2", URL: "https://example.com", Method: "GET"},
+			}, nil
+		},
+	}
+
+	svc := tasktemplate.New(storage, true, zap.NewNop())
+	res, err := svc.List(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &goatasktemplate.TaskTemplatesResponse{
+		Templates: []*goatasktemplate.TaskTemplate{
+			{Name: "task1", RequestPolicy: ptr.String("policies/example/example/1.0")},
+			{Name: "task2", URL: ptr.String("https://example.com"), Method: ptr.String("GET")},
+		},
+	}, res)
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const (
+	// WebhookPending is the state of a webhook delivery which is not yet
+	// delivered, but will be attempted again.
+	WebhookPending = "pending"
+
+	// WebhookDelivered is the state of a successfully delivered webhook.
+	WebhookDelivered = "delivered"
+
+	// WebhookFailed is the state of a webhook delivery which failed
+	// and will not be attempted again.
+	WebhookFailed = "failed"
+)
+
+// Notification is sent to the callback URL of a task or taskList
+// when its execution is finished.
+type Notification struct {
+	ID         string          `json:"id"`   // ID of the task or taskList.
+	Type       string          `json:"type"` // Type is either "task" or "taskList".
+	Name       string          `json:"name"`
+	State      State           `json:"state"`
+	Result     json.RawMessage `json:"result,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	FinishedAt time.Time       `json:"finishedAt"`
+}
+
+// WebhookDelivery is a notification which must be delivered to a callback URL.
+type WebhookDelivery struct {
+	ID            string           `json:"id"`
+	ResourceID    string           `json:"resourceID"` // ResourceID is the ID of the task or taskList.
+	URL           string           `json:"url"`
+	Payload       []byte           `json:"payload"` // Payload is the JSON encoded notification.
+	State         string           `json:"state"`
+	Attempts      []WebhookAttempt `json:"attempts"`
+	NextAttemptAt time.Time        `json:"nextAttemptAt"` // NextAttemptAt is the time of the next delivery attempt.
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+// WebhookAttempt is a single attempt to deliver a webhook.
+type WebhookAttempt struct {
+	StartedAt  time.Time `json:"startedAt"`
+	StatusCode int       `json:"statusCode"` // StatusCode of the response, if one was received.
+	Error      string    `json:"error"`
+}
+
+// TaskNotification creates the notification for a finished task.
+func TaskNotification(task *Task) *Notification {
+	return &Notification{
+		ID:         task.ID,
+		Type:       "task",
+		Name:       task.Name,
+		State:      task.State,
+		Result:     rawResult(task.Response),
+		Error:      task.Error,
+		FinishedAt: task.FinishedAt,
+	}
+}
+
+// TaskListNotification creates the notification for a finished taskList
+// with the taskList state as a result.
+func TaskListNotification(list *TaskList, result []byte) *Notification {
+	return &Notification{
+		ID:         list.ID,
+		Type:       "taskList",
+		Name:       list.Name,
+		State:      list.State,
+		Result:     rawResult(result),
+		FinishedAt: list.FinishedAt,
+	}
+}
+
+// NewWebhookDelivery creates a delivery of the notification to the given URL.
+func NewWebhookDelivery(url string, n *Notification) (*WebhookDelivery, error) {
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return nil, errors.New("error marshaling notification", err)
+	}
+
+	now := time.Now()
+	return &WebhookDelivery{
+		ID:            uuid.NewString(),
+		ResourceID:    n.ID,
+		URL:           url,
+		Payload:       payload,
+		State:         WebhookPending,
+		Attempts:      []WebhookAttempt{},
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// ValidateCallbackURL checks that the callback URL is an absolute HTTP(S) URL.
+func ValidateCallbackURL(callbackURL string) error {
+	u, err := url.ParseRequestURI(callbackURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New(errors.BadRequest, "invalid callbackURL", err)
+	}
+	return nil
+}
+
+// ValidateCallback checks the callback URL of a task, taskList or template.
+// A callback URL can't be set when webhooks are disabled, because the service
+// has no secret to sign the notifications.
+func ValidateCallback(callbackURL string, webhooks bool) error {
+	if callbackURL == "" {
+		return nil
+	}
+	if !webhooks {
+		return errors.New(errors.BadRequest, "callbackURL is not supported: webhooks are disabled")
+	}
+	return ValidateCallbackURL(callbackURL)
+}
+
+// rawResult returns the result as is if it's valid JSON,
+// or encodes it as a JSON string otherwise.
+func rawResult(result []byte) json.RawMessage {
+	if len(result) == 0 {
+		return nil
+	}
+	if json.Valid(result) {
+		return result
+	}
+	encoded, _ := json.Marshal(string(result))
+	return encoded
+}
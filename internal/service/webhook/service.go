@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goawebhook "github.com/eclipse-xfsc/task-sheduler/gen/webhook"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+type Service struct {
+	storage service.Storage
+	logger  *zap.Logger
+}
+
+// New creates the webhook service.
+func New(storage service.Storage, logger *zap.Logger) *Service {
+	return &Service{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Deliveries returns the webhook deliveries and their attempts
+// for a task or a taskList.
+func (s *Service) Deliveries(ctx context.Context, req *goawebhook.WebhookDeliveriesRequest) (*goawebhook.WebhookDeliveriesResponse, error) {
+	if req.ID == "" {
+		return nil, errors.New(errors.BadRequest, "missing id")
+	}
+
+	deliveries, err := s.storage.WebhookDeliveries(ctx, req.ID)
+	if err != nil {
+		s.logger.Error("error getting webhook deliveries from storage", zap.String("id", req.ID), zap.Error(err))
+		return nil, err
+	}
+
+	res := &goawebhook.WebhookDeliveriesResponse{Deliveries: []*goawebhook.WebhookDelivery{}}
+	for _, delivery := range deliveries {
+		res.Deliveries = append(res.Deliveries, toResponse(delivery))
+	}
+
+	return res, nil
+}
+
+func toResponse(delivery *service.WebhookDelivery) *goawebhook.WebhookDelivery {
+	res := &goawebhook.WebhookDelivery{
+		ID:        delivery.ID,
+		URL:       delivery.URL,
+		State:     delivery.State,
+		Attempts:  []*goawebhook.WebhookAttempt{},
+		CreatedAt: delivery.CreatedAt.Format(time.RFC3339),
+	}
+	if delivery.State == service.WebhookPending {
+		res.NextAttemptAt = ptr.String(delivery.NextAttemptAt.Format(time.RFC3339))
+	}
+
+	for _, attempt := range delivery.Attempts {
+		a := &goawebhook.WebhookAttempt{StartedAt: attempt.StartedAt.Format(time.RFC3339)}
+		if attempt.StatusCode != 0 {
+			a.StatusCode = ptr.Int(attempt.StatusCode)
+		}
+		if attempt.Error != "" {
+			a.Error = ptr.String(attempt.Error)
+		}
+		res.Attempts = append(res.Attempts, a)
+	}
+
+	return res
+}
@@ -0,0 +1,135 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goawebhook "github.com/eclipse-xfsc/task-sheduler/gen/webhook"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/servicefakes"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/webhook"
+)
+
+func TestNew(t *testing.T) {
+	svc := webhook.New(nil, zap.NewNop())
+	assert.Implements(t, (*goawebhook.Service)(nil), svc)
+}
+
+func TestService_Deliveries(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		req     *goawebhook.WebhookDeliveriesRequest
+		storage *servicefakes.FakeStorage
+
+		res     *goawebhook.WebhookDeliveriesResponse
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing id",
+			req:     &goawebhook.WebhookDeliveriesRequest{},
+			errkind: errors.BadRequest,
+			errtext: "missing id",
+		},
+		{
+			name: "error getting webhook deliveries from storage",
+			req:  &goawebhook.WebhookDeliveriesRequest{ID: "123"},
+			storage: &servicefakes.FakeStorage{
+				WebhookDeliveriesStub: func(ctx context.Context, resourceID string) ([]*service.WebhookDelivery, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "no webhook deliveries",
+			req:  &goawebhook.WebhookDeliveriesRequest{ID: "123"},
+			storage: &servicefakes.FakeStorage{
+				WebhookDeliveriesStub: func(ctx context.Context, resourceID string) ([]*service.WebhookDelivery, error) {
+					return nil, nil
+				},
+			},
+			res: &goawebhook.WebhookDeliveriesResponse{Deliveries: []*goawebhook.WebhookDelivery{}},
+		},
+		{
+			name: "successfully get webhook deliveries",
+			req:  &goawebhook.WebhookDeliveriesRequest{ID: "123"},
+			storage: &servicefakes.FakeStorage{
+				WebhookDeliveriesStub: func(ctx context.Context, resourceID string) ([]*service.WebhookDelivery, error) {
+					return []*service.WebhookDelivery{
+						{
+							ID:         "1",
+							ResourceID: resourceID,
+							URL:        "https://example.com/hook",
+							State:      service.WebhookPending,
+							Attempts: []service.WebhookAttempt{
+								{StartedAt: createdAt, StatusCode: 503, Error: "unexpected response status: 503 Service Unavailable"},
+								{StartedAt: createdAt.Add(time.Second), Error: "connection refused"},
+							},
+							NextAttemptAt: createdAt.Add(time.Minute),
+							CreatedAt:     createdAt,
+						},
+						{
+							ID:            "2",
+							ResourceID:    resourceID,
+							URL:           "https://example.com/other",
+							State:         service.WebhookDelivered,
+							Attempts:      []service.WebhookAttempt{{StartedAt: createdAt, StatusCode: 200}},
+							NextAttemptAt: createdAt,
+							CreatedAt:     createdAt,
+						},
+					}, nil
+				},
+			},
+			res: &goawebhook.WebhookDeliveriesResponse{
+				Deliveries: []*goawebhook.WebhookDelivery{
+					{
+						ID:    "1",
+						URL:   "https://example.com/hook",
+						State: service.WebhookPending,
+						Attempts: []*goawebhook.WebhookAttempt{
+							{StartedAt: "2023-01-01T10:00:00Z", StatusCode: ptr.Int(503), Error: ptr.String("unexpected response status: 503 Service Unavailable")},
+							{StartedAt: "2023-01-01T10:00:01Z", Error: ptr.String("connection refused")},
+						},
+						NextAttemptAt: ptr.String("2023-01-01T10:01:00Z"),
+						CreatedAt:     "2023-01-01T10:00:00Z",
+					},
+					{
+						ID:        "2",
+						URL:       "https://example.com/other",
+						State:     service.WebhookDelivered,
+						Attempts:  []*goawebhook.WebhookAttempt{{StartedAt: "2023-01-01T10:00:00Z", StatusCode: ptr.Int(200)}},
+						CreatedAt: "2023-01-01T10:00:00Z",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := webhook.New(test.storage, zap.NewNop())
+			res, err := svc.Deliveries(context.Background(), test.req)
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Nil(t, res)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// migration changes the schema of the database, e.g. creates indexes or
+// converts documents. Several instances of the service may apply the same
+// migration concurrently when they start, so migrations must be idempotent.
+type migration struct {
+	description string
+	migrate     func(s *Storage, ctx context.Context) error
+}
+
+// migrations contains the schema migrations in the order in which they are
+// applied. The version of a migration is its position in the list starting
+// from 1. Applied migrations must not be changed, changes of the schema are
+// made by appending new migrations.
+var migrations = []migration{
+	{description: "create indexes of templates, queues, lookups and other collections", migrate: (*Storage).createIndexes},
+}
+
+// schemaVersion is a document of the `schemaMigrations` collection
+// recording an applied migration.
+type schemaVersion struct {
+	Version     int       `bson:"version"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"appliedat"`
+}
+
+// Migrate applies the schema migrations which are not applied yet and
+// records their versions in the `schemaMigrations` collection. It returns
+// an error if the schema was migrated by a newer version of the service,
+// which has migrations unknown to this version.
+func (s *Storage) Migrate(ctx context.Context) error {
+	_, err := s.schemaMigrations.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "version", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return errors.New("error creating schema migrations index", err)
+	}
+
+	version, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	if version > len(migrations) {
+		return errors.New(fmt.Sprintf("database schema version %d is newer than the latest known version %d", version, len(migrations)))
+	}
+
+	for i := version; i < len(migrations); i++ {
+		if err := migrations[i].migrate(s, ctx); err != nil {
+			return errors.New(fmt.Sprintf("error applying migration %d", i+1), err)
+		}
+
+		_, err := s.schemaMigrations.InsertOne(ctx, schemaVersion{
+			Version:     i + 1,
+			Description: migrations[i].description,
+			AppliedAt:   time.Now(),
+		})
+		// the migration may have been applied by another instance concurrently
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SchemaVersion returns the version of the last applied migration,
+// or 0 if no migrations were applied.
+func (s *Storage) SchemaVersion(ctx context.Context) (int, error) {
+	opts := options.FindOne().SetSort(bson.M{"version": -1})
+
+	var version schemaVersion
+	if err := s.schemaMigrations.FindOne(ctx, bson.M{}, opts).Decode(&version); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return version.Version, nil
+}
+
+// createIndexes creates the indexes of templates, event tasks, schedules, the
+// queues, idempotency keys, webhook deliveries and dead letters. Task and taskList
+// template names must be unique, as well as template versions, idempotency keys and
+// the cache key, namespace and scope of event tasks, so creating the indexes fails
+// if there are duplicates. It also creates the indexes used to find tasks and
+// taskLists by ID, to fetch the tasks of taskList groups in order and to search
+// the queues and the history by name and state sorted by creation time. IDs are
+// unique in the queues, but not in the history, as saving the history is retried.
+func (s *Storage) createIndexes(ctx context.Context) error {
+	uniqueName := mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+
+	if _, err := s.taskTemplates.Indexes().CreateOne(ctx, uniqueName); err != nil {
+		return err
+	}
+
+	if _, err := s.taskListTemplates.Indexes().CreateOne(ctx, uniqueName); err != nil {
+		return err
+	}
+
+	uniqueVersion := mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}, {Key: "templateversion", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+
+	if _, err := s.taskTemplateVersions.Indexes().CreateOne(ctx, uniqueVersion); err != nil {
+		return err
+	}
+
+	if _, err := s.taskListTemplateVersions.Indexes().CreateOne(ctx, uniqueVersion); err != nil {
+		return err
+	}
+
+	uniqueCacheKey := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "key", Value: 1},
+			{Key: "namespace", Value: 1},
+			{Key: "scope", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}
+
+	if _, err := s.eventTasks.Indexes().CreateOne(ctx, uniqueCacheKey); err != nil {
+		return err
+	}
+
+	uniqueID := mongo.IndexModel{
+		Keys:    bson.D{{Key: "id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+
+	_, err := s.schedules.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		uniqueID,
+		{Keys: bson.D{{Key: "nextrunat", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	queueRank := mongo.IndexModel{
+		Keys: bson.D{{Key: "state", Value: 1}, {Key: "rank", Value: 1}, {Key: "createdat", Value: 1}},
+	}
+
+	leaseExpiry := mongo.IndexModel{
+		Keys: bson.D{{Key: "state", Value: 1}, {Key: "leaseexpiresat", Value: 1}},
+	}
+
+	search := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "name", Value: 1}, {Key: "createdat", Value: 1}}},
+		{Keys: bson.D{{Key: "state", Value: 1}, {Key: "createdat", Value: 1}}},
+		{Keys: bson.D{{Key: "createdat", Value: 1}, {Key: "id", Value: 1}}},
+	}
+
+	id := mongo.IndexModel{
+		Keys: bson.D{{Key: "id", Value: 1}},
+	}
+
+	group := mongo.IndexModel{
+		Keys: bson.D{{Key: "groupid", Value: 1}, {Key: "position", Value: 1}},
+	}
+
+	if _, err := s.tasks.Indexes().CreateMany(ctx, append([]mongo.IndexModel{queueRank, leaseExpiry, uniqueID, group}, search...)); err != nil {
+		return err
+	}
+
+	if _, err := s.taskLists.Indexes().CreateMany(ctx, append([]mongo.IndexModel{queueRank, leaseExpiry, uniqueID}, search...)); err != nil {
+		return err
+	}
+
+	if _, err := s.tasksHistory.Indexes().CreateMany(ctx, append([]mongo.IndexModel{id}, search...)); err != nil {
+		return err
+	}
+
+	if _, err := s.taskListHistory.Indexes().CreateMany(ctx, append([]mongo.IndexModel{id}, search...)); err != nil {
+		return err
+	}
+
+	_, err = s.idempotencyKeys.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// expired keys are removed by the database
+			Keys:    bson.D{{Key: "expiresat", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.webhookDeliveries.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		uniqueID,
+		{Keys: bson.D{{Key: "state", Value: 1}, {Key: "nextattemptat", Value: 1}}},
+		{Keys: bson.D{{Key: "resourceid", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.deadLetters.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		uniqueID,
+		{Keys: bson.D{{Key: "task.name", Value: 1}, {Key: "deadletteredat", Value: -1}}},
+		{Keys: bson.D{{Key: "deadletteredat", Value: -1}}},
+	})
+	return err
+}
@@ -2,7 +2,9 @@ package storage
 
 import (
 	"context"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/eclipse-xfsc/task-sheduler/internal/service"
 
@@ -15,35 +17,69 @@ import (
 )
 
 const (
-	taskDB            = "task"
-	taskTemplates     = "taskTemplates"
-	taskQueue         = "tasks"
-	tasksHistory      = "tasksHistory"
-	taskListQueue     = "taskLists"
-	taskListTemplates = "taskListTemplates"
-	taskListHistory   = "taskListHistory"
-	eventTasks        = "eventTasks"
+	taskDB                   = "task"
+	taskTemplates            = "taskTemplates"
+	taskTemplateVersions     = "taskTemplateVersions"
+	taskQueue                = "tasks"
+	tasksHistory             = "tasksHistory"
+	taskListQueue            = "taskLists"
+	taskListTemplates        = "taskListTemplates"
+	taskListTemplateVersions = "taskListTemplateVersions"
+	taskListHistory          = "taskListHistory"
+	eventTasks               = "eventTasks"
+	schedules                = "schedules"
+	idempotencyKeys          = "idempotencyKeys"
+	webhookDeliveries        = "webhookDeliveries"
+	deadLetters              = "deadLetters"
+	schemaMigrations         = "schemaMigrations"
 )
 
+// Storage keeps the latest version of each template in the `taskTemplates`
+// and `taskListTemplates` collections, while all template versions are kept in
+// the `taskTemplateVersions` and `taskListTemplateVersions` collections.
 type Storage struct {
-	eventTasks        *mongo.Collection
-	taskTemplates     *mongo.Collection
-	tasks             *mongo.Collection
-	tasksHistory      *mongo.Collection
-	taskLists         *mongo.Collection
-	taskListTemplates *mongo.Collection
-	taskListHistory   *mongo.Collection
+	eventTasks               *mongo.Collection
+	taskTemplates            *mongo.Collection
+	taskTemplateVersions     *mongo.Collection
+	tasks                    *mongo.Collection
+	tasksHistory             *mongo.Collection
+	taskLists                *mongo.Collection
+	taskListTemplates        *mongo.Collection
+	taskListTemplateVersions *mongo.Collection
+	taskListHistory          *mongo.Collection
+	schedules                *mongo.Collection
+	idempotencyKeys          *mongo.Collection
+	webhookDeliveries        *mongo.Collection
+	deadLetters              *mongo.Collection
+	schemaMigrations         *mongo.Collection
+
+	// priorityAging is the time waited in the queue which
+	// equals one level of task and taskList priority.
+	priorityAging time.Duration
 }
 
-func New(db *mongo.Client) *Storage {
+func New(db *mongo.Client, priorityAging time.Duration) *Storage {
+	return newStorage(db.Database(taskDB), priorityAging)
+}
+
+// newStorage creates a storage keeping its collections in the given database.
+func newStorage(db *mongo.Database, priorityAging time.Duration) *Storage {
 	return &Storage{
-		eventTasks:        db.Database(taskDB).Collection(eventTasks),
-		taskTemplates:     db.Database(taskDB).Collection(taskTemplates),
-		tasks:             db.Database(taskDB).Collection(taskQueue),
-		tasksHistory:      db.Database(taskDB).Collection(tasksHistory),
-		taskListTemplates: db.Database(taskDB).Collection(taskListTemplates),
-		taskLists:         db.Database(taskDB).Collection(taskListQueue),
-		taskListHistory:   db.Database(taskDB).Collection(taskListHistory),
+		priorityAging:            priorityAging,
+		eventTasks:               db.Collection(eventTasks),
+		taskTemplates:            db.Collection(taskTemplates),
+		taskTemplateVersions:     db.Collection(taskTemplateVersions),
+		tasks:                    db.Collection(taskQueue),
+		tasksHistory:             db.Collection(tasksHistory),
+		taskListTemplates:        db.Collection(taskListTemplates),
+		taskListTemplateVersions: db.Collection(taskListTemplateVersions),
+		taskLists:                db.Collection(taskListQueue),
+		taskListHistory:          db.Collection(taskListHistory),
+		schedules:                db.Collection(schedules),
+		idempotencyKeys:          db.Collection(idempotencyKeys),
+		webhookDeliveries:        db.Collection(webhookDeliveries),
+		deadLetters:              db.Collection(deadLetters),
+		schemaMigrations:         db.Collection(schemaMigrations),
 	}
 }
 
@@ -67,23 +103,142 @@ func (s *Storage) TaskTemplate(ctx context.Context, taskName string) (*service.T
 	return &task, nil
 }
 
+// TaskTemplateVersion retrieves a specific version of a task template.
+func (s *Storage) TaskTemplateVersion(ctx context.Context, taskName string, version int) (*service.Task, error) {
+	result := s.taskTemplateVersions.FindOne(ctx, bson.M{
+		"name":            taskName,
+		"templateversion": version,
+	})
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "task template version not found")
+		}
+		return nil, result.Err()
+	}
+
+	var task service.Task
+	if err := result.Decode(&task); err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// AddTaskTemplate inserts the first version of a new task template
+// in the `taskTemplates` and `taskTemplateVersions` collections. If a
+// template with the same name was deleted, its kept versions are
+// continued, so that they're not overwritten.
+func (s *Storage) AddTaskTemplate(ctx context.Context, template *service.Task) error {
+	opts := options.FindOne().SetSort(bson.M{"templateversion": -1})
+	var last service.Task
+	err := s.taskTemplateVersions.FindOne(ctx, bson.M{"name": template.Name}, opts).Decode(&last)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return err
+	}
+
+	template.TemplateVersion = last.TemplateVersion + 1
+
+	_, err = s.taskTemplates.InsertOne(ctx, template)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New(errors.Exist, "task template already exists")
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.taskTemplateVersions.InsertOne(ctx, template); err != nil {
+		// remove the template, so that it can be created again
+		if _, err := s.taskTemplates.DeleteOne(ctx, bson.M{"name": template.Name}); err != nil {
+			return errors.New("failed to remove task template", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UpdateTaskTemplate saves the template as a new version of an existing
+// task template and makes it the latest version. Concurrent updates of
+// the same template are rejected by the unique index of template versions.
+func (s *Storage) UpdateTaskTemplate(ctx context.Context, template *service.Task) error {
+	latest, err := s.TaskTemplate(ctx, template.Name)
+	if err != nil {
+		return err
+	}
+
+	template.TemplateVersion = latest.TemplateVersion + 1
+
+	_, err = s.taskTemplateVersions.InsertOne(ctx, template)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New(errors.Exist, "task template was modified concurrently, try again")
+	}
+	if err != nil {
+		return err
+	}
+
+	result, err := s.taskTemplates.ReplaceOne(ctx, bson.M{"name": template.Name}, template)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New(errors.NotFound, "task template not found")
+	}
+
+	return nil
+}
+
+// DeleteTaskTemplate removes a task template. Its versions are kept
+// in the `taskTemplateVersions` collection, because they can be used
+// by pinned versions of taskList templates.
+func (s *Storage) DeleteTaskTemplate(ctx context.Context, taskName string) error {
+	result, err := s.taskTemplates.DeleteOne(ctx, bson.M{"name": taskName})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New(errors.NotFound, "task template not found")
+	}
+
+	return nil
+}
+
+// ListTaskTemplates retrieves all task templates sorted by name.
+func (s *Storage) ListTaskTemplates(ctx context.Context) ([]*service.Task, error) {
+	opts := options.Find().SetSort(bson.M{"name": 1})
+	return findTasks(ctx, s.taskTemplates, bson.M{}, opts)
+}
+
 func (s *Storage) Add(ctx context.Context, task *service.Task) error {
+	task.Rank = service.Rank(task.CreatedAt, task.RunAt, task.Priority, s.priorityAging)
 	_, err := s.tasks.InsertOne(ctx, task)
 	return err
 }
 
 // Poll retrieves one task with empty groupID from the tasks collection
-// with the older ones being retrieved first (FIFO). It updates the state
-// of the task to "pending", so that consequent calls to Poll would
-// not retrieve the same task.
-func (s *Storage) Poll(ctx context.Context) (*service.Task, error) {
+// ordered by their rank, so tasks with higher priority and older tasks
+// are retrieved first (see service.Rank). Tasks scheduled for
+// execution in the future and failed tasks waiting for their next
+// attempt are not retrieved until their time comes. It updates the state
+// of the task to "pending" and sets its execution start time, so that
+// consequent calls to Poll would not retrieve the same task. The task is
+// leased to the given owner for the lease duration, see RequeueExpired.
+func (s *Storage) Poll(ctx context.Context, owner string, lease time.Duration) (*service.Task, error) {
 	opts := options.
 		FindOneAndUpdate().
-		SetSort(bson.M{"createdAt": 1}).
+		SetSort(bson.D{{Key: "rank", Value: 1}, {Key: "createdat", Value: 1}}).
 		SetReturnDocument(options.After)
 
-	filter := bson.M{"state": service.Created, "groupid": ""}
-	update := bson.M{"$set": bson.M{"state": service.Pending}}
+	now := time.Now()
+	filter := claimableTasks(now)
+	update := bson.M{"$set": bson.M{
+		"state":          service.Pending,
+		"startedat":      now,
+		"leaseowner":     owner,
+		"leaseexpiresat": now.Add(lease),
+	}}
 	result := s.tasks.FindOneAndUpdate(
 		ctx,
 		filter,
@@ -106,21 +261,323 @@ func (s *Storage) Poll(ctx context.Context) (*service.Task, error) {
 	return &task, nil
 }
 
-// Ack removes a task from the `tasks` collection.
+// PollBatch retrieves up to limit tasks in the same order as Poll and
+// leases them to the given owner for the lease duration. Each task is
+// claimed atomically with Poll, so that concurrent polls of other instances
+// of the service don't reduce the number of returned tasks. If a poll fails
+// after some tasks are claimed, the claimed tasks are returned. If there
+// are no tasks to execute, it returns a NotFound error.
+func (s *Storage) PollBatch(ctx context.Context, owner string, lease time.Duration, limit int) ([]*service.Task, error) {
+	var tasks []*service.Task
+	for len(tasks) < limit {
+		task, err := s.Poll(ctx, owner, lease)
+		if err != nil {
+			if len(tasks) > 0 {
+				break
+			}
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	if len(tasks) == 0 {
+		return nil, errors.New(errors.NotFound, "task not found")
+	}
+
+	return tasks, nil
+}
+
+// WatchTasks watches the change stream of the `tasks` collection and notifies
+// on the returned channel when a task is added to the queue or returned to it.
+// Multiple changes are coalesced in a single notification if the receiver is
+// busy. The channel is closed when the change stream fails or ctx is done.
+// Change streams are only supported by replica sets and sharded clusters,
+// so it returns an error for a standalone MongoDB.
+func (s *Storage) WatchTasks(ctx context.Context) (<-chan struct{}, error) {
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{"$or": []bson.M{
+		{"operationType": "insert", "fullDocument.state": service.Created, "fullDocument.groupid": ""},
+		{"operationType": "update", "updateDescription.updatedFields.state": service.Created},
+	}}}}}
+
+	stream, err := s.tasks.Watch(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	return notify(ctx, stream), nil
+}
+
+// WatchTaskLists watches the change stream of the `taskLists` collection in
+// the same way as WatchTasks does for tasks.
+func (s *Storage) WatchTaskLists(ctx context.Context) (<-chan struct{}, error) {
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{"$or": []bson.M{
+		{"operationType": "insert", "fullDocument.state": service.Created},
+		{"operationType": "update", "updateDescription.updatedFields.state": service.Created},
+	}}}}}
+
+	stream, err := s.taskLists.Watch(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	return notify(ctx, stream), nil
+}
+
+// notify sends a notification for every event of the change stream
+// unless a previous notification is still waiting to be received.
+func notify(ctx context.Context, stream *mongo.ChangeStream) <-chan struct{} {
+	c := make(chan struct{}, 1)
+	go func() {
+		defer close(c)
+		defer stream.Close(context.Background()) //nolint:errcheck
+
+		for stream.Next(ctx) {
+			select {
+			case c <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return c
+}
+
+// claimableTasks returns the filter of the tasks which can be taken from the
+// queue for execution at the given time. Tasks which are part of a taskList
+// are executed by the taskList executor and are not taken from the queue.
+func claimableTasks(now time.Time) bson.M {
+	return bson.M{
+		"state":         service.Created,
+		"groupid":       "",
+		"runat":         bson.M{"$not": bson.M{"$gt": now}},
+		"nextattemptat": bson.M{"$not": bson.M{"$gt": now}},
+	}
+}
+
+// Heartbeat extends the lease of a pending task held by the lease owner of
+// the task. It returns a NotFound error if the task is no longer held by
+// the owner, e.g. because the lease expired and the task was requeued.
+func (s *Storage) Heartbeat(ctx context.Context, task *service.Task, lease time.Duration) error {
+	filter := bson.M{"id": task.ID, "state": service.Pending, "leaseowner": task.LeaseOwner}
+	update := bson.M{"$set": bson.M{"leaseexpiresat": time.Now().Add(lease)}}
+	result, err := s.tasks.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New(errors.NotFound, "task lease not found")
+	}
+	return nil
+}
+
+// ClaimTask updates the state of a "created" task with empty groupID to
+// "pending" and leases it to the given owner, like Poll does. It's used by
+// queues which deliver the tasks themselves and keep their state in storage.
+// A "pending" task is claimed again when it's redelivered, because the
+// execution was interrupted, and this counts as a failed execution attempt.
+// It returns a NotFound error if the task was removed or cancelled.
+func (s *Storage) ClaimTask(ctx context.Context, taskID, owner string, lease time.Duration) (*service.Task, error) {
+	opts := options.
+		FindOneAndUpdate().
+		SetReturnDocument(options.After)
+
+	now := time.Now()
+	filter := bson.M{
+		"id":      taskID,
+		"groupid": "",
+		"state":   bson.M{"$in": []string{service.Created, service.Pending}},
+	}
+	interrupted := bson.M{"$eq": bson.A{"$state", service.Pending}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"state":          service.Pending,
+		"startedat":      now,
+		"leaseowner":     owner,
+		"leaseexpiresat": now.Add(lease),
+		"retries": bson.M{"$cond": bson.A{
+			interrupted, bson.M{"$add": bson.A{"$retries", 1}}, "$retries",
+		}},
+		"error": bson.M{"$cond": bson.A{
+			interrupted, service.LeaseExpiredError, "$error",
+		}},
+		"errors": bson.M{"$cond": bson.A{
+			interrupted,
+			bson.M{"$slice": bson.A{
+				bson.M{"$concatArrays": bson.A{bson.M{"$ifNull": bson.A{"$errors", bson.A{}}}, bson.A{service.LeaseExpiredError}}},
+				-service.MaxTaskErrors,
+			}},
+			"$errors",
+		}},
+	}}}}
+	result := s.tasks.FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		opts,
+	)
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "task not found")
+		}
+		return nil, result.Err()
+	}
+
+	var task service.Task
+	if err := result.Decode(&task); err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// RequeueExpired returns pending tasks with empty groupID which lease has
+// expired to the queue, because the instance of the service executing them
+// stopped. Returning a task to the queue counts as a failed execution attempt.
+// It returns the number of requeued tasks.
+func (s *Storage) RequeueExpired(ctx context.Context, now time.Time) (int, error) {
+	filter := bson.M{
+		"state":          service.Pending,
+		"groupid":        "",
+		"leaseexpiresat": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"state":      service.Created,
+			"error":      service.LeaseExpiredError,
+			"leaseowner": "",
+		},
+		"$inc": bson.M{"retries": 1},
+		"$push": bson.M{"errors": bson.M{
+			"$each":  []string{service.LeaseExpiredError},
+			"$slice": -service.MaxTaskErrors,
+		}},
+	}
+	result, err := s.tasks.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return int(result.ModifiedCount), nil
+}
+
+// Ack removes a task from the `tasks` collection, unless the task
+// was leased to another owner in the meantime.
 func (s *Storage) Ack(ctx context.Context, task *service.Task) error {
-	_, err := s.tasks.DeleteOne(ctx, bson.M{"id": task.ID})
+	_, err := s.tasks.DeleteOne(ctx, bson.M{"id": task.ID, "leaseowner": task.LeaseOwner})
 	return err
 }
 
 // Unack changes the "pending" state of a task to "created", so that
-// it can be retrieved for processing again.
+// it can be retrieved for processing again after its next attempt
+// time. The error of the task is
+// appended to its most recent errors. Cancelled tasks and tasks which
+// were leased to another owner in the meantime are not changed.
 func (s *Storage) Unack(ctx context.Context, t *service.Task) error {
-	filter := bson.M{"id": t.ID}
-	update := bson.M{"$set": bson.M{"state": service.Created, "retries": t.Retries + 1}}
+	filter := bson.M{"id": t.ID, "leaseowner": t.LeaseOwner, "state": bson.M{"$ne": service.Cancelled}}
+	update := bson.M{
+		"$set": bson.M{
+			"state":         service.Created,
+			"retries":       t.Retries + 1,
+			"error":         t.Error,
+			"failurereason": t.FailureReason,
+			"nextattemptat": t.NextAttemptAt,
+			"responsecode":  t.ResponseCode,
+			"response":      t.Response,
+		},
+		"$push": bson.M{"errors": bson.M{
+			"$each":  []string{t.Error},
+			"$slice": -service.MaxTaskErrors,
+		}},
+	}
 	_, err := s.tasks.UpdateOne(ctx, filter, update)
 	return err
 }
 
+// Cancel changes the state of a "created" or "pending" task with
+// empty groupID to "cancelled", so that consequent calls to Poll would
+// not retrieve it and workers executing it could interrupt the execution.
+// A "created" task is moved to the `tasksHistory` collection immediately,
+// while a "pending" task is moved there by the worker executing it, or by
+// ArchiveCancelled if its lease expires. It returns the task as it was
+// before the cancellation.
+func (s *Storage) Cancel(ctx context.Context, taskID string) (*service.Task, error) {
+	opts := options.
+		FindOneAndUpdate().
+		SetReturnDocument(options.Before)
+
+	filter := bson.M{
+		"id":      taskID,
+		"groupid": "",
+		"state":   bson.M{"$in": []string{service.Created, service.Pending}},
+	}
+	update := bson.M{"$set": bson.M{"state": service.Cancelled}}
+	result := s.tasks.FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		opts,
+	)
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "task not found")
+		}
+		return nil, result.Err()
+	}
+
+	var task service.Task
+	if err := result.Decode(&task); err != nil {
+		return nil, err
+	}
+
+	if task.State == service.Created {
+		cancelled := task
+		if err := s.archiveCancelled(ctx, &cancelled); err != nil {
+			return nil, errors.New("task is cancelled, but not moved to history", err)
+		}
+	}
+
+	return &task, nil
+}
+
+// ArchiveCancelled moves cancelled tasks with empty groupID which are not
+// leased, or which lease has expired, from the `tasks` collection to the
+// `tasksHistory` collection. These are tasks which couldn't be moved when
+// they were cancelled, or which executions were interrupted by a stop of
+// the instance executing them. It returns the number of moved tasks.
+func (s *Storage) ArchiveCancelled(ctx context.Context, now time.Time) (int, error) {
+	filter := bson.M{
+		"state":          service.Cancelled,
+		"groupid":        "",
+		"leaseexpiresat": bson.M{"$lte": now},
+	}
+
+	tasks, err := findTasks(ctx, s.tasks, filter, options.Find())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, task := range tasks {
+		if err := s.archiveCancelled(ctx, task); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(tasks), nil
+}
+
+// archiveCancelled saves a cancelled task in the `tasksHistory` collection
+// and removes it from the `tasks` collection.
+func (s *Storage) archiveCancelled(ctx context.Context, task *service.Task) error {
+	task.State = service.Cancelled
+	task.FinishedAt = time.Now()
+
+	if err := s.SaveTaskHistory(ctx, task); err != nil {
+		return err
+	}
+
+	_, err := s.tasks.DeleteOne(ctx, bson.M{"id": task.ID, "state": service.Cancelled})
+	return err
+}
+
 // SaveTaskHistory saves a task to the `tasksHistory` collection.
 func (s *Storage) SaveTaskHistory(ctx context.Context, task *service.Task) error {
 	insert := func() error {
@@ -217,21 +674,54 @@ func (s *Storage) TaskTemplates(ctx context.Context, names []string) (map[string
 	return res, nil
 }
 
-func (s *Storage) AddTaskList(ctx context.Context, taskList *service.TaskList, tasks []*service.Task) error {
-	_, err := s.taskLists.InsertOne(ctx, taskList)
-	if err != nil {
-		return err
+// TaskListTemplateVersion retrieves a specific version of a taskList template.
+func (s *Storage) TaskListTemplateVersion(ctx context.Context, taskListName string, version int) (*service.Template, error) {
+	result := s.taskListTemplateVersions.FindOne(ctx, bson.M{
+		"name":            taskListName,
+		"templateversion": version,
+	})
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "taskList template version not found")
+		}
+		return nil, result.Err()
 	}
 
-	var ti []interface{}
-	for _, task := range tasks {
-		ti = append(ti, task)
+	var tasklist service.Template
+	if err := result.Decode(&tasklist); err != nil {
+		return nil, err
 	}
 
-	_, err = s.tasks.InsertMany(ctx, ti)
+	return &tasklist, nil
+}
+
+// AddTaskListTemplate inserts the first version of a new taskList template
+// in the `taskListTemplates` and `taskListTemplateVersions` collections. If a
+// template with the same name was deleted, its kept versions are
+// continued, so that they're not overwritten.
+func (s *Storage) AddTaskListTemplate(ctx context.Context, template *service.Template) error {
+	opts := options.FindOne().SetSort(bson.M{"templateversion": -1})
+	var last service.Template
+	err := s.taskListTemplateVersions.FindOne(ctx, bson.M{"name": template.Name}, opts).Decode(&last)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return err
+	}
+
+	template.TemplateVersion = last.TemplateVersion + 1
+
+	_, err = s.taskListTemplates.InsertOne(ctx, template)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New(errors.Exist, "taskList template already exists")
+	}
 	if err != nil {
-		if err := s.AckList(ctx, taskList); err != nil { // remove taskList from queue
-			return errors.New("failed to ack taskList", err)
+		return err
+	}
+
+	if _, err := s.taskListTemplateVersions.InsertOne(ctx, template); err != nil {
+		// remove the template, so that it can be created again
+		if _, err := s.taskListTemplates.DeleteOne(ctx, bson.M{"name": template.Name}); err != nil {
+			return errors.New("failed to remove taskList template", err)
 		}
 		return err
 	}
@@ -239,61 +729,253 @@ func (s *Storage) AddTaskList(ctx context.Context, taskList *service.TaskList, t
 	return nil
 }
 
-// AckList removes a taskList from the `tasksLists` collection.
-func (s *Storage) AckList(ctx context.Context, taskList *service.TaskList) error {
-	_, err := s.taskLists.DeleteOne(ctx, bson.M{"id": taskList.ID})
-	return err
-}
+// UpdateTaskListTemplate saves the template as a new version of an existing
+// taskList template and makes it the latest version. Concurrent updates of
+// the same template are rejected by the unique index of template versions.
+func (s *Storage) UpdateTaskListTemplate(ctx context.Context, template *service.Template) error {
+	latest, err := s.TaskListTemplate(ctx, template.Name)
+	if err != nil {
+		return err
+	}
 
-// PollList retrieves one taskList from the taskLists collection
-// with the older ones being retrieved first (FIFO). It updates the state
-// of the task to "pending", so that consequent calls to PollList would
-// not retrieve the same task.
-func (s *Storage) PollList(ctx context.Context) (*service.TaskList, error) {
-	opts := options.
-		FindOneAndUpdate().
-		SetSort(bson.M{"createdAt": 1}).
-		SetReturnDocument(options.After)
+	template.TemplateVersion = latest.TemplateVersion + 1
 
-	filter := bson.M{"state": service.Created}
-	update := bson.M{"$set": bson.M{"state": service.Pending}}
-	result := s.taskLists.FindOneAndUpdate(
-		ctx,
-		filter,
-		update,
-		opts,
-	)
+	_, err = s.taskListTemplateVersions.InsertOne(ctx, template)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New(errors.Exist, "taskList template was modified concurrently, try again")
+	}
+	if err != nil {
+		return err
+	}
 
-	if result.Err() != nil {
-		if strings.Contains(result.Err().Error(), "no documents in result") {
-			return nil, errors.New(errors.NotFound, "taskList not found")
-		}
-		return nil, result.Err()
+	result, err := s.taskListTemplates.ReplaceOne(ctx, bson.M{"name": template.Name}, template)
+	if err != nil {
+		return err
 	}
 
-	var list service.TaskList
-	if err := result.Decode(&list); err != nil {
-		return nil, err
+	if result.MatchedCount == 0 {
+		return errors.New(errors.NotFound, "taskList template not found")
 	}
 
-	return &list, nil
+	return nil
 }
 
-// GetGroupTasks fetches all tasks by a groupID
-func (s *Storage) GetGroupTasks(ctx context.Context, group *service.Group) ([]*service.Task, error) {
-	filter := bson.M{"groupid": group.ID}
-	opts := options.Find().SetSort(bson.M{"createdAt": 1})
-
-	cursor, err := s.tasks.Find(ctx, filter, opts)
+// DeleteTaskListTemplate removes a taskList template. Its versions are kept
+// in the `taskListTemplateVersions` collection, because they can be used
+// by taskLists created from them.
+func (s *Storage) DeleteTaskListTemplate(ctx context.Context, taskListName string) error {
+	result, err := s.taskListTemplates.DeleteOne(ctx, bson.M{"name": taskListName})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer cursor.Close(ctx)
 
-	var tasks []*service.Task
-	for cursor.Next(ctx) {
-		var task service.Task
-		if err := cursor.Decode(&task); err != nil {
+	if result.DeletedCount == 0 {
+		return errors.New(errors.NotFound, "taskList template not found")
+	}
+
+	return nil
+}
+
+// ListTaskListTemplates retrieves all taskList templates sorted by name.
+func (s *Storage) ListTaskListTemplates(ctx context.Context) ([]*service.Template, error) {
+	opts := options.Find().SetSort(bson.M{"name": 1})
+	return s.findTaskListTemplates(ctx, bson.M{}, opts)
+}
+
+// TaskListTemplatesByTask retrieves the taskList templates containing
+// a task with the given name in any of their groups.
+func (s *Storage) TaskListTemplatesByTask(ctx context.Context, taskName string) ([]*service.Template, error) {
+	opts := options.Find().SetSort(bson.M{"name": 1})
+	return s.findTaskListTemplates(ctx, bson.M{"groups.tasks": taskName}, opts)
+}
+
+func (s *Storage) findTaskListTemplates(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*service.Template, error) {
+	cursor, err := s.taskListTemplates.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var templates []*service.Template
+	for cursor.Next(ctx) {
+		var template service.Template
+		if err := cursor.Decode(&template); err != nil {
+			return nil, err
+		}
+		templates = append(templates, &template)
+	}
+
+	return templates, cursor.Err()
+}
+
+func (s *Storage) AddTaskList(ctx context.Context, taskList *service.TaskList, tasks []*service.Task) error {
+	taskList.Rank = service.Rank(taskList.CreatedAt, taskList.RunAt, taskList.Priority, s.priorityAging)
+	_, err := s.taskLists.InsertOne(ctx, taskList)
+	if err != nil {
+		return err
+	}
+
+	var ti []interface{}
+	for _, task := range tasks {
+		ti = append(ti, task)
+	}
+
+	_, err = s.tasks.InsertMany(ctx, ti)
+	if err != nil {
+		if err := s.AckList(ctx, taskList); err != nil { // remove taskList from queue
+			return errors.New("failed to ack taskList", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// AckList removes a taskList from the `tasksLists` collection.
+func (s *Storage) AckList(ctx context.Context, taskList *service.TaskList) error {
+	_, err := s.taskLists.DeleteOne(ctx, bson.M{"id": taskList.ID})
+	return err
+}
+
+// PollList retrieves one taskList from the taskLists collection ordered
+// by their rank, so taskLists with higher priority and older taskLists
+// are retrieved first (see service.Rank). TaskLists scheduled for
+// execution in the future are not retrieved until their time comes. It updates the state
+// of the task to "pending", so that consequent calls to PollList would
+// not retrieve the same task. The taskList is leased to the given owner
+// for the lease duration, see RequeueExpiredLists.
+func (s *Storage) PollList(ctx context.Context, owner string, lease time.Duration) (*service.TaskList, error) {
+	opts := options.
+		FindOneAndUpdate().
+		SetSort(bson.D{{Key: "rank", Value: 1}, {Key: "createdat", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	now := time.Now()
+	filter := bson.M{
+		"state": service.Created,
+		"runat": bson.M{"$not": bson.M{"$gt": now}},
+	}
+	update := bson.M{"$set": bson.M{
+		"state":          service.Pending,
+		"leaseowner":     owner,
+		"leaseexpiresat": now.Add(lease),
+	}}
+	result := s.taskLists.FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		opts,
+	)
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "taskList not found")
+		}
+		return nil, result.Err()
+	}
+
+	var list service.TaskList
+	if err := result.Decode(&list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// HeartbeatList extends the lease of a pending taskList held by the lease
+// owner of the taskList. It returns a NotFound error if the taskList is no
+// longer held by the owner.
+func (s *Storage) HeartbeatList(ctx context.Context, taskList *service.TaskList, lease time.Duration) error {
+	filter := bson.M{"id": taskList.ID, "state": service.Pending, "leaseowner": taskList.LeaseOwner}
+	update := bson.M{"$set": bson.M{"leaseexpiresat": time.Now().Add(lease)}}
+	result, err := s.taskLists.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New(errors.NotFound, "taskList lease not found")
+	}
+	return nil
+}
+
+// ClaimTaskList updates the state of a "created" taskList to "pending" and
+// leases it to the given owner in the same way as ClaimTask does for tasks.
+// The retries of a redelivered "pending" taskList are incremented.
+func (s *Storage) ClaimTaskList(ctx context.Context, taskListID, owner string, lease time.Duration) (*service.TaskList, error) {
+	opts := options.
+		FindOneAndUpdate().
+		SetReturnDocument(options.After)
+
+	now := time.Now()
+	filter := bson.M{
+		"id":    taskListID,
+		"state": bson.M{"$in": []string{service.Created, service.Pending}},
+	}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"state":          service.Pending,
+		"leaseowner":     owner,
+		"leaseexpiresat": now.Add(lease),
+		"retries": bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{"$state", service.Pending}}, bson.M{"$add": bson.A{"$retries", 1}}, "$retries",
+		}},
+	}}}}
+	result := s.taskLists.FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		opts,
+	)
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "taskList not found")
+		}
+		return nil, result.Err()
+	}
+
+	var list service.TaskList
+	if err := result.Decode(&list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// RequeueExpiredLists returns pending taskLists which lease has expired to
+// the queue and increments their retries. Groups which were completed before
+// the taskList was interrupted have no tasks left, so only the remaining
+// groups are executed again. It returns the number of requeued taskLists.
+func (s *Storage) RequeueExpiredLists(ctx context.Context, now time.Time) (int, error) {
+	filter := bson.M{
+		"state":          service.Pending,
+		"leaseexpiresat": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{"state": service.Created, "leaseowner": ""},
+		"$inc": bson.M{"retries": 1},
+	}
+	result, err := s.taskLists.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return int(result.ModifiedCount), nil
+}
+
+// GetGroupTasks fetches all tasks of a group sorted by their position in the group.
+func (s *Storage) GetGroupTasks(ctx context.Context, group *service.Group) ([]*service.Task, error) {
+	filter := bson.M{"groupid": group.ID}
+	opts := options.Find().SetSort(bson.M{"position": 1})
+
+	cursor, err := s.tasks.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tasks []*service.Task
+	for cursor.Next(ctx) {
+		var task service.Task
+		if err := cursor.Decode(&task); err != nil {
 			return nil, err
 		}
 		tasks = append(tasks, &task)
@@ -382,3 +1064,623 @@ func (s *Storage) EventTask(ctx context.Context, key, namespace, scope string) (
 
 	return &eventTask, nil
 }
+
+// AddEventTask inserts a new event task binding in the `eventTasks` collection.
+func (s *Storage) AddEventTask(ctx context.Context, eventTask *service.EventTask) error {
+	_, err := s.eventTasks.InsertOne(ctx, eventTask)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New(errors.Exist, "eventTask already exists")
+	}
+	return err
+}
+
+// DeleteEventTask removes the event task binding for the given cache key,
+// namespace and scope from the `eventTasks` collection.
+func (s *Storage) DeleteEventTask(ctx context.Context, key, namespace, scope string) error {
+	result, err := s.eventTasks.DeleteOne(ctx, bson.M{
+		"key":       key,
+		"namespace": namespace,
+		"scope":     scope,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New(errors.NotFound, "eventTask not found")
+	}
+
+	return nil
+}
+
+// ListEventTasks retrieves all event task bindings sorted by cache key,
+// namespace and scope.
+func (s *Storage) ListEventTasks(ctx context.Context) ([]*service.EventTask, error) {
+	opts := options.Find().SetSort(bson.D{
+		{Key: "key", Value: 1},
+		{Key: "namespace", Value: 1},
+		{Key: "scope", Value: 1},
+	})
+
+	cursor, err := s.eventTasks.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var eventTasks []*service.EventTask
+	for cursor.Next(ctx) {
+		var eventTask service.EventTask
+		if err := cursor.Decode(&eventTask); err != nil {
+			return nil, err
+		}
+		eventTasks = append(eventTasks, &eventTask)
+	}
+
+	return eventTasks, cursor.Err()
+}
+
+// AddSchedule inserts a new schedule in the `schedules` collection.
+func (s *Storage) AddSchedule(ctx context.Context, schedule *service.Schedule) error {
+	_, err := s.schedules.InsertOne(ctx, schedule)
+	return err
+}
+
+func (s *Storage) Schedule(ctx context.Context, scheduleID string) (*service.Schedule, error) {
+	result := s.schedules.FindOne(ctx, bson.M{"id": scheduleID})
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "schedule not found")
+		}
+		return nil, result.Err()
+	}
+
+	var schedule service.Schedule
+	if err := result.Decode(&schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// DeleteSchedule removes a schedule from the `schedules` collection.
+func (s *Storage) DeleteSchedule(ctx context.Context, scheduleID string) error {
+	result, err := s.schedules.DeleteOne(ctx, bson.M{"id": scheduleID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New(errors.NotFound, "schedule not found")
+	}
+
+	return nil
+}
+
+// ListSchedules retrieves all schedules sorted by creation time.
+func (s *Storage) ListSchedules(ctx context.Context) ([]*service.Schedule, error) {
+	opts := options.Find().SetSort(bson.M{"createdat": 1})
+	return s.findSchedules(ctx, bson.M{}, opts)
+}
+
+// DueSchedules retrieves the schedules which next run time has come or
+// which have a pending run, sorted by the next run time.
+func (s *Storage) DueSchedules(ctx context.Context, now time.Time) ([]*service.Schedule, error) {
+	opts := options.Find().SetSort(bson.M{"nextrunat": 1})
+	filter := bson.M{"$or": []bson.M{
+		{"nextrunat": bson.M{"$lte": now}},
+		{"pendingrun": bson.M{"$ne": nil}},
+	}}
+	return s.findSchedules(ctx, filter, opts)
+}
+
+// ClaimSchedule moves the next run time of a schedule from the scheduled
+// time of the run to nextRunAt and records the run as pending in the same
+// update. The update is conditional on the current next run time and on
+// the schedule having no pending run, so when several instances of the
+// service try to claim the same run, only one of them succeeds and the
+// others receive a NotFound error.
+func (s *Storage) ClaimSchedule(ctx context.Context, scheduleID string, nextRunAt time.Time, run *service.ScheduleRun) error {
+	result, err := s.schedules.UpdateOne(
+		ctx,
+		bson.M{"id": scheduleID, "nextrunat": run.ScheduledAt, "pendingrun": nil},
+		bson.M{"$set": bson.M{"nextrunat": nextRunAt, "pendingrun": run}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New(errors.NotFound, "schedule run is already claimed")
+	}
+
+	return nil
+}
+
+// ClaimPendingRun changes the claim time of a pending run of a schedule,
+// so that it's retried by a single instance of the service. It returns a
+// NotFound error if the run was claimed again or completed in the meantime.
+func (s *Storage) ClaimPendingRun(ctx context.Context, scheduleID string, run *service.ScheduleRun, claimedAt time.Time) error {
+	result, err := s.schedules.UpdateOne(
+		ctx,
+		bson.M{"id": scheduleID, "pendingrun.id": run.ID, "pendingrun.claimedat": run.ClaimedAt},
+		bson.M{"$set": bson.M{"pendingrun.claimedat": claimedAt}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New(errors.NotFound, "schedule run is already claimed")
+	}
+
+	return nil
+}
+
+// CompleteScheduleRun records a pending run of a schedule keeping only the
+// most recent runs, and removes the pending run.
+func (s *Storage) CompleteScheduleRun(ctx context.Context, scheduleID string, run *service.ScheduleRun) error {
+	_, err := s.schedules.UpdateOne(
+		ctx,
+		bson.M{"id": scheduleID, "pendingrun.id": run.ID},
+		bson.M{
+			"$set":   bson.M{"lastrunat": run.ScheduledAt},
+			"$unset": bson.M{"pendingrun": ""},
+			"$push": bson.M{"runs": bson.M{
+				"$each":  []*service.ScheduleRun{run},
+				"$slice": -service.MaxScheduleRuns,
+			}},
+		},
+	)
+	return err
+}
+
+// DiscardScheduleRun removes a pending run of a schedule without recording it.
+func (s *Storage) DiscardScheduleRun(ctx context.Context, scheduleID string, runID string) error {
+	_, err := s.schedules.UpdateOne(
+		ctx,
+		bson.M{"id": scheduleID, "pendingrun.id": runID},
+		bson.M{"$unset": bson.M{"pendingrun": ""}},
+	)
+	return err
+}
+
+func (s *Storage) findSchedules(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*service.Schedule, error) {
+	cursor, err := s.schedules.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var schedules []*service.Schedule
+	for cursor.Next(ctx) {
+		var schedule service.Schedule
+		if err := cursor.Decode(&schedule); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, &schedule)
+	}
+
+	return schedules, cursor.Err()
+}
+
+// AddIdempotencyKey inserts an idempotency key in the `idempotencyKeys` collection.
+// An expired key with the same value is replaced. If the key exists and is not
+// expired, the unique index on the key rejects the insert and an Exist error is returned.
+func (s *Storage) AddIdempotencyKey(ctx context.Context, key *service.IdempotencyKey) error {
+	_, err := s.idempotencyKeys.ReplaceOne(
+		ctx,
+		bson.M{"key": key.Key, "expiresat": bson.M{"$lte": time.Now()}},
+		key,
+		options.Replace().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New(errors.Exist, "idempotency key already exists")
+	}
+	return err
+}
+
+func (s *Storage) IdempotencyKey(ctx context.Context, key string) (*service.IdempotencyKey, error) {
+	result := s.idempotencyKeys.FindOne(ctx, bson.M{"key": key})
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "idempotency key not found")
+		}
+		return nil, result.Err()
+	}
+
+	var idempotencyKey service.IdempotencyKey
+	if err := result.Decode(&idempotencyKey); err != nil {
+		return nil, err
+	}
+
+	return &idempotencyKey, nil
+}
+
+// DeleteIdempotencyKey removes an idempotency key, so that it can be used again.
+func (s *Storage) DeleteIdempotencyKey(ctx context.Context, key string) error {
+	_, err := s.idempotencyKeys.DeleteOne(ctx, bson.M{"key": key})
+	return err
+}
+
+// AddWebhookDelivery inserts a webhook delivery in the `webhookDeliveries` collection.
+func (s *Storage) AddWebhookDelivery(ctx context.Context, delivery *service.WebhookDelivery) error {
+	_, err := s.webhookDeliveries.InsertOne(ctx, delivery)
+	return err
+}
+
+// PollWebhookDelivery retrieves one pending webhook delivery which next attempt
+// time has come. The next attempt time is moved forward by the lease duration,
+// so that the delivery is not retrieved by other instances of the service while
+// it's being delivered. If the instance stops during the delivery, the delivery
+// is retrieved again after the lease expires.
+func (s *Storage) PollWebhookDelivery(ctx context.Context, lease time.Duration) (*service.WebhookDelivery, error) {
+	opts := options.
+		FindOneAndUpdate().
+		SetSort(bson.M{"nextattemptat": 1}).
+		SetReturnDocument(options.After)
+
+	now := time.Now()
+	filter := bson.M{
+		"state":         service.WebhookPending,
+		"nextattemptat": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"nextattemptat": now.Add(lease)}}
+	result := s.webhookDeliveries.FindOneAndUpdate(ctx, filter, update, opts)
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "webhook delivery not found")
+		}
+		return nil, result.Err()
+	}
+
+	var delivery service.WebhookDelivery
+	if err := result.Decode(&delivery); err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// UpdateWebhookDelivery saves the state and the attempts of a webhook delivery.
+func (s *Storage) UpdateWebhookDelivery(ctx context.Context, delivery *service.WebhookDelivery) error {
+	_, err := s.webhookDeliveries.ReplaceOne(ctx, bson.M{"id": delivery.ID}, delivery)
+	return err
+}
+
+// WebhookDeliveries retrieves the webhook deliveries of a task
+// or taskList sorted by creation time.
+func (s *Storage) WebhookDeliveries(ctx context.Context, resourceID string) ([]*service.WebhookDelivery, error) {
+	opts := options.Find().SetSort(bson.M{"createdat": 1})
+	cursor, err := s.webhookDeliveries.Find(ctx, bson.M{"resourceid": resourceID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var deliveries []*service.WebhookDelivery
+	for cursor.Next(ctx) {
+		var delivery service.WebhookDelivery
+		if err := cursor.Decode(&delivery); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+
+	return deliveries, cursor.Err()
+}
+
+// AddDeadLetter saves a task removed from the queue in the `deadLetters` collection.
+// Adding the same task again replaces the previous dead letter.
+func (s *Storage) AddDeadLetter(ctx context.Context, deadLetter *service.DeadLetter) error {
+	opts := options.Replace().SetUpsert(true)
+	_, err := s.deadLetters.ReplaceOne(ctx, bson.M{"id": deadLetter.ID}, deadLetter, opts)
+	return err
+}
+
+// DeadLetters retrieves dead letters from the newest to the oldest. If
+// taskName is not empty, only dead letters of tasks with this name are retrieved.
+func (s *Storage) DeadLetters(ctx context.Context, taskName string, limit int) ([]*service.DeadLetter, error) {
+	filter := bson.M{}
+	if taskName != "" {
+		filter["task.name"] = taskName
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "deadletteredat", Value: -1}, {Key: "id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := s.deadLetters.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var letters []*service.DeadLetter
+	for cursor.Next(ctx) {
+		var letter service.DeadLetter
+		if err := cursor.Decode(&letter); err != nil {
+			return nil, err
+		}
+		letters = append(letters, &letter)
+	}
+
+	return letters, cursor.Err()
+}
+
+// DeadLetter retrieves the dead letter of a task.
+func (s *Storage) DeadLetter(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+	result := s.deadLetters.FindOne(ctx, bson.M{"id": taskID})
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "dead letter not found")
+		}
+		return nil, result.Err()
+	}
+
+	var letter service.DeadLetter
+	if err := result.Decode(&letter); err != nil {
+		return nil, err
+	}
+
+	return &letter, nil
+}
+
+// DeleteDeadLetter removes the dead letter of a task and returns it.
+func (s *Storage) DeleteDeadLetter(ctx context.Context, taskID string) (*service.DeadLetter, error) {
+	result := s.deadLetters.FindOneAndDelete(ctx, bson.M{"id": taskID})
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "dead letter not found")
+		}
+		return nil, result.Err()
+	}
+
+	var letter service.DeadLetter
+	if err := result.Decode(&letter); err != nil {
+		return nil, err
+	}
+
+	return &letter, nil
+}
+
+// PurgeDeadLetters removes dead letters and returns their number. If taskName is not
+// empty, only dead letters of tasks with this name are removed. If before is not zero,
+// only the tasks which were removed from the queue before the given time are removed.
+func (s *Storage) PurgeDeadLetters(ctx context.Context, taskName string, before time.Time) (int, error) {
+	filter := bson.M{}
+	if taskName != "" {
+		filter["task.name"] = taskName
+	}
+	if !before.IsZero() {
+		filter["deadletteredat"] = bson.M{"$lt": before}
+	}
+
+	result, err := s.deadLetters.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.DeletedCount), nil
+}
+
+// Tasks retrieves tasks matching the filter from the `tasks`, `tasksHistory`
+// and `deadLetters` collections sorted by creation time. A task which is
+// present in several collections is returned only once, as it is found in
+// the history or the dead letters.
+func (s *Storage) Tasks(ctx context.Context, f *service.Filter) ([]*service.Task, error) {
+	filter := searchFilter(f)
+	opts := searchOptions(f)
+
+	history, err := findTasks(ctx, s.tasksHistory, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	deadLettered, err := s.deadLetterTasks(ctx, filter, f)
+	if err != nil {
+		return nil, err
+	}
+
+	queued, err := findTasks(ctx, s.tasks, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var tasks []*service.Task
+	for _, task := range append(append(history, deadLettered...), queued...) {
+		if seen[task.ID] {
+			continue
+		}
+		seen[task.ID] = true
+		tasks = append(tasks, task)
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return sortedBefore(tasks[i].CreatedAt, tasks[i].ID, tasks[j].CreatedAt, tasks[j].ID, f.Descending)
+	})
+	if len(tasks) > f.Limit {
+		tasks = tasks[:f.Limit]
+	}
+
+	return tasks, nil
+}
+
+// TaskLists retrieves taskLists matching the filter from the `taskLists` and
+// `taskListHistory` collections sorted by creation time. A taskList which is
+// present in both collections is returned only once, as it is found in the history.
+func (s *Storage) TaskLists(ctx context.Context, f *service.Filter) ([]*service.TaskList, error) {
+	filter := searchFilter(f)
+	opts := searchOptions(f)
+
+	history, err := findTaskLists(ctx, s.taskListHistory, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	queued, err := findTaskLists(ctx, s.taskLists, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var lists []*service.TaskList
+	for _, list := range append(history, queued...) {
+		if seen[list.ID] {
+			continue
+		}
+		seen[list.ID] = true
+		lists = append(lists, list)
+	}
+
+	sort.Slice(lists, func(i, j int) bool {
+		return sortedBefore(lists[i].CreatedAt, lists[i].ID, lists[j].CreatedAt, lists[j].ID, f.Descending)
+	})
+	if len(lists) > f.Limit {
+		lists = lists[:f.Limit]
+	}
+
+	return lists, nil
+}
+
+// deadLetterTasks retrieves the tasks of the dead letters which match the
+// search filter, sorted and limited in the same way as the other tasks.
+func (s *Storage) deadLetterTasks(ctx context.Context, filter bson.M, f *service.Filter) ([]*service.Task, error) {
+	order := 1
+	if f.Descending {
+		order = -1
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$task"}}},
+		{{Key: "$match", Value: filter}},
+		{{Key: "$sort", Value: bson.D{{Key: "createdat", Value: order}, {Key: "id", Value: order}}}},
+		{{Key: "$limit", Value: int64(f.Limit)}},
+	}
+
+	cursor, err := s.deadLetters.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTasks(ctx, cursor)
+}
+
+func findTasks(ctx context.Context, collection *mongo.Collection, filter bson.M, opts *options.FindOptions) ([]*service.Task, error) {
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTasks(ctx, cursor)
+}
+
+func decodeTasks(ctx context.Context, cursor *mongo.Cursor) ([]*service.Task, error) {
+	defer cursor.Close(ctx)
+
+	var tasks []*service.Task
+	for cursor.Next(ctx) {
+		var task service.Task
+		if err := cursor.Decode(&task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, &task)
+	}
+
+	return tasks, cursor.Err()
+}
+
+func findTaskLists(ctx context.Context, collection *mongo.Collection, filter bson.M, opts *options.FindOptions) ([]*service.TaskList, error) {
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var lists []*service.TaskList
+	for cursor.Next(ctx) {
+		var list service.TaskList
+		if err := cursor.Decode(&list); err != nil {
+			return nil, err
+		}
+		lists = append(lists, &list)
+	}
+
+	return lists, cursor.Err()
+}
+
+// searchFilter creates a query for the given search criteria. When a cursor
+// is given, only documents positioned after it in the sort order are selected.
+func searchFilter(f *service.Filter) bson.M {
+	filter := bson.M{}
+	if f.Name != "" {
+		filter["name"] = f.Name
+	}
+	if f.State != "" {
+		filter["state"] = f.State
+	}
+	if f.CacheNamespace != "" {
+		filter["cachenamespace"] = f.CacheNamespace
+	}
+	if f.CacheScope != "" {
+		filter["cachescope"] = f.CacheScope
+	}
+
+	createdAt := bson.M{}
+	if !f.CreatedAfter.IsZero() {
+		createdAt["$gte"] = f.CreatedAfter
+	}
+	if !f.CreatedBefore.IsZero() {
+		createdAt["$lt"] = f.CreatedBefore
+	}
+	if len(createdAt) > 0 {
+		filter["createdat"] = createdAt
+	}
+
+	if f.After != nil {
+		op := "$gt"
+		if f.Descending {
+			op = "$lt"
+		}
+		filter["$or"] = bson.A{
+			bson.M{"createdat": bson.M{op: f.After.CreatedAt}},
+			bson.M{"createdat": f.After.CreatedAt, "id": bson.M{op: f.After.ID}},
+		}
+	}
+
+	return filter
+}
+
+// searchOptions sorts documents by creation time and ID and limits
+// the number of documents retrieved from a single collection.
+func searchOptions(f *service.Filter) *options.FindOptions {
+	order := 1
+	if f.Descending {
+		order = -1
+	}
+
+	return options.Find().
+		SetSort(bson.D{{Key: "createdat", Value: order}, {Key: "id", Value: order}}).
+		SetLimit(int64(f.Limit))
+}
+
+// sortedBefore reports whether an item with creation time t1 and id1 is
+// positioned before an item with creation time t2 and id2 in the sort order.
+func sortedBefore(t1 time.Time, id1 string, t2 time.Time, id2 string, descending bool) bool {
+	if t1.Equal(t2) {
+		if descending {
+			return id1 > id2
+		}
+		return id1 < id2
+	}
+	if descending {
+		return t1.After(t2)
+	}
+	return t1.Before(t2)
+}
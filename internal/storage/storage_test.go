@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+func TestStorage_Tasks(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(connect(t), time.Minute)
+	require.NoError(t, s.Migrate(ctx))
+
+	created := time.Now().Truncate(time.Second)
+	require.NoError(t, s.SaveTaskHistory(ctx, &service.Task{ID: "1", Name: "task", State: service.Done, CreatedAt: created}))
+	require.NoError(t, s.Add(ctx, &service.Task{ID: "2", Name: "task", State: service.Created, CreatedAt: created.Add(time.Second)}))
+
+	// a dead-lettered task which is not acknowledged yet is returned once
+	dead := &service.Task{ID: "3", Name: "task", State: service.Failed, CreatedAt: created.Add(2 * time.Second)}
+	require.NoError(t, s.Add(ctx, &service.Task{ID: "3", Name: "task", State: service.Created, CreatedAt: dead.CreatedAt}))
+	require.NoError(t, s.AddDeadLetter(ctx, &service.DeadLetter{ID: dead.ID, Task: dead, DeadLetteredAt: time.Now()}))
+
+	tests := []struct {
+		name   string
+		filter service.Filter
+
+		ids []string
+	}{
+		{
+			name:   "all tasks",
+			filter: service.Filter{Limit: 10},
+			ids:    []string{"1", "2", "3"},
+		},
+		{
+			name:   "descending",
+			filter: service.Filter{Descending: true, Limit: 2},
+			ids:    []string{"3", "2"},
+		},
+		{
+			name:   "failed tasks",
+			filter: service.Filter{State: service.Failed, Limit: 10},
+			ids:    []string{"3"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tasks, err := s.Tasks(ctx, &test.filter)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, task := range tasks {
+				ids = append(ids, task.ID)
+			}
+			assert.Equal(t, test.ids, ids)
+		})
+	}
+}
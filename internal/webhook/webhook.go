@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+)
+
+const (
+	// SignatureHeader contains the hex encoded HMAC-SHA256 signature of the
+	// timestamp and the request body, separated by a dot.
+	SignatureHeader = "X-Signature"
+
+	// TimestampHeader contains the Unix time at which the request is signed.
+	TimestampHeader = "X-Signature-Timestamp"
+
+	// deliveryLease is the time for which a delivery is reserved
+	// for an instance of the service while it's being delivered.
+	deliveryLease = time.Minute
+)
+
+// Dispatcher delivers the webhook notifications saved in storage to their
+// callback URLs. Failed deliveries are retried with the exponential backoff
+// of a retry policy and every delivery attempt is recorded in storage.
+type Dispatcher struct {
+	storage      service.Storage
+	httpClient   *http.Client
+	secret       []byte
+	retry        service.RetryPolicy
+	pollInterval time.Duration
+	logger       *zap.Logger
+}
+
+func New(
+	storage service.Storage,
+	httpClient *http.Client,
+	secret string,
+	maxAttempts int,
+	minBackoff time.Duration,
+	maxBackoff time.Duration,
+	pollInterval time.Duration,
+	logger *zap.Logger,
+) *Dispatcher {
+	return &Dispatcher{
+		storage:      storage,
+		httpClient:   httpClient,
+		secret:       []byte(secret),
+		retry:        service.RetryPolicy{MaxAttempts: maxAttempts, BaseDelay: minBackoff, MaxDelay: maxBackoff},
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+}
+
+func (d *Dispatcher) Start(ctx context.Context) error {
+	defer d.logger.Info("webhook dispatcher stopped")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d.pollInterval):
+			d.deliverDue(ctx)
+		}
+	}
+}
+
+// deliverDue delivers the webhooks which next attempt time has come.
+func (d *Dispatcher) deliverDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		delivery, err := d.storage.PollWebhookDelivery(ctx, deliveryLease)
+		if err != nil {
+			if !errors.Is(errors.NotFound, err) {
+				d.logger.Error("error getting webhook delivery from storage", zap.Error(err))
+			}
+			return
+		}
+		d.Deliver(ctx, delivery)
+	}
+}
+
+// Deliver makes an attempt to deliver the webhook and records it. If the attempt
+// fails, the next attempt is scheduled after a backoff period, unless the
+// maximum number of attempts is reached.
+func (d *Dispatcher) Deliver(ctx context.Context, delivery *service.WebhookDelivery) {
+	logger := d.logger.With(
+		zap.String("deliveryID", delivery.ID),
+		zap.String("resourceID", delivery.ResourceID),
+	)
+
+	attempt := d.send(ctx, delivery)
+	delivery.Attempts = append(delivery.Attempts, attempt)
+
+	switch {
+	case attempt.Error == "":
+		delivery.State = service.WebhookDelivered
+		logger.Debug("webhook is delivered")
+	case len(delivery.Attempts) >= d.retry.MaxAttempts:
+		delivery.State = service.WebhookFailed
+		logger.Error("webhook delivery failed", zap.String("error", attempt.Error))
+	default:
+		delivery.NextAttemptAt = time.Now().Add(d.retry.Backoff(len(delivery.Attempts)))
+		logger.Debug("webhook delivery attempt failed", zap.String("error", attempt.Error))
+	}
+
+	if err := d.storage.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		logger.Error("error saving webhook delivery", zap.Error(err))
+	}
+}
+
+// send makes a single signed POST request to the callback URL.
+// Any response with status code other than 2xx is an error.
+func (d *Dispatcher) send(ctx context.Context, delivery *service.WebhookDelivery) service.WebhookAttempt {
+	attempt := service.WebhookAttempt{StartedAt: time.Now()}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = "error creating http request: " + err.Error()
+		return attempt
+	}
+
+	timestamp := strconv.FormatInt(attempt.StartedAt.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Signature(d.secret, timestamp, delivery.Payload))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		attempt.Error = "error executing http request: " + err.Error()
+		return attempt
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	attempt.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		attempt.Error = "unexpected response status: " + resp.Status
+	}
+
+	return attempt
+}
+
+// Signature calculates the signature of a webhook request, which receivers
+// can use to verify that the request is sent by the task service.
+func Signature(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
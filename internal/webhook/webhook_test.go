@@ -0,0 +1,144 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/task-sheduler/internal/service"
+	"github.com/eclipse-xfsc/task-sheduler/internal/service/servicefakes"
+	"github.com/eclipse-xfsc/task-sheduler/internal/webhook"
+)
+
+func TestSignature(t *testing.T) {
+	// echo -n '1672567200.{"id":"123"}' | openssl dgst -sha256 -hmac secret
+	signature := webhook.Signature([]byte("secret"), "1672567200", []byte(`{"id":"123"}`))
+	assert.Equal(t, "892a2276c959f796af2cfd845c1c48f3d449dd444817d249949f867b0c193708", signature)
+
+	assert.NotEqual(t, signature, webhook.Signature([]byte("other"), "1672567200", []byte(`{"id":"123"}`)))
+	assert.NotEqual(t, signature, webhook.Signature([]byte("secret"), "1672567201", []byte(`{"id":"123"}`)))
+	assert.NotEqual(t, signature, webhook.Signature([]byte("secret"), "1672567200", []byte(`{"id":"124"}`)))
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		attempts int // attempts are the previous failed attempts of the delivery
+
+		state   string
+		backoff time.Duration // backoff is the expected maximum time until the next attempt
+		errText string
+	}{
+		{
+			name:   "successful delivery",
+			status: http.StatusOK,
+			state:  service.WebhookDelivered,
+		},
+		{
+			name:    "first failed attempt is retried after the minimum backoff",
+			status:  http.StatusInternalServerError,
+			state:   service.WebhookPending,
+			backoff: time.Second,
+			errText: "unexpected response status: 500 Internal Server Error",
+		},
+		{
+			name:     "failed attempt is retried after a doubled backoff",
+			status:   http.StatusBadGateway,
+			attempts: 1,
+			state:    service.WebhookPending,
+			backoff:  2 * time.Second,
+			errText:  "unexpected response status: 502 Bad Gateway",
+		},
+		{
+			name:     "backoff is limited by the maximum backoff",
+			status:   http.StatusBadGateway,
+			attempts: 3,
+			state:    service.WebhookPending,
+			backoff:  5 * time.Second,
+			errText:  "unexpected response status: 502 Bad Gateway",
+		},
+		{
+			name:     "delivery fails after the maximum number of attempts",
+			status:   http.StatusNotFound,
+			attempts: 4,
+			state:    service.WebhookFailed,
+			errText:  "unexpected response status: 404 Not Found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payload := []byte(`{"id":"123","state":"done"}`)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, payload, body)
+
+				timestamp := r.Header.Get(webhook.TimestampHeader)
+				assert.NotEmpty(t, timestamp)
+				assert.Equal(t, webhook.Signature([]byte("secret"), timestamp, body), r.Header.Get(webhook.SignatureHeader))
+
+				w.WriteHeader(test.status)
+			}))
+			defer srv.Close()
+
+			storage := &servicefakes.FakeStorage{}
+			dispatcher := webhook.New(storage, srv.Client(), "secret", 5, time.Second, 5*time.Second, time.Second, zap.NewNop())
+
+			delivery := &service.WebhookDelivery{
+				ID:         "delivery",
+				ResourceID: "123",
+				URL:        srv.URL,
+				Payload:    payload,
+				State:      service.WebhookPending,
+				Attempts:   make([]service.WebhookAttempt, test.attempts),
+			}
+
+			start := time.Now()
+			dispatcher.Deliver(context.Background(), delivery)
+
+			require.Equal(t, 1, storage.UpdateWebhookDeliveryCallCount())
+			_, saved := storage.UpdateWebhookDeliveryArgsForCall(0)
+			assert.Equal(t, test.state, saved.State)
+			require.Len(t, saved.Attempts, test.attempts+1)
+
+			attempt := saved.Attempts[test.attempts]
+			assert.Equal(t, test.status, attempt.StatusCode)
+			assert.Equal(t, test.errText, attempt.Error)
+
+			if test.backoff > 0 {
+				// the backoff has a jitter of up to the half of it
+				assert.WithinRange(t, saved.NextAttemptAt, start.Add(test.backoff/2), time.Now().Add(test.backoff))
+			}
+		})
+	}
+}
+
+func TestDispatcher_DeliverConnectionError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	storage := &servicefakes.FakeStorage{}
+	dispatcher := webhook.New(storage, http.DefaultClient, "secret", 5, time.Second, 5*time.Second, time.Second, zap.NewNop())
+	dispatcher.Deliver(context.Background(), &service.WebhookDelivery{ID: "delivery", URL: url, Payload: []byte(`{}`), State: service.WebhookPending})
+
+	require.Equal(t, 1, storage.UpdateWebhookDeliveryCallCount())
+	_, saved := storage.UpdateWebhookDeliveryArgsForCall(0)
+	assert.Equal(t, service.WebhookPending, saved.State)
+	require.Len(t, saved.Attempts, 1)
+	assert.Equal(t, 0, saved.Attempts[0].StatusCode)
+	assert.Contains(t, saved.Attempts[0].Error, "error executing http request")
+}